@@ -53,6 +53,10 @@ type Format struct {
 	ntrim     int            // Number of columns with "trim"
 	json      bool           // Print as JSON.
 
+	// Lines nested under a line, by index in lines.
+	children map[int][][]string
+	childKey string // Key for the nested lines with -json.
+
 	printHeader bool
 }
 
@@ -149,17 +153,48 @@ func (f *Format) processColumn(line string) error {
 
 // Add a new line.
 func (f *Format) Line(columns map[string]string) error {
+	f.lines = append(f.lines, f.toLine(columns))
+	return nil
+}
+
+// Children adds lines nested under the last line; these are printed indented
+// in text mode, and as a list in key with -json.
+func (f *Format) Children(key string, columns ...map[string]string) {
+	if f.children == nil {
+		f.children = make(map[int][][]string)
+	}
+	f.childKey = key
+	n := len(f.lines) - 1
+	for _, c := range columns {
+		f.children[n] = append(f.children[n], f.toLine(c))
+	}
+}
+
+func (f *Format) toLine(columns map[string]string) []string {
 	line := make([]string, len(f.cols))
 	for i, c := range f.cols {
 		line[i] = columns[c.name]
+		if !f.json {
+			line[i] = strings.ReplaceAll(line[i], listSep, ", ")
+		}
 		if c.width == alignAuto {
-			if l := zstring.TabWidth(columns[c.name]); l > f.autoalign[i] {
+			if l := zstring.TabWidth(line[i]); l > f.autoalign[i] {
 				f.autoalign[i] = l
 			}
 		}
 	}
-	f.lines = append(f.lines, line)
-	return nil
+	return line
+}
+
+// Fits reports if the values for cols fit in the column width, for columns
+// with a fixed width.
+func (f *Format) Fits(columns map[string]string, cols ...string) bool {
+	for _, c := range f.cols {
+		if c.width > 0 && zstring.Contains(cols, c.name) && zstring.TabWidth(columns[c.name]) > c.width {
+			return false
+		}
+	}
+	return true
 }
 
 // Sort by column.
@@ -186,10 +221,18 @@ func (f *Format) SortNum(col string) {
 			break
 		}
 	}
+	// Sequences have several space-separated numbers ("49 65039 8419");
+	// compare them one by one so they sort after their first codepoint.
 	sort.Slice(f.lines, func(i, j int) bool {
-		a, _ := strconv.Atoi(f.lines[i][coli])
-		b, _ := strconv.Atoi(f.lines[j][coli])
-		return a < b
+		a, b := strings.Fields(f.lines[i][coli]), strings.Fields(f.lines[j][coli])
+		for k := 0; k < len(a) && k < len(b); k++ {
+			na, _ := strconv.Atoi(a[k])
+			nb, _ := strconv.Atoi(b[k])
+			if na != nb {
+				return na < nb
+			}
+		}
+		return len(a) < len(b)
 	})
 }
 
@@ -201,12 +244,13 @@ func (f *Format) printJSON(out io.Writer) {
 
 	out.Write([]byte("["))
 	for i, l := range f.lines {
-		m := make(map[string]string, len(f.cols))
-		for j, c := range f.cols {
-			if c.name == "wide_padding" || c.name == "tab" {
-				continue
+		m := f.jsonLine(l)
+		if children, ok := f.children[i]; ok {
+			c := make([]map[string]interface{}, 0, len(children))
+			for _, child := range children {
+				c = append(c, f.jsonLine(child))
 			}
-			m[c.name] = l[j]
+			m[f.childKey] = c
 		}
 
 		enc.Encode(m)
@@ -222,6 +266,25 @@ func (f *Format) printJSON(out io.Writer) {
 	out.Write([]byte("]\n"))
 }
 
+func (f *Format) jsonLine(l []string) map[string]interface{} {
+	m := make(map[string]interface{}, len(f.cols))
+	for j, c := range f.cols {
+		if c.name == "wide_padding" || c.name == "tab" {
+			continue
+		}
+		if zstring.Contains(listColumns, c.name) {
+			list := []string{}
+			if l[j] != "" {
+				list = strings.Split(l[j], listSep)
+			}
+			m[c.name] = list
+			continue
+		}
+		m[c.name] = l[j]
+	}
+	return m
+}
+
 func (f *Format) Print(out io.Writer) {
 	if f.json {
 		f.printJSON(out)
@@ -229,38 +292,45 @@ func (f *Format) Print(out io.Writer) {
 	}
 
 	for lineno, l := range f.lines {
-		line := f.format
-
-		// TODO: we can probably make this a bit faster by getting the text
-		// between the placeholders once; this is kind of slow for large sets
-		// (1.5s for "uni p all"), partly because we do all of this twice.
-		for i, text := range l {
-			m := f.re.FindAllString(line, 1)
-			line = strings.Replace(line, m[0], f.fmtPlaceholder(i, lineno, text, 0), 1)
+		f.printLine(out, lineno, l, "")
+		for _, c := range f.children[lineno] {
+			f.printLine(out, -1, c, "    ")
 		}
+	}
+}
 
-		// This line is too long and we want to trim: reformat the lot.
-		// TODO: this can be a bit more efficient: we know the column widths and
-		// text already, but this is easier.
-		if f.ntrim > 0 && zstring.TabWidth(line) > termWidth {
-			tooLongBy := zstring.TabWidth(line) - termWidth
-			var t = make([]int, len(f.cols))
-			for i, text := range l {
-				if f.cols[i].trim {
-					t[i] = zstring.TabWidth(text)
-				}
-			}
-			trim := nratio(tooLongBy, t...)
+func (f *Format) printLine(out io.Writer, lineno int, l []string, indent string) {
+	line := f.format
 
-			line = f.format
-			for i, text := range l {
-				m := f.re.FindAllString(line, 1)
-				line = strings.Replace(line, m[0], f.fmtPlaceholder(i, lineno, text, trim[i]-1), 1)
+	// TODO: we can probably make this a bit faster by getting the text
+	// between the placeholders once; this is kind of slow for large sets
+	// (1.5s for "uni p all"), partly because we do all of this twice.
+	for i, text := range l {
+		m := f.re.FindAllString(line, 1)
+		line = strings.Replace(line, m[0], f.fmtPlaceholder(i, lineno, text, 0), 1)
+	}
+
+	// This line is too long and we want to trim: reformat the lot.
+	// TODO: this can be a bit more efficient: we know the column widths and
+	// text already, but this is easier.
+	if f.ntrim > 0 && zstring.TabWidth(line) > termWidth {
+		tooLongBy := zstring.TabWidth(line) - termWidth
+		var t = make([]int, len(f.cols))
+		for i, text := range l {
+			if f.cols[i].trim {
+				t[i] = zstring.TabWidth(text)
 			}
 		}
+		trim := nratio(tooLongBy, t...)
 
-		out.Write(append([]byte(line), '\n'))
+		line = f.format
+		for i, text := range l {
+			m := f.re.FindAllString(line, 1)
+			line = strings.Replace(line, m[0], f.fmtPlaceholder(i, lineno, text, trim[i]-1), 1)
+		}
 	}
+
+	out.Write(append([]byte(indent+line), '\n'))
 }
 
 // nratio subtracts "sub" from all the numbers in "nums" proportionally. That
@@ -318,31 +388,114 @@ func (f *Format) String() string {
 }
 
 var knownColumns = []string{"char", "wide_padding", "cpoint", "dec", "hex",
-	"utf8", "utf16be", "utf16le", "html", "xml", "json", "keysym", "digraph",
-	"name", "cat", "block", "plane", "width"}
-
-func toLine(info unidata.Codepoint, raw bool) map[string]string {
-	// TODO: would be better to include only the columns that are actually used.
-	return map[string]string{
-		"char":         info.Repr(raw),
-		"wide_padding": widePadding(info),
-		"cpoint":       info.FormatCodepoint(),
-		"dec":          info.Format(10),
-		"hex":          info.Format(16),
-		"utf8":         info.UTF8(),
-		"utf16be":      info.UTF16(true),
-		"utf16le":      info.UTF16(false),
-		"html":         info.HTMLEntity(),
-		"xml":          info.XMLEntity(),
-		"json":         info.JSON(),
-		"keysym":       info.KeySym,
-		"digraph":      info.Digraph,
-		"name":         info.Name,
-		"cat":          info.Category(),
-		"block":        info.Block(),
-		"plane":        info.Plane(),
-		"width":        info.WidthName(),
+	"utf8", "utf16be", "utf16le", "html", "html_all", "xml", "json", "keysym", "keysyms", "compose", "digraph",
+	"name", "cat", "block", "plane", "width", "props", "upper", "lower",
+	"title", "fold", "alias", "abbr", "decomp", "ccc", "script", "scriptx", "age",
+	"numtype", "numval", "bidi", "mirror", "linebreak", "jamo", "emoji_props", "presentation"}
+
+// listSep separates the values of a column that is a list; these are printed
+// as an array with -json, and joined with a comma otherwise.
+const listSep = "\x1f"
+
+var listColumns = []string{"alias", "abbr", "scriptx",
+	"html_all", "keysyms", "compose"}
+
+// toLine gets the columns for the codepoint; only the columns used in f are
+// set.
+func toLine(f *Format, info unidata.Codepoint, raw bool) map[string]string {
+	l := make(map[string]string, len(f.cols))
+	for _, c := range f.cols {
+		l[c.name] = columnValue(c.name, info, raw)
+	}
+	return l
+}
+
+func columnValue(name string, info unidata.Codepoint, raw bool) string {
+	switch name {
+	case "char":
+		return info.Repr(raw)
+	case "wide_padding":
+		return widePadding(info)
+	case "cpoint":
+		return info.FormatCodepoint()
+	case "dec":
+		return info.Format(10)
+	case "hex":
+		return info.Format(16)
+	case "utf8":
+		return info.UTF8()
+	case "utf16be":
+		return info.UTF16(true)
+	case "utf16le":
+		return info.UTF16(false)
+	case "html":
+		return info.HTMLEntity()
+	case "html_all":
+		return htmlEntities(info.HTMLEntities)
+	case "xml":
+		return info.XMLEntity()
+	case "json":
+		return info.JSON()
+	case "keysym":
+		return first(info.KeySyms)
+	case "keysyms":
+		return strings.Join(info.KeySyms, listSep)
+	case "compose":
+		return composeList(string(info.Codepoint))
+	case "digraph":
+		return info.Digraph
+	case "name":
+		return info.Name
+	case "cat":
+		return info.Category()
+	case "block":
+		return info.Block()
+	case "plane":
+		return info.Plane()
+	case "width":
+		return info.WidthName()
+	case "props":
+		return (info.Properties() &^ unidata.EmojiProps).String()
+	case "emoji_props":
+		return (info.Properties() & unidata.EmojiProps).String()
+	case "presentation":
+		return presentation(info)
+	case "upper":
+		return caseMapping(info, info.Upper())
+	case "lower":
+		return caseMapping(info, info.Lower())
+	case "title":
+		return caseMapping(info, info.Title())
+	case "fold":
+		return caseMapping(info, info.Fold())
+	case "alias":
+		return nameAliases(info, false)
+	case "abbr":
+		return nameAliases(info, true)
+	case "decomp":
+		return decomposition(info)
+	case "ccc":
+		return strconv.Itoa(int(info.CombiningClass()))
+	case "script":
+		return info.Script()
+	case "scriptx":
+		return strings.Join(info.ScriptExtensions(), listSep)
+	case "age":
+		return info.Age()
+	case "numtype":
+		return numericType(info)
+	case "numval":
+		return info.Numeric().String()
+	case "bidi":
+		return info.BidiClass().String()
+	case "mirror":
+		return mirror(info)
+	case "linebreak":
+		return info.LineBreak()
+	case "jamo":
+		return jamo(info)
 	}
+	return ""
 }
 
 // Alignment with spaces is tricky, as some emojis are double-width and some are
@@ -366,9 +519,174 @@ func tabOrSpace() string {
 	return "    "
 }
 
+// toLineSeq is like toLine, but for a sequence of codepoints. Only the columns
+// that make sense for a sequence are set, with the values for every codepoint
+// joined together.
+func toLineSeq(f *Format, s string, raw bool) map[string]string {
+	runes := []rune(s)
+	if len(runes) == 1 {
+		info, _ := unidata.Find(runes[0])
+		return toLine(f, info, raw)
+	}
+
+	line := map[string]string{"wide_padding": " "}
+	for i, r := range runes {
+		info, _ := unidata.Find(r)
+		l := toLine(f, info, raw)
+		if i == 0 {
+			for _, col := range seqColumns {
+				line[col] = l[col]
+			}
+			line["char"] = l["char"]
+			line["name"] = l["name"]
+		} else {
+			for _, col := range seqColumns {
+				line[col] += " " + l[col]
+			}
+			line["char"] += l["char"]
+			line["name"] += " + " + l["name"]
+		}
+		if l["wide_padding"] == "" {
+			line["wide_padding"] = ""
+		}
+	}
+	return line
+}
+
+var seqColumns = []string{"cpoint", "dec", "hex", "utf8", "utf16be", "utf16le", "xml", "json"}
+
+// htmlEntities formats a list of HTML entity names as "&euro;".
+func htmlEntities(names []string) string {
+	l := make([]string, 0, len(names))
+	for _, n := range names {
+		l = append(l, "&"+n+";")
+	}
+	return strings.Join(l, listSep)
+}
+
+func first(l []string) string {
+	if len(l) == 0 {
+		return ""
+	}
+	return l[0]
+}
+
+// Blank if the codepoint maps to itself.
+func caseMapping(info unidata.Codepoint, m string) string {
+	if m == string(info.Codepoint) {
+		return ""
+	}
+	return m
+}
+
+// nameAliases gets the formal aliases, or only the abbreviations if abbr is
+// set.
+func nameAliases(info unidata.Codepoint, abbr bool) string {
+	var s []string
+	for _, a := range info.Aliases() {
+		if (a.Type == unidata.AliasAbbreviation) == abbr {
+			s = append(s, a.Name)
+		}
+	}
+	return strings.Join(s, listSep)
+}
+
+func decomposition(info unidata.Codepoint) string {
+	d := info.Decomposition()
+	s := make([]string, 0, len(d.Mapping)+1)
+	if d.Tag != "" {
+		s = append(s, "<"+d.Tag+">")
+	}
+	for _, cp := range d.Mapping {
+		s = append(s, fmt.Sprintf("U+%04X", cp))
+	}
+	return strings.Join(s, " ")
+}
+
+// composeIndex is the X11 Compose sequences by the text they produce; this is
+// loaded on first use.
+var composeIndex map[string][]unidata.ComposeSequence
+
+func loadCompose() map[string][]unidata.ComposeSequence {
+	if composeIndex != nil {
+		return composeIndex
+	}
+
+	composeIndex = make(map[string][]unidata.ComposeSequence)
+	seqs, err := unidata.LoadCompose(composeLocale())
+	if err != nil {
+		fmt.Fprintf(zli.Stderr, "uni: WARNING: loading Compose file: %s\n", err)
+	}
+	for _, s := range seqs {
+		composeIndex[s.Text] = append(composeIndex[s.Text], s)
+	}
+	return composeIndex
+}
+
+// composeLocale gets the locale for LC_CTYPE, which is what libX11 uses to
+// find the Compose file.
+func composeLocale() string {
+	for _, v := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if l := os.Getenv(v); l != "" {
+			return l
+		}
+	}
+	return "C"
+}
+
+func composeList(text string) string {
+	seqs := loadCompose()[text]
+	s := make([]string, 0, len(seqs))
+	for _, c := range seqs {
+		s = append(s, c.String())
+	}
+	return strings.Join(s, listSep)
+}
+
+func jamo(info unidata.Codepoint) string {
+	j := info.Jamo()
+	s := make([]string, 0, len(j))
+	for _, cp := range j {
+		s = append(s, fmt.Sprintf("U+%04X", cp))
+	}
+	return strings.Join(s, " ")
+}
+
+func numericType(info unidata.Codepoint) string {
+	if t := info.Numeric().Type; t != unidata.NumericNone {
+		return t.String()
+	}
+	return ""
+}
+
+func mirror(info unidata.Codepoint) string {
+	if !info.BidiMirrored() {
+		return ""
+	}
+	if m := info.BidiMirroringGlyph(); m > -1 {
+		return fmt.Sprintf("U+%04X", m)
+	}
+	return "yes"
+}
+
+// Emoji presentation characters are displayed as wide, regardless of their
+// East Asian width.
 func widePadding(info unidata.Codepoint) string {
-	if info.Width != unidata.WidthFullWidth && info.Width != unidata.WidthWide {
+	if info.Width != unidata.WidthFullWidth && info.Width != unidata.WidthWide &&
+		!info.HasProperty(unidata.PropEmojiPresentation) {
 		return " "
 	}
 	return ""
 }
+
+// Default presentation of emoji; blank if it's not an emoji.
+func presentation(info unidata.Codepoint) string {
+	p := info.Properties()
+	switch {
+	case p&unidata.PropEmojiPresentation != 0:
+		return "emoji"
+	case p&unidata.PropEmoji != 0:
+		return "text"
+	}
+	return ""
+}
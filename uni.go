@@ -6,9 +6,11 @@ package main
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"unicode/utf8"
 
 	"arp242.net/uni/v2/unidata"
@@ -17,8 +19,10 @@ import (
 )
 
 var (
-	errNoMatches = errors.New("no matches")
-	version      = "git"
+	errNoMatches     = errors.New("no matches")
+	errNotConfusable = errors.New("not confusable")
+	errNotNormalized = errors.New("not normalized")
+	version          = "git"
 )
 
 var usageShort = zli.Usage(zli.UsageHeaders|zli.UsageProgram|zli.UsageTrim, `
@@ -38,8 +42,15 @@ Flags:
 Commands:
     identify       Identity all the characters in the given strings.
     search         Search description for any of the words.
-    print          Print characters by codepoint, category, or block.
+    print          Print characters by codepoint, category, block, or property.
     emoji          Search emojis.
+    case           Change the case of text.
+    confusable     Find visually confusable characters.
+    normalize      Normalize text to NFC, NFD, NFKC, or NFKD.
+    number         Parse numbers written in any script.
+    bidi           Show how bidirectional text is displayed.
+    segment        Split text in words, sentences, graphemes, or lines.
+    wrap           Wrap text with the Unicode line breaking algorithm.
 
 Use "%(prog) help" for a more detailed help.
 `)
@@ -62,24 +73,64 @@ Flags:
                    instead of anything where at least one matches.
     -c, -columns   Select columns to print.
     -f, -format    Output format; see Format section below for details.
+    -max-version   Exclude characters and emojis newer than this Unicode or
+                   emoji version from search, print, and emoji, and warn
+                   about them in identify; for example "-max-version 12.0".
     -j, -json      Output as JSON; the columns listed in -format are included,
                    ignoring formatting flags. Use "-format all" to include all
                    columns.
 
 Commands:
     identify [text]  Idenfity all the characters in the given strings.
+                     Named sequences such as "TAMIL SYLLABLE KSSA" and HTML
+                     entities for several codepoints such as &NotEqualTilde;
+                     are printed as one line, with every codepoint indented
+                     below it if they don't fit in the columns.
 
-    search [query]   Search description for any of the words.
+                        -scripts      Print a summary of the scripts used
+                                      instead of every character.
 
-    print [query]    Print characters by codepoint, category, or block.
+                        -graphemes    Print every grapheme cluster (what a
+                                      user sees as a single character, such
+                                      as "é" written as "e" and a combining
+                                      accent), with its codepoints indented
+                                      below it.
+
+                        -no-emoji     Print every codepoint of emoji
+                                      sequences; the default is to print
+                                      sequences such as 🧑‍🚒 (firefighter)
+                                      or 🇳🇱 (flag: Netherlands) as one
+                                      line.
+
+    search [query]   Search description for any of the words; this includes
+                     formal aliases and abbreviations such as "ZWJ", and
+                     named sequences such as "KEYCAP DIGIT ONE".
+
+    print [query]    Print characters by codepoint, category, block, script,
+                     property, or a character reference.
 
                        Codepoints             U+20, U20, 0x20, 0d32 (decimal),
                                               0o40, 0b100000
                        Range                  U+2042..U+2050, 0o101..0x5a
                        Categories and Blocks  OtherPunctuation, Po,
                                               GeneralPunctuation
+                       Scripts                Cyrillic, Cyrl (ISO 15924)
+                       Properties             White_Space, Dash, Emoji_Presentation
+                       Names and aliases      name:NBSP, "name:euro sign",
+                                              '\N{EURO SIGN}'
+                       HTML entities          &euro;, &#x20AC;, &#8364;
+                       X11 keysyms            keysym:EuroSign
+                       Vim digraphs           digraph:=e
+                       Compose sequences      "compose:<Multi_key> <equal> <e>",
+                                              compose:=e (keys after Compose)
+                       Characters             € (anything that isn't a
+                                              hex digit)
+                       Unicode version        age:13.0, age:<=9.0, age:>12.1
                        all                    Everything
 
+                     Scripts take precedence over blocks with the same name;
+                     use block:Cyrillic to get the block.
+
     emoji [query]    Search emojis.
 
                      The query is matched on the emoji name and CLDR data.
@@ -120,6 +171,12 @@ Commands:
                                         md, mediumdark, medium-dark
                                         d,  dark
 
+                                      Use two tones separated by a colon
+                                      to set the tone of every person in
+                                      emojis with two people, such as
+                                      "light:dark" for 👩🏻‍🤝‍👩🏿; only the
+                                      first is used for other emojis.
+
                      Use "all" to include all combinations; the default is to
                      include no skin tones and the "person' gender.
 
@@ -127,6 +184,80 @@ Commands:
                      in terminals. It's recommended to copy to the clipboard
                      directly with e.g. xclip.
 
+    case [text]      Change the case of text; this uses the full case mappings
+                     (ß is SS in uppercase) and context-specific rules such as
+                     the final sigma in Greek.
+
+                        -to           Mappings to print as a comma-separated
+                                      list: upper, lower, title, fold. The
+                                      default is to print all of them.
+
+                        -lang         Language to use for language-specific
+                                      rules; only "tr" (Turkish), "az"
+                                      (Azeri), and "lt" (Lithuanian) have any.
+
+    confusable [text]
+                     Show all characters that are visually confusable with the
+                     characters in text (e.g. Latin "a" and Cyrillic "а"), as
+                     defined by the Unicode security mechanisms (UTS #39).
+
+                     With more than one argument it will compare the
+                     "skeletons" of all arguments instead, and exit with 1 if
+                     they're not confusable:
+
+                       uni confusable paypal раураl
+
+    normalize [text] Normalize text, and report if it was already normalized;
+                     exits with 1 if the text isn't in all the given forms.
+
+                        -form         Normalization forms as a comma-separated
+                                      list: NFC, NFD, NFKC, NFKD. The default
+                                      is to use all of them.
+
+                        -explain      Print the normalized text with the
+                                      identify format, to see exactly which
+                                      codepoints it consists of. This needs a
+                                      single -form.
+
+    number [text]    Parse numbers written with the digits of any script (e.g.
+                     "١٢٣" or "１２３"), Roman numerals ("ⅯⅯⅩⅩⅠ"), or other
+                     numeric characters ("½", "⑳", "五"), and print them as a
+                     plain integer or decimal. Every argument is a separate
+                     number; digits from different scripts can't be mixed.
+                     Use "." as the decimal separator and "," as the
+                     thousands separator: "1,234.5".
+
+    bidi [text]      Run the Unicode Bidirectional Algorithm (UAX #9) over the
+                     text, and show the resolved embedding levels and the
+                     order in which it's displayed. Every argument or line of
+                     input is a separate paragraph. Explicit embeddings,
+                     overrides, and isolates are shown as "[RLI]" etc. in the
+                     text, unless -raw is given.
+
+                        -dir          Paragraph direction: auto, ltr, or rtl.
+                                      The default is auto, which uses the
+                                      first strong character.
+
+                        -explain      Print every codepoint with its bidi
+                                      class, level, and position in the
+                                      visual order.
+
+    segment [text]   Split text in segments, and show the byte and rune offset
+                     of every segment. Spaces and punctuation are kept as
+                     separate segments, or at the end of a sentence or line.
+
+                        -by           What to split by: word (the default),
+                                      sentence, grapheme (UAX #29), or line
+                                      (every line break opportunity from
+                                      UAX #14).
+
+    wrap [text]      Wrap text to a maximum width with the Unicode line
+                     breaking algorithm (UAX #14), and show if every line
+                     ends at a mandatory break (a newline) or an allowed one.
+                     Wide and fullwidth characters count as two columns.
+
+                        -width        Maximum width; the default is 80.
+
 Format:
     You can use the -format or -f flag to control what to print; placeholders
     are in the form of %(name) or %(name flags), where "name" is a column name
@@ -158,21 +289,72 @@ Format:
         %(utf16le)       As UTF-16 LE (Windows)         13 27
         %(utf16be)       As UTF-16 BE                   27 13
         %(html)          HTML entity                    &check;
+        %(html_all)      All HTML entities              &check;, &checkmark;
         %(xml)           XML entity                     &#x2713;
         %(json)          JSON escape                    \u2713
         %(keysym)        X11 keysym; can be blank       checkmark
+        %(keysyms)       All X11 keysyms                checkmark
+        %(compose)       X11 Compose sequences          <Multi_key> <v> <slash>
         %(digraph)       Vim Digraph; can be blank      OK
         %(name)          Code point name                CHECK MARK
-        %(cat)           Category name                  Other_Symbol
+        %(cat)           Category name; "sequence" for  Other_Symbol
+                         named sequences
         %(block)         Block name                     Dingbats
         %(plane)         Plane name                     Basic Multilingual Plane
         %(width)         Character width                Narrow
+        %(props)         Binary properties              Grapheme_Base
+        %(upper)         Uppercase mapping; can be blank
+        %(lower)         Lowercase mapping; can be blank
+        %(title)         Titlecase mapping; can be blank
+        %(fold)          Case folding; can be blank
+        %(alias)         Formal aliases, such as corrections for
+                         misspelt names; can be blank
+        %(abbr)          Abbreviations; can be blank    NBSP
+        %(decomp)        Decomposition mapping; can be blank
+        %(ccc)           Canonical combining class      0
+        %(script)        Script                         Common
+        %(scriptx)       Script extensions              Common
+        %(age)           Unicode version it was added   1.1
+        %(numtype)       Numeric type; can be blank     Numeric
+        %(numval)        Numeric value; can be blank    1/2
+        %(bidi)          Bidi class                     ON
+        %(mirror)        Mirrored glyph in RTL text,    U+0029
+                         "yes" if it's mirrored without
+                         a glyph; can be blank
+        %(linebreak)     Line break class               AL
+        %(emoji_props)   Emoji properties; can be blank Emoji, Emoji_Presentation
+        %(presentation)  Default emoji presentation:    emoji
+                         "emoji" or "text"; can be blank
+        %(jamo)          Conjoining jamo of Hangul      U+1100 U+1161
+                         syllables; can be blank        U+11A8
         %(wide_padding)  Blank for wide characters,
                          space otherwise; for alignment
 
         The default is:
         %(char q l:3)%(wide_padding) %(cpoint l:7) %(dec l:6) %(utf8 l:11) %(html l:10) %(name t) (%(cat t))
 
+    Placeholders for identify:
+
+        %(grapheme)      The grapheme cluster it's in   é
+        %(cluster_index) Cluster number, starting at 1  1
+        %(group)         Emoji group; can be blank      Smileys & Emotion
+        %(subgroup)      Emoji subgroup; can be blank   face-smiling
+        %(cldr)          CLDR keywords, without words in
+                         the name; can be blank
+        %(cldr_full)     All CLDR keywords; can be blank
+
+        And all the placeholders from identify, search, and print.
+
+    Placeholders for identify -scripts:
+
+        %(script)      Script name                      Latin
+        %(code)        ISO 15924 code                   Latn
+        %(count)       Number of characters             5
+        %(chars)       The characters                   Hello
+
+        The default is:
+        %(script l:auto) %(code l:4) %(count r:auto)  %(chars q)
+
     Placeholders for emoji:
 
         %(emoji)       The emoji itself                 🧑‍🚒
@@ -182,9 +364,101 @@ Format:
         %(cpoint)      Codepoints                       U+1F9D1 U+200D U+1F692
         %(cldr)        CLDR data, w/o duplicating name  firetruck
         %(cldr_full)   Full CLDR data                   firefighter, firetruck
+        %(version)     Emoji version it was added       12.1
 
         The default is:
         %(emoji)%(tab)%(name l:auto)  (%(cldr t))
+
+    Placeholders for confusable:
+
+        %(input)       Input character                  m
+        %(skeleton)    Skeleton, when comparing         rn
+        %(text)        Text, when comparing             m
+
+        And %(char), %(wide_padding), %(cpoint), %(name) from identify.
+
+        The default is:
+        %(input q l:3)  %(char q l:auto)%(wide_padding) %(cpoint l:auto) %(name t)
+
+        Or, when comparing:
+        %(text q l:auto)  %(skeleton q)
+
+    Placeholders for normalize:
+
+        %(form)        Normalization form               NFC
+        %(text)        Normalized text                  é
+        %(cpoint)      Codepoints                       U+00E9
+        %(quick_check) Result of the quick check; yes,  yes
+                       no, or maybe
+        %(normalized)  If the input was normalized      yes
+
+        The default is:
+        %(form l:4) %(normalized l:auto) %(text q l:auto) %(cpoint)
+
+    Placeholders for number:
+
+        %(input)       Input text                       ١٢٫٥
+        %(number)      Parsed number                    12.5
+
+        The default is:
+        %(input q l:auto)  %(number)
+
+    Placeholders for bidi:
+
+        %(text)        Input text                       abc [RLI]אבג[PDI]
+        %(dir)         Paragraph direction              ltr
+        %(levels)      Resolved level of every          0000111110
+                       character; "x" for characters
+                       that are ignored
+        %(visual)      Text in visual order             abc [RLI]גבא[PDI]
+
+        The default is:
+        %(text q l:auto)  %(dir)  %(levels)  %(visual q)
+
+    Placeholders for bidi -explain:
+
+        %(level)       Resolved level; "x" if ignored   1
+        %(order)       Position in the visual order     5
+        %(explicit)    Explicit embeddings, overrides,  RLI
+                       and isolates it's inside of
+
+        And all the placeholders from identify.
+
+        The default is:
+        %(char q l:3)%(wide_padding) %(cpoint l:7) %(bidi l:3) %(level r:5) %(order r:5)  %(explicit l:auto) %(name t)
+
+    Placeholders for segment:
+
+        %(segment)     The segment                      Hello
+        %(byte)        Byte offset, starting at 0       6
+        %(rune)        Rune offset, starting at 0       6
+        %(bytes)       Length in bytes                  5
+        %(runes)       Length in runes                  5
+
+        The default is:
+        %(byte r:auto) %(rune r:auto)  %(segment q)
+
+    Placeholders for wrap:
+
+        %(line)        Line number                      1
+        %(text)        Text of the line                 The quick brown
+        %(width)       Width of the line                15
+        %(break)       How the line ends: "allowed",    allowed
+                       "mandatory", or "end"
+        %(breaks)      Text with every allowed break    The ÷quick ÷brown
+                       marked with ÷
+
+        The default is:
+        %(width r:auto)  %(break l:auto)  %(text q)
+
+    Placeholders for case:
+
+        %(mapping)     Case mapping                     upper
+        %(text)        Text with the mapping applied    STRASSE
+        %(cpoint)      Codepoints                       U+0053 U+0054 [..]
+
+        The default is:
+        %(mapping l:auto)  %(text)
 `)
 
 func main() {
@@ -200,10 +474,25 @@ func main() {
 		jsonF    = flag.Bool(false, "json", "j")
 		tone     = flag.String("", "t", "tone", "tones")
 		gender   = flag.String("person", "g", "gender", "genders")
+		to       = flag.String("", "to")
+		lang     = flag.String("", "lang")
+		form     = flag.String("", "form")
+		explain  = flag.Bool(false, "explain")
+		scripts  = flag.Bool(false, "scripts")
+		graphs   = flag.Bool(false, "graphemes")
+		noEmoji  = flag.Bool(false, "no-emoji")
+		maxVer   = flag.String("", "max-version")
+		dir      = flag.String("", "dir")
+		by       = flag.String("word", "by")
+		widthF   = flag.Int(80, "width")
 	)
 	err := flag.Parse()
 	zli.F(err)
 
+	if maxVer.Set() && !validVersion(maxVer.String()) {
+		zli.Fatalf("invalid -max-version: %q", maxVer.String())
+	}
+
 	if versionF.Set() {
 		fmt.Println(version)
 		return
@@ -218,7 +507,11 @@ func main() {
 		return
 	}
 
-	cmd := flag.ShiftCommand("identify", "print", "search", "emoji", "help", "version")
+	cmd := flag.ShiftCommand("identify", "print", "search", "s", "se", "emoji", "case",
+		"confusable", "normalize", "number", "bidi", "segment", "wrap", "help", "version")
+	if cmd == "s" || cmd == "se" { // Ambiguous with segment, but keep them as they were commonly used.
+		cmd = "search"
+	}
 	switch cmd { // These commands don't read from stdin.
 	case zli.CommandNoneGiven:
 		fmt.Fprint(zli.Stdout, usageShort)
@@ -226,6 +519,9 @@ func main() {
 	case zli.CommandUnknown:
 		zli.Fatalf("unknown command")
 		return
+	case zli.CommandAmbiguous:
+		zli.Fatalf("ambiguous command")
+		return
 	case "help":
 		fmt.Fprint(zli.Stdout, usage)
 		return
@@ -237,38 +533,121 @@ func main() {
 	quiet := quietF.Set()
 	raw := rawF.Set()
 	args := flag.Args
-	args, err = zli.InputOrArgs(args, " \t\n", quiet)
+	sep := " \t\n"
+	switch cmd {
+	case "bidi": // Keep spaces, as they affect the result.
+		sep = "\n"
+	case "segment", "wrap":
+		sep = ""
+	}
+	args, err = zli.InputOrArgs(args, sep, quiet)
 	zli.F(err)
 
 	format := formatF.String()
 	if !formatF.Set() {
 		format = "%(char q l:3)%(wide_padding) %(cpoint l:7) %(dec l:6) %(utf8 l:11) %(html l:10) %(name t) (%(cat t))"
-		if cmd == "emoji" {
+		switch cmd {
+		case "identify":
+			if scripts.Bool() {
+				format = "%(script l:auto) %(code l:4) %(count r:auto)  %(chars q)"
+			}
+		case "emoji":
 			format = "%(emoji)%(tab)%(name l:auto)  (%(cldr t))"
+		case "case":
+			format = "%(mapping l:auto)  %(text)"
+		case "confusable":
+			format = "%(input q l:3)  %(char q l:auto)%(wide_padding) %(cpoint l:auto) %(name t)"
+			if len(args) > 1 {
+				format = "%(text q l:auto)  %(skeleton q)"
+			}
+		case "normalize":
+			if !explain.Bool() {
+				format = "%(form l:4) %(normalized l:auto) %(text q l:auto) %(cpoint)"
+			}
+		case "number":
+			format = "%(input q l:auto)  %(number)"
+		case "segment":
+			format = "%(byte r:auto) %(rune r:auto)  %(segment q)"
+		case "wrap":
+			format = "%(width r:auto)  %(break l:auto)  %(text q)"
+		case "bidi":
+			format = "%(text q l:auto)  %(dir)  %(levels)  %(visual q)"
+			if explain.Bool() {
+				format = "%(char q l:3)%(wide_padding) %(cpoint l:7) %(bidi l:3) %(level r:5) %(order r:5)  %(explicit l:auto) %(name t)"
+			}
 		}
 	}
 	if formatF.String() == "all" {
 		format = "%(char q l:3)%(wide_padding) %(cpoint l:auto) %(width l:auto) %(dec l:auto) %(hex l:auto)" +
-			" %(utf8 l:auto) %(utf16le l:auto) %(utf16be l:auto) %(html l:auto) %(xml l:auto) %(json l:auto)" +
-			" %(keysym l:auto) %(digraph l:auto) %(name l:auto) %(plane l:auto) %(cat l:auto) %(block l:auto)"
-		if cmd == "emoji" {
-			format = "%(emoji)%(tab)%(name l:auto) %(group l:auto) %(subgroup l:auto) %(cpoint l:auto) %(cldr l:auto) %(cldr_full)"
+			" %(utf8 l:auto) %(utf16le l:auto) %(utf16be l:auto) %(html l:auto) %(html_all l:auto) %(xml l:auto)" +
+			" %(json l:auto) %(keysym l:auto) %(keysyms l:auto) %(compose l:auto) %(digraph l:auto) %(name l:auto) %(plane l:auto) %(cat l:auto) %(block l:auto)" +
+			" %(props l:auto) %(upper l:auto) %(lower l:auto) %(title l:auto) %(fold l:auto)" +
+			" %(alias l:auto) %(abbr l:auto)" +
+			" %(decomp l:auto) %(ccc l:auto) %(script l:auto) %(scriptx l:auto) %(age l:auto)" +
+			" %(numtype l:auto) %(numval l:auto) %(bidi l:auto) %(mirror l:auto) %(linebreak l:auto) %(jamo l:auto)" +
+			" %(emoji_props l:auto) %(presentation l:auto)"
+		switch cmd {
+		case "identify":
+			if scripts.Bool() {
+				format = "%(script l:auto) %(code l:auto) %(count l:auto) %(chars)"
+			} else {
+				format += " %(cluster_index l:auto) %(grapheme l:auto) %(group l:auto) %(subgroup l:auto) %(cldr l:auto) %(cldr_full l:auto)"
+			}
+		case "emoji":
+			format = "%(emoji)%(tab)%(name l:auto) %(group l:auto) %(subgroup l:auto) %(cpoint l:auto) %(version l:auto) %(cldr l:auto) %(cldr_full)"
+		case "case":
+			format = "%(mapping l:auto) %(text l:auto) %(cpoint)"
+		case "confusable":
+			format = "%(input q l:3)  %(char q l:auto)%(wide_padding) %(cpoint l:auto) %(name)"
+			if len(args) > 1 {
+				format = "%(text q l:auto) %(skeleton q)"
+			}
+		case "normalize":
+			if !explain.Bool() {
+				format = "%(form l:auto) %(quick_check l:auto) %(normalized l:auto) %(text q l:auto) %(cpoint)"
+			}
+		case "number":
+			format = "%(input q l:auto) %(number)"
+		case "segment":
+			format = "%(byte l:auto) %(rune l:auto) %(bytes l:auto) %(runes l:auto) %(segment q)"
+		case "wrap":
+			format = "%(line l:auto) %(width l:auto) %(break l:auto) %(text q) %(breaks q)"
+		case "bidi":
+			if explain.Bool() {
+				format += " %(line l:auto) %(level l:auto) %(order l:auto) %(explicit l:auto)"
+			} else {
+				format = "%(text q l:auto) %(dir l:auto) %(levels l:auto) %(visual q)"
+			}
 		}
 	}
 
 	switch cmd {
 	case "identify":
-		err = identify(args, format, quiet, raw, jsonF.Bool())
+		err = identify(args, format, quiet, raw, jsonF.Bool(), scripts.Bool(), graphs.Bool(), noEmoji.Bool(), maxVer.String())
 	case "search":
-		err = search(args, format, quiet, raw, jsonF.Bool(), or.Bool())
+		err = search(args, format, quiet, raw, jsonF.Bool(), or.Bool(), maxVer.String())
 	case "print":
-		err = print(args, format, quiet, raw, jsonF.Bool())
+		err = print(args, format, quiet, raw, jsonF.Bool(), maxVer.String())
 	case "emoji":
 		err = emoji(args, format, quiet, raw, jsonF.Bool(), or.Bool(),
-			parseToneFlag(tone.String()), parseGenderFlag(gender.String()))
+			parseToneFlag(tone.String()), parseGenderFlag(gender.String()), maxVer.String())
+	case "case":
+		err = changeCase(args, format, quiet, jsonF.Bool(), parseToFlag(to.String()), lang.String())
+	case "confusable":
+		err = confusable(args, format, quiet, raw, jsonF.Bool())
+	case "normalize":
+		err = normalize(args, format, quiet, raw, jsonF.Bool(), parseFormFlag(form.String()), explain.Bool())
+	case "number":
+		err = number(args, format, quiet, jsonF.Bool())
+	case "bidi":
+		err = bidi(args, format, quiet, raw, jsonF.Bool(), parseDirFlag(dir.String()), explain.Bool())
+	case "segment":
+		err = segment(args, format, quiet, jsonF.Bool(), parseByFlag(by.String()))
+	case "wrap":
+		err = wrap(args, format, quiet, jsonF.Bool(), widthF.Int())
 	}
 	if err != nil {
-		if !(err == errNoMatches && quiet) {
+		if !((err == errNoMatches || err == errNotConfusable || err == errNotNormalized) && quiet) {
 			zli.Fatalf(err)
 		}
 		zli.Exit(1)
@@ -280,25 +659,33 @@ func parseToneFlag(tone string) []string {
 		return nil
 	}
 	if tone == "all" {
-		tone = "none,light,mediumlight, medium, mediumdark,dark"
+		tone = "none,light,mediumlight,medium,mediumdark,dark"
+		for _, a := range []string{"light", "mediumlight", "medium", "mediumdark", "dark"} {
+			for _, b := range []string{"light", "mediumlight", "medium", "mediumdark", "dark"} {
+				if a != b {
+					tone += "," + a + ":" + b
+				}
+			}
+		}
 	}
 
 	var tones []string
 	for _, t := range zstring.Fields(tone, ",") {
-		switch t {
-		case "none", "n":
-			t = "none"
-		case "l", "light":
-			t = "light"
-		case "ml", "mediumlight", "medium-light", "medium_light":
-			t = "mediumlight"
-		case "m", "medium":
-			t = "medium"
-		case "md", "mediumdark", "medium-dark", "medium_dark":
-			t = "mediumdark"
-		case "d", "dark":
-			t = "dark"
-		default:
+		if i := strings.IndexByte(t, ':'); i > -1 {
+			a, b := canonicalTone(t[:i]), canonicalTone(t[i+1:])
+			if a == "" || b == "" || a == "none" || b == "none" {
+				zli.Fatalf("invalid skin tone: %q", tone)
+			}
+			if a == b {
+				tones = append(tones, a)
+			} else {
+				tones = append(tones, a+":"+b)
+			}
+			continue
+		}
+
+		t = canonicalTone(t)
+		if t == "" {
 			zli.Fatalf("invalid skin tone: %q", tone)
 		}
 		tones = append(tones, t)
@@ -306,6 +693,89 @@ func parseToneFlag(tone string) []string {
 	return tones
 }
 
+// canonicalTone gets the canonical name of a skin tone, or "" if it's not a
+// valid tone.
+func canonicalTone(t string) string {
+	switch t {
+	case "none", "n":
+		return "none"
+	case "l", "light":
+		return "light"
+	case "ml", "mediumlight", "medium-light", "medium_light":
+		return "mediumlight"
+	case "m", "medium":
+		return "medium"
+	case "md", "mediumdark", "medium-dark", "medium_dark":
+		return "mediumdark"
+	case "d", "dark":
+		return "dark"
+	}
+	return ""
+}
+
+func parseToFlag(to string) []string {
+	if to == "" {
+		return []string{"upper", "lower", "title", "fold"}
+	}
+
+	var maps []string
+	for _, t := range zstring.Fields(to, ",") {
+		switch t {
+		case "upper", "u", "uppercase":
+			t = "upper"
+		case "lower", "l", "lowercase":
+			t = "lower"
+		case "title", "t", "titlecase":
+			t = "title"
+		case "fold", "f", "casefold":
+			t = "fold"
+		default:
+			zli.Fatalf("invalid case mapping: %q", to)
+		}
+		maps = append(maps, t)
+	}
+	return maps
+}
+
+func parseFormFlag(form string) []unidata.Form {
+	if form == "" {
+		return unidata.Forms
+	}
+
+	var forms []unidata.Form
+	for _, f := range zstring.Fields(form, ",") {
+		nf, err := unidata.ParseForm(f)
+		if err != nil {
+			zli.Fatalf("invalid normalization form: %q", form)
+		}
+		forms = append(forms, nf)
+	}
+	return forms
+}
+
+func parseDirFlag(dir string) unidata.Direction {
+	d, err := unidata.ParseDirection(dir)
+	if err != nil {
+		zli.Fatalf("invalid -dir: %q", dir)
+	}
+	return d
+}
+
+func parseByFlag(by string) func(string) []string {
+	switch by {
+	case "word", "words", "w":
+		return unidata.Words
+	case "sentence", "sentences", "s":
+		return unidata.Sentences
+	case "grapheme", "graphemes", "g":
+		return unidata.Graphemes
+	case "line", "lines", "l":
+		return unidata.Lines
+	}
+	zli.Fatalf("invalid -by: %q", by)
+	return nil
+}
+
 func parseGenderFlag(gender string) []string {
 	if gender == "" {
 		return nil
@@ -331,29 +801,156 @@ func parseGenderFlag(gender string) []string {
 	return genders
 }
 
-func identify(ins []string, format string, quiet, raw, asJSON bool) error {
+func identify(ins []string, format string, quiet, raw, asJSON, scripts, graphemes, noEmoji bool, maxVersion string) error {
 	in := strings.Join(ins, "")
 	if !utf8.ValidString(in) {
 		fmt.Fprintf(zli.Stderr, "uni: WARNING: input string is not valid UTF-8\n")
 	}
 
-	f, err := NewFormat(format, asJSON, !quiet, knownColumns...)
+	if scripts {
+		return identifyScripts(in, format, quiet, asJSON)
+	}
+
+	f, err := NewFormat(format, asJSON, !quiet, append(knownColumns,
+		"grapheme", "cluster_index", "group", "subgroup", "cldr", "cldr_full")...)
 	if err != nil {
 		return err
 	}
+	var seqs map[string]unidata.Emoji
+	if !noEmoji {
+		seqs = emojiSequences()
+	}
+	gs := unidata.Graphemes(in)
+	for i := 0; i < len(gs); i++ {
+		g, ci := gs[i], i
+		e, isEmoji := seqs[g]
+
+		// Named sequences can span several grapheme clusters (e.g. TAMIL
+		// SYLLABLE KSSA); use the longest match.
+		var (
+			ns      unidata.NamedSequence
+			isNamed bool
+		)
+		if !isEmoji {
+			s, end := "", i
+			for j := i; j < len(gs); j++ {
+				s += gs[j]
+				if utf8.RuneCountInString(s) > maxNamedSeq {
+					break
+				}
+				if n, ok := unidata.FindNamedSequence(s); ok {
+					ns, isNamed, g, end = n, true, s, j
+				}
+			}
+			i = end
+		}
+		ents, isEntity := unidata.HTMLSequences[g]
+
+		cps := make([]map[string]string, 0, len(g))
+		for _, c := range g {
+			info, ok := unidata.Find(c)
+			if !ok {
+				return fmt.Errorf("unknown codepoint: U+%.4X", c) // Should never happen.
+			}
+			if age := info.Age(); newerThan(age, maxVersion) {
+				fmt.Fprintf(zli.Stderr, "uni: WARNING: %s %s is from Unicode %s, which is newer than %s\n",
+					info.FormatCodepoint(), info.Name, age, maxVersion)
+			}
+
+			l := toLine(f, info, raw)
+			l["grapheme"], l["cluster_index"] = g, strconv.Itoa(ci+1)
+			cps = append(cps, l)
+		}
+
+		// Print emoji, named sequences, and HTML entities for several
+		// codepoints as a single line, rather than every codepoint.
+		if !graphemes && !isEmoji && !isNamed && !isEntity {
+			for _, l := range cps {
+				f.Line(l)
+			}
+			continue
+		}
+
+		l := toLineSeq(f, g, raw)
+		l["grapheme"], l["cluster_index"] = g, strconv.Itoa(ci+1)
+		if len(cps) > 1 {
+			l["char"] = g
+		}
+		if isNamed {
+			l["name"], l["cat"] = ns.Name, "sequence"
+		}
+		if isEntity {
+			l["html"], l["html_all"] = "&"+ents[0]+";", htmlEntities(ents)
+		}
+		if isEmoji {
+			l["name"], l["cat"] = e.Name, e.GroupName()
+			l["group"], l["subgroup"] = e.GroupName(), e.SubgroupName()
+			l["cldr"], l["cldr_full"] = emojiCLDR(e), strings.Join(e.CLDR, ", ")
+		}
+		// Print a row for every codepoint below it if the values for every
+		// codepoint joined together don't fit in the columns.
+		nested := len(cps) > 1 && !asJSON && !f.Fits(l, seqColumns...)
+		if nested {
+			for _, col := range seqColumns {
+				delete(l, col)
+			}
+		}
+		f.Line(l)
+		if nested || (graphemes && (len(cps) > 1 || asJSON)) {
+			f.Children("codepoints", cps...)
+		}
+	}
+	f.Print(zli.Stdout)
+	return nil
+}
+
+// maxNamedSeq is the length of the longest named sequence, in codepoints.
+var maxNamedSeq = func() int {
+	m := 0
+	for _, ns := range unidata.NamedSequences {
+		if len(ns.Codepoints) > m {
+			m = len(ns.Codepoints)
+		}
+	}
+	return m
+}()
+
+// identifyScripts prints a summary of the scripts in the string, in the order
+// they first appear.
+func identifyScripts(in, format string, quiet, asJSON bool) error {
+	f, err := NewFormat(format, asJSON, !quiet, "script", "code", "count", "chars")
+	if err != nil {
+		return err
+	}
+
+	var (
+		order []string
+		count = make(map[string]int)
+		chars = make(map[string]string)
+	)
 	for _, c := range in {
-		info, ok := unidata.Find(c)
-		if !ok {
-			return fmt.Errorf("unknown codepoint: U+%.4X", c) // Should never happen.
+		info, _ := unidata.Find(c)
+		sc := info.Script()
+		if _, ok := count[sc]; !ok {
+			order = append(order, sc)
 		}
+		count[sc]++
+		chars[sc] += string(c)
+	}
 
-		f.Line(toLine(info, raw))
+	for _, sc := range order {
+		f.Line(map[string]string{
+			"script": sc,
+			"code":   unidata.ScriptCode(sc),
+			"count":  strconv.Itoa(count[sc]),
+			"chars":  chars[sc],
+		})
 	}
 	f.Print(zli.Stdout)
 	return nil
 }
 
-func search(args []string, format string, quiet, raw, asJSON, or bool) error {
+func search(args []string, format string, quiet, raw, asJSON, or bool, maxVersion string) error {
 	var na []string
 	for _, a := range args {
 		if a != "" {
@@ -374,21 +971,35 @@ func search(args []string, format string, quiet, raw, asJSON, or bool) error {
 	if err != nil {
 		return err
 	}
-	for _, info := range unidata.Codepoints {
+	match := func(info unidata.Codepoint) {
 		m := 0
 		for _, a := range args {
-			if strings.Contains(info.Name, a) {
+			if matchName(info, a) {
+				m++
 				if or {
-					found = true
-					f.Line(toLine(info, raw))
 					break
 				}
-				m++
 			}
 		}
-		if !or && m == len(args) {
+		// Check the age after the name, as that's a lot faster.
+		if m > 0 && (or || m == len(args)) && !newerThan(info.Age(), maxVersion) {
+			found = true
+			f.Line(toLine(f, info, raw))
+		}
+	}
+	for _, info := range unidata.Codepoints {
+		if !info.IsRangeEnd() {
+			match(info)
+		}
+	}
+	unidata.Derived(match)
+
+	for _, ns := range unidata.NamedSequences {
+		if matchNamedSequence(ns, args, or, maxVersion) {
 			found = true
-			f.Line(toLine(info, raw))
+			l := toLineSeq(f, ns.String(), raw)
+			l["char"], l["name"], l["cat"] = ns.String(), ns.Name, "sequence"
+			f.Line(l)
 		}
 	}
 
@@ -400,18 +1011,153 @@ func search(args []string, format string, quiet, raw, asJSON, or bool) error {
 	return nil
 }
 
-func print(args []string, format string, quiet, raw, asJSON bool) error {
+// matchNamedSequence reports if the name of the named sequence contains the
+// search terms, which must be in upper case.
+func matchNamedSequence(ns unidata.NamedSequence, args []string, or bool, maxVersion string) bool {
+	for _, c := range ns.Codepoints {
+		if info, _ := unidata.Find(c); newerThan(info.Age(), maxVersion) {
+			return false
+		}
+	}
+	m := 0
+	for _, a := range args {
+		if strings.Contains(ns.Name, a) {
+			if or {
+				return true
+			}
+			m++
+		}
+	}
+	return !or && m == len(args)
+}
+
+// matchName reports if the name, any of the formal aliases, or the Unicode 1.0
+// name contain s, which must be in upper case.
+func matchName(info unidata.Codepoint, s string) bool {
+	if strings.Contains(info.Name, s) {
+		return true
+	}
+	if n := info.Unicode1Name(); n != "" && strings.Contains(n, s) {
+		return true
+	}
+	for _, a := range info.Aliases() {
+		if strings.Contains(a.Name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func print(args []string, format string, quiet, raw, asJSON bool, maxVersion string) error {
 	f, err := NewFormat(format, asJSON, !quiet, knownColumns...)
 	if err != nil {
 		return err
 	}
+	line := func(info unidata.Codepoint) {
+		if info.IsRangeEnd() {
+			info, _ = unidata.Find(info.Codepoint)
+		}
+		if !newerThan(info.Age(), maxVersion) {
+			f.Line(toLine(f, info, raw))
+		}
+	}
 	for _, a := range args {
+		// Name or alias.
+		if strings.HasPrefix(a, "name:") {
+			info, ok := unidata.FindName(a[5:])
+			if !ok {
+				return fmt.Errorf("unknown name: %q", a[5:])
+			}
+			line(info)
+			continue
+		}
+		// \N{EURO SIGN}, as in Perl and Python.
+		if strings.HasPrefix(a, `\N{`) && strings.HasSuffix(a, "}") {
+			info, ok := unidata.FindName(a[3 : len(a)-1])
+			if !ok {
+				return fmt.Errorf("unknown name: %q", a[3:len(a)-1])
+			}
+			line(info)
+			continue
+		}
+
+		// HTML entity: &euro;, &#x20ac;, &#8364;
+		if strings.HasPrefix(a, "&") && strings.HasSuffix(a, ";") {
+			infos, ok := unidata.FindHTMLEntity(a)
+			if !ok {
+				return fmt.Errorf("unknown HTML entity: %q", a)
+			}
+			for _, info := range infos {
+				line(info)
+			}
+			continue
+		}
+
+		// X11 keysym: keysym:EuroSign
+		if strings.HasPrefix(a, "keysym:") {
+			info, ok := unidata.FindKeySym(a[7:])
+			if !ok {
+				return fmt.Errorf("unknown keysym: %q", a[7:])
+			}
+			line(info)
+			continue
+		}
+
+		// X11 Compose sequence: "compose:<Multi_key> <equal> <e>", compose:=e
+		if strings.HasPrefix(a, "compose:") {
+			keys, ok := parseComposeKeys(a[8:])
+			if !ok {
+				return fmt.Errorf("invalid compose sequence: %q", a[8:])
+			}
+			found := false
+			for text, seqs := range loadCompose() {
+				for _, c := range seqs {
+					if c.HasPrefix(keys) {
+						found = true
+						for _, r := range text {
+							info, _ := unidata.Find(r)
+							line(info)
+						}
+						break
+					}
+				}
+			}
+			if !found {
+				return fmt.Errorf("unknown compose sequence: %q", a[8:])
+			}
+			continue
+		}
+
+		// Vim digraph: digraph:=e
+		if strings.HasPrefix(a, "digraph:") {
+			info, ok := unidata.FindDigraph(a[8:])
+			if !ok {
+				return fmt.Errorf("unknown digraph: %q", a[8:])
+			}
+			line(info)
+			continue
+		}
+
+		// Unicode version: age:13.0, age:<=9.0
+		if strings.HasPrefix(a, "age:") {
+			cmp, v := parseAge(a[4:])
+			if cmp == nil {
+				return fmt.Errorf("invalid age: %q", a[4:])
+			}
+			for _, info := range unidata.Codepoints {
+				if age := info.Age(); age != "" && cmp(unidata.CompareVersion(age, v)) {
+					line(info)
+				}
+			}
+			continue
+		}
+
 		canon := unidata.CanonicalCategory(a)
 
 		// Print everything.
 		if canon == "all" {
 			for _, info := range unidata.Codepoints {
-				f.Line(toLine(info, raw))
+				line(info)
 			}
 			continue
 		}
@@ -420,23 +1166,57 @@ func print(args []string, format string, quiet, raw, asJSON bool) error {
 		if cat, ok := unidata.Catmap[canon]; ok {
 			for _, info := range unidata.Codepoints {
 				if info.Cat == cat {
-					f.Line(toLine(info, raw))
+					line(info)
+				}
+			}
+			continue
+		}
+
+		// Script name or ISO 15924 code.
+		if sc, ok := unidata.Scriptmap[canon]; ok {
+			for _, r := range unidata.Scripts[sc] {
+				for cp := r[0]; cp <= r[1]; cp++ {
+					if info, ok := unidata.Find(cp); ok {
+						line(info)
+					}
 				}
 			}
 			continue
 		}
 
 		// Block.
-		if bl, ok := unidata.Blockmap[canon]; ok {
+		bl, ok := unidata.Blockmap[canon]
+		if !ok && strings.HasPrefix(canon, "block:") {
+			bl, ok = unidata.Blockmap[canon[6:]]
+		}
+		if ok {
 			for cp := unidata.Blocks[bl][0]; cp <= unidata.Blocks[bl][1]; cp++ {
 				s, ok := unidata.Codepoints[cp]
 				if ok {
-					f.Line(toLine(s, raw))
+					line(s)
+				}
+			}
+			continue
+		}
+
+		// Property.
+		if p, ok := unidata.Propmap[canon]; ok {
+			for _, r := range unidata.Props[p] {
+				for cp := r[0]; cp <= r[1]; cp++ {
+					info, _ := unidata.Find(cp)
+					line(info)
 				}
 			}
 			continue
 		}
 
+		// A single character; hex digits are codepoints.
+		if r, size := utf8.DecodeRuneInString(a); size == len(a) && r != utf8.RuneError && !isHexDigit(r) {
+			info, _ := unidata.Find(r)
+			line(info)
+			continue
+		}
+
 		// U2042, U+2042, U+2042..U+2050, 2042..2050, 2042-2050, 0x2041, etc.
 		var s []string
 		switch {
@@ -472,7 +1252,7 @@ func print(args []string, format string, quiet, raw, asJSON bool) error {
 
 		for i := start; i <= end; i++ {
 			info, _ := unidata.Find(i)
-			f.Line(toLine(info, raw))
+			line(info)
 		}
 	}
 	f.SortNum("dec")
@@ -480,7 +1260,424 @@ func print(args []string, format string, quiet, raw, asJSON bool) error {
 	return nil
 }
 
-func emoji(args []string, format string, quiet, raw, asJSON, or bool, tones, genders []string) error {
+// parseComposeKeys parses the keys of a Compose sequence, which can be as in
+// the Compose file ("<Multi_key> <equal> <e>") or the characters after the
+// Compose key ("=e").
+func parseComposeKeys(s string) ([]string, bool) {
+	if !strings.Contains(s, "<") {
+		keys, ok := unidata.ComposeKeys(s)
+		return append([]string{"Multi_key"}, keys...), ok && len(keys) > 0
+	}
+
+	var keys []string
+	for _, k := range strings.Fields(s) {
+		if !strings.HasPrefix(k, "<") || !strings.HasSuffix(k, ">") || len(k) < 3 {
+			return nil, false
+		}
+		keys = append(keys, k[1:len(k)-1])
+	}
+	return keys, len(keys) > 0
+}
+
+// isHexDigit reports if r is an ASCII hexadecimal digit.
+func isHexDigit(r rune) bool {
+	return (r >= '0' && r <= '9') || (r >= 'a' && r <= 'f') || (r >= 'A' && r <= 'F')
+}
+
+// parseAge parses a version with an optional comparison operator, such as
+// "13.0" or "<=9.0".
+//
+// The returned function reports if the result of unidata.CompareVersion()
+// satisfies the operator; it's nil if the input is invalid.
+func parseAge(s string) (func(int) bool, string) {
+	var cmp func(int) bool
+	switch {
+	case strings.HasPrefix(s, "<="):
+		s, cmp = s[2:], func(c int) bool { return c <= 0 }
+	case strings.HasPrefix(s, ">="):
+		s, cmp = s[2:], func(c int) bool { return c >= 0 }
+	case strings.HasPrefix(s, "<"):
+		s, cmp = s[1:], func(c int) bool { return c < 0 }
+	case strings.HasPrefix(s, ">"):
+		s, cmp = s[1:], func(c int) bool { return c > 0 }
+	default:
+		s, cmp = strings.TrimPrefix(s, "="), func(c int) bool { return c == 0 }
+	}
+	if !validVersion(s) {
+		return nil, ""
+	}
+	return cmp, s
+}
+
+// validVersion reports if s looks like a Unicode or emoji version: "13.0",
+// "9", "0.6", etc.
+func validVersion(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, p := range strings.Split(s, ".") {
+		if _, err := strconv.Atoi(p); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// newerThan reports if version is newer than max; it's never newer if either
+// is blank.
+func newerThan(version, max string) bool {
+	return version != "" && max != "" && unidata.CompareVersion(version, max) > 0
+}
+
+func changeCase(args []string, format string, quiet, asJSON bool, to []string, lang string) error {
+	in := strings.Join(args, " ")
+
+	f, err := NewFormat(format, asJSON, !quiet, "mapping", "text", "cpoint")
+	if err != nil {
+		return err
+	}
+	for _, t := range to {
+		var text string
+		switch t {
+		case "upper":
+			text = unidata.ToUpper(in, lang)
+		case "lower":
+			text = unidata.ToLower(in, lang)
+		case "title":
+			text = unidata.ToTitle(in, lang)
+		case "fold":
+			text = unidata.Fold(in, lang)
+		}
+
+		f.Line(map[string]string{
+			"mapping": t,
+			"text":    text,
+			"cpoint": func() string {
+				cp := make([]string, 0, len(text))
+				for _, c := range text {
+					cp = append(cp, fmt.Sprintf("U+%04X", c))
+				}
+				return strings.Join(cp, " ")
+			}(),
+		})
+	}
+	f.Print(zli.Stdout)
+	return nil
+}
+
+func normalize(args []string, format string, quiet, raw, asJSON bool, forms []unidata.Form, explain bool) error {
+	in := strings.Join(args, " ")
+
+	normalized := true
+	for _, form := range forms {
+		if !unidata.IsNormalized(in, form) {
+			normalized = false
+		}
+	}
+
+	if explain {
+		if len(forms) != 1 {
+			return errors.New("normalize: -explain needs a single -form")
+		}
+		err := identify([]string{unidata.Normalize(in, forms[0])}, format, quiet, raw, asJSON, false, false, true, "")
+		if err == nil && !normalized {
+			err = errNotNormalized
+		}
+		return err
+	}
+
+	f, err := NewFormat(format, asJSON, !quiet, "form", "text", "cpoint", "quick_check", "normalized")
+	if err != nil {
+		return err
+	}
+	for _, form := range forms {
+		text := unidata.Normalize(in, form)
+		f.Line(map[string]string{
+			"form":        form.String(),
+			"text":        text,
+			"cpoint":      toLineSeq(f, text, raw)["cpoint"],
+			"quick_check": unidata.QuickCheckString(in, form).String(),
+			"normalized": func() string {
+				if unidata.IsNormalized(in, form) {
+					return "yes"
+				}
+				return "no"
+			}(),
+		})
+	}
+	f.Print(zli.Stdout)
+
+	if !normalized {
+		return errNotNormalized
+	}
+	return nil
+}
+
+func number(args []string, format string, quiet, asJSON bool) error {
+	f, err := NewFormat(format, asJSON, !quiet, "input", "number")
+	if err != nil {
+		return err
+	}
+	for _, a := range args {
+		n, err := unidata.ParseNumber(a)
+		if err != nil {
+			return fmt.Errorf("number: cannot parse %q: %s", a, err)
+		}
+		f.Line(map[string]string{"input": a, "number": formatNumber(n)})
+	}
+	f.Print(zli.Stdout)
+	return nil
+}
+
+// formatNumber formats n as an integer or decimal; numbers that can't be
+// represented exactly as a decimal (e.g. 1/3) are rounded to 12 places.
+func formatNumber(n *big.Rat) string {
+	if n.IsInt() {
+		return n.Num().String()
+	}
+	s := strings.TrimRight(n.FloatString(12), "0")
+	if s == "-0." || s == "0." {
+		return "0"
+	}
+	return strings.TrimSuffix(s, ".")
+}
+
+func segment(args []string, format string, quiet, asJSON bool, split func(string) []string) error {
+	f, err := NewFormat(format, asJSON, !quiet, "segment", "byte", "rune", "bytes", "runes")
+	if err != nil {
+		return err
+	}
+	var b, r int
+	for _, seg := range split(strings.Join(args, " ")) {
+		n := utf8.RuneCountInString(seg)
+		f.Line(map[string]string{
+			"segment": seg,
+			"byte":    strconv.Itoa(b),
+			"rune":    strconv.Itoa(r),
+			"bytes":   strconv.Itoa(len(seg)),
+			"runes":   strconv.Itoa(n),
+		})
+		b, r = b+len(seg), r+n
+	}
+	f.Print(zli.Stdout)
+	return nil
+}
+
+func wrap(args []string, format string, quiet, asJSON bool, width int) error {
+	if width < 1 {
+		return fmt.Errorf("wrap: invalid -width: %d", width)
+	}
+	f, err := NewFormat(format, asJSON, !quiet, "line", "text", "width", "break", "breaks")
+	if err != nil {
+		return err
+	}
+
+	var (
+		line []string // Segments on the current line.
+		w    int      // Width of the current line, including trailing spaces.
+		n    int
+	)
+	flush := func(brk string) {
+		n++
+		text := strings.Join(line, "")
+		line[len(line)-1] = trimLine(line[len(line)-1])
+		f.Line(map[string]string{
+			"line":   strconv.Itoa(n),
+			"text":   trimLine(text),
+			"width":  strconv.Itoa(textWidth(trimLine(text))),
+			"break":  brk,
+			"breaks": strings.Join(line, "÷"),
+		})
+		line, w = line[:0], 0
+	}
+	for _, seg := range unidata.Lines(strings.Join(args, " ")) {
+		// Trailing spaces are allowed to go over the width.
+		if len(line) > 0 && w+textWidth(trimLine(seg)) > width {
+			flush("allowed")
+		}
+		line = append(line, seg)
+		w += textWidth(seg)
+		if unidata.MandatoryBreak(seg) {
+			flush("mandatory")
+		}
+	}
+	if len(line) > 0 {
+		flush("end")
+	}
+	f.Print(zli.Stdout)
+	return nil
+}
+
+// trimLine removes trailing spaces and line terminators.
+func trimLine(s string) string {
+	return strings.TrimRight(s, " \r\n\v\f\u0085\u2028\u2029")
+}
+
+// textWidth gets the display width of s: wide and fullwidth characters take up
+// two columns, and combining marks, format, and control characters none.
+func textWidth(s string) int {
+	w := 0
+	for _, r := range s {
+		info, _ := unidata.Find(r)
+		switch {
+		case info.Cat == unidata.CatNonspacingMark, info.Cat == unidata.CatEnclosingMark,
+			info.Cat == unidata.CatFormat, info.Cat == unidata.CatControl:
+			// Zero width.
+		case info.Width == unidata.WidthFullWidth, info.Width == unidata.WidthWide:
+			w += 2
+		default:
+			w++
+		}
+	}
+	return w
+}
+
+func bidi(args []string, format string, quiet, raw, asJSON bool, dir unidata.Direction, explain bool) error {
+	var paras []unidata.BidiParagraph
+	for _, a := range args {
+		paras = append(paras, unidata.Bidi(a, dir)...)
+	}
+
+	if explain {
+		cols := append([]string{"line", "level", "order", "explicit"}, knownColumns...)
+		f, err := NewFormat(format, asJSON, !quiet, cols...)
+		if err != nil {
+			return err
+		}
+		for n, p := range paras {
+			pos := make(map[int]int)
+			for i, idx := range p.Reorder() {
+				pos[idx] = i + 1
+			}
+			for i, c := range p.Text {
+				info, ok := unidata.Find(c)
+				if !ok {
+					return fmt.Errorf("unknown codepoint: U+%.4X", c) // Should never happen.
+				}
+				l := toLine(f, info, raw)
+				l["line"] = strconv.Itoa(n + 1)
+				l["level"], l["order"] = "x", ""
+				if p.Levels[i] > -1 {
+					l["level"], l["order"] = strconv.Itoa(p.Levels[i]), strconv.Itoa(pos[i])
+				}
+				explicit := make([]string, 0, len(p.Explicit[i]))
+				for _, e := range p.Explicit[i] {
+					explicit = append(explicit, e.String())
+				}
+				l["explicit"] = strings.Join(explicit, " > ")
+				f.Line(l)
+			}
+		}
+		f.Print(zli.Stdout)
+		return nil
+	}
+
+	f, err := NewFormat(format, asJSON, !quiet, "text", "dir", "levels", "visual")
+	if err != nil {
+		return err
+	}
+	for _, p := range paras {
+		var (
+			levels = make([]string, 0, len(p.Levels))
+			big    bool
+		)
+		for _, l := range p.Levels {
+			if l > 9 {
+				big = true
+			}
+			if l == -1 {
+				levels = append(levels, "x")
+			} else {
+				levels = append(levels, strconv.Itoa(l))
+			}
+		}
+		sep := ""
+		if big {
+			sep = " "
+		}
+
+		visual := make([]rune, 0, len(p.Text))
+		for _, i := range p.Reorder() {
+			visual = append(visual, p.Text[i])
+		}
+		dir := "ltr"
+		if p.Level == 1 {
+			dir = "rtl"
+		}
+		f.Line(map[string]string{
+			"text":   showExplicit(p, p.Text, raw),
+			"dir":    dir,
+			"levels": strings.Join(levels, sep),
+			"visual": showExplicit(p, []rune(p.Visual()), raw),
+		})
+	}
+	f.Print(zli.Stdout)
+	return nil
+}
+
+// showExplicit replaces the explicit formatting characters (which are
+// invisible) with their bidi class, such as "[RLI]".
+func showExplicit(p unidata.BidiParagraph, text []rune, raw bool) string {
+	if raw {
+		return string(text)
+	}
+	b := new(strings.Builder)
+	for _, r := range text {
+		switch c := (unidata.Codepoint{Codepoint: r}).BidiClass(); c {
+		case unidata.BidiLRE, unidata.BidiLRO, unidata.BidiRLE, unidata.BidiRLO, unidata.BidiPDF,
+			unidata.BidiLRI, unidata.BidiRLI, unidata.BidiFSI, unidata.BidiPDI:
+			b.WriteString("[" + c.String() + "]")
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func confusable(args []string, format string, quiet, raw, asJSON bool) error {
+	// Compare skeletons.
+	if len(args) > 1 {
+		f, err := NewFormat(format, asJSON, !quiet, "text", "skeleton")
+		if err != nil {
+			return err
+		}
+		skel := unidata.Skeleton(args[0])
+		same := true
+		for _, a := range args {
+			s := unidata.Skeleton(a)
+			if s != skel {
+				same = false
+			}
+			f.Line(map[string]string{"text": a, "skeleton": s})
+		}
+		f.Print(zli.Stdout)
+		if !same {
+			return errNotConfusable
+		}
+		return nil
+	}
+
+	f, err := NewFormat(format, asJSON, !quiet, "input", "char", "wide_padding", "cpoint", "name")
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, c := range strings.Join(args, "") {
+		for _, conf := range unidata.ConfusableWith(c) {
+			found = true
+			l := toLineSeq(f, conf, raw)
+			l["input"] = string(c)
+			f.Line(l)
+		}
+	}
+	if !found {
+		return errNoMatches
+	}
+	f.Print(zli.Stdout)
+	return nil
+}
+
+func emoji(args []string, format string, quiet, raw, asJSON, or bool, tones, genders []string, maxVersion string) error {
 	type matchArg struct {
 		group bool
 		name  bool
@@ -507,6 +1704,9 @@ func emoji(args []string, format string, quiet, raw, asJSON, or bool, tones, gen
 
 	out := make([]unidata.Emoji, 0, 16)
 	for _, e := range unidata.Emojis {
+		if newerThan(e.Version, maxVersion) {
+			continue
+		}
 		m := 0
 		for _, a := range matchArgs {
 			var match bool
@@ -523,12 +1723,11 @@ func emoji(args []string, format string, quiet, raw, asJSON, or bool, tones, gen
 			if match {
 				m++
 				if or {
-					out = append(out, e)
 					break
 				}
 			}
 		}
-		if all || (!or && m == len(matchArgs)) {
+		if all || (or && m > 0) || (!or && m == len(matchArgs)) {
 			out = append(out, applyGenders(applyTones(e, tones), genders)...)
 		}
 	}
@@ -538,29 +1737,20 @@ func emoji(args []string, format string, quiet, raw, asJSON, or bool, tones, gen
 	}
 
 	f, err := NewFormat(format, asJSON, !quiet, "emoji", "name", "group", "subgroup",
-		"tab", "cldr", "cldr_full", "cpoint")
+		"tab", "cldr", "cldr_full", "cpoint", "version")
 	if err != nil {
 		return err
 	}
 	for _, e := range out {
 		f.Line(map[string]string{
-			"emoji":    e.String(),
-			"name":     e.Name,
-			"group":    e.GroupName(),
-			"subgroup": e.SubgroupName(),
-			"tab":      tabOrSpace(),
-			"cldr": func() string {
-				// Remove words that duplicate what's already in the name; it's
-				// kind of pointless.
-				cldr := make([]string, 0, len(e.CLDR))
-				for _, c := range e.CLDR {
-					if !strings.Contains(e.Name, c) {
-						cldr = append(cldr, c)
-					}
-				}
-				return strings.Join(cldr, ", ")
-			}(),
+			"emoji":     e.String(),
+			"name":      e.Name,
+			"group":     e.GroupName(),
+			"subgroup":  e.SubgroupName(),
+			"tab":       tabOrSpace(),
+			"cldr":      emojiCLDR(e),
 			"cldr_full": strings.Join(e.CLDR, ", "),
+			"version":   e.Version,
 			"cpoint": func() string {
 				cp := make([]string, 0, len(e.Codepoints))
 				for _, c := range e.String() { // String() inserts ZWJ and whatnot
@@ -574,6 +1764,55 @@ func emoji(args []string, format string, quiet, raw, asJSON, or bool, tones, gen
 	return nil
 }
 
+// emojiCLDR gets the CLDR keywords, without the words that duplicate what's
+// already in the name; it's kind of pointless.
+func emojiCLDR(e unidata.Emoji) string {
+	cldr := make([]string, 0, len(e.CLDR))
+	for _, c := range e.CLDR {
+		if !strings.Contains(e.Name, c) {
+			cldr = append(cldr, c)
+		}
+	}
+	return strings.Join(cldr, ", ")
+}
+
+// All emoji sequences of more than one codepoint, including all skin tone and
+// gender variants; these are built on first use as it's a bit slow.
+//
+// Sequences are also added without U+FE0F VARIATION SELECTOR-16, as it's
+// frequently omitted.
+var (
+	emojiSeqsOnce sync.Once
+	emojiSeqs     map[string]unidata.Emoji
+)
+
+func emojiSequences() map[string]unidata.Emoji {
+	emojiSeqsOnce.Do(buildEmojiSequences)
+	return emojiSeqs
+}
+
+func buildEmojiSequences() {
+	var (
+		seqs    = make(map[string]unidata.Emoji)
+		tones   = parseToneFlag("all")
+		genders = parseGenderFlag("all")
+	)
+	for _, e := range unidata.Emojis {
+		for _, ee := range applyGenders(applyTones(e, tones), genders) {
+			s := ee.String()
+			if utf8.RuneCountInString(s) > 1 {
+				seqs[s] = ee
+			}
+			if s = strings.ReplaceAll(s, "\ufe0f", ""); utf8.RuneCountInString(s) > 1 {
+				if _, ok := seqs[s]; !ok {
+					seqs[s] = ee
+				}
+			}
+		}
+	}
+	emojiSeqs = seqs
+}
+
 var tonemap = map[string]rune{
 	"none":        0,
 	"light":       0x1f3fb,
@@ -584,23 +1823,61 @@ var tonemap = map[string]rune{
 }
 
 // Skintone always comes after the base emoji and doesn't required a ZWJ.
+//
+// Tones can be given as "light:dark" to give a different tone to every person
+// in emoji with two people, such as "kiss"; only the first tone is used for
+// other emojis.
 func applyTones(e unidata.Emoji, tones []string) []unidata.Emoji {
 	if !e.SkinTones || len(tones) == 0 {
 		return []unidata.Emoji{e}
 	}
 
-	emojis := make([]unidata.Emoji, len(tones))
-	for i, t := range tones {
-		emojis[i] = e // This makes a copy, but beware of directly modifying lists as they're pointers.
+	var (
+		emojis = make([]unidata.Emoji, 0, len(tones))
+		seen   = make(map[string]bool)
+	)
+	for _, t := range tones {
+		first, second := t, t
+		if i := strings.IndexByte(t, ':'); i > -1 {
+			first, second = t[:i], t[i+1:]
+		}
+		if len(e.MultiTone) == 0 {
+			second = first
+		}
+		if seen[first+":"+second] {
+			continue
+		}
+		seen[first+":"+second] = true
 
-		if tcp := tonemap[t]; tcp > 0 {
-			emojis[i].Name += fmt.Sprintf(": %s skin tone", t)
-			emojis[i].Codepoints = append(append([]rune{e.Codepoints[0]}, tcp), e.Codepoints[1:]...)
-			l := len(emojis[i].Codepoints) - 1
-			if emojis[i].Codepoints[l] == 0xfe0f {
-				emojis[i].Codepoints = emojis[i].Codepoints[:l]
+		ee := e // This makes a copy, but beware of directly modifying lists as they're pointers.
+		switch {
+		case tonemap[first] == 0:
+		case len(e.MultiTone) == 0 || (first == second && len(e.Codepoints) == 1):
+			ee.Name += fmt.Sprintf(": %s skin tone", first)
+			ee.Codepoints = append(append([]rune{e.Codepoints[0]}, tonemap[first]), e.Codepoints[1:]...)
+			l := len(ee.Codepoints) - 1
+			if ee.Codepoints[l] == 0xfe0f {
+				ee.Codepoints = ee.Codepoints[:l]
+			}
+		default:
+			// Add the tones after the first and last person:
+			//   1F469 1F3FB 200D 1F91D 200D 1F469 1F3FF   # 👩🏻‍🤝‍👩🏿 E12.1 women holding hands: light skin tone, dark skin tone
+			sep, name := ": ", first+" skin tone"
+			if strings.Contains(ee.Name, ":") {
+				sep = ", "
+			}
+			if first != second {
+				name += ", " + second + " skin tone"
 			}
+			ee.Name += sep + name
+
+			l := len(e.MultiTone) - 1
+			ee.Codepoints = make([]rune, 0, len(e.MultiTone)+2)
+			ee.Codepoints = append(ee.Codepoints, e.MultiTone[0], tonemap[first])
+			ee.Codepoints = append(ee.Codepoints, e.MultiTone[1:l+1]...)
+			ee.Codepoints = append(ee.Codepoints, tonemap[second])
 		}
+		emojis = append(emojis, ee)
 	}
 
 	return emojis
@@ -13,12 +13,53 @@ import (
 	"zgo.at/zstd/ztest"
 )
 
+func TestMain(m *testing.M) {
+	// Use our own Compose files rather than the system ones.
+	os.Setenv("XLOCALEDIR", "testdata/compose")
+	os.Setenv("XCOMPOSEFILE", "testdata/compose/XCompose")
+	os.Setenv("LC_CTYPE", "en_US.UTF-8")
+	os.Unsetenv("LC_ALL")
+	os.Exit(m.Run())
+}
+
+// mainTest is a test for main(), with the arguments in in; -q is added to the
+// arguments.
+type mainTest struct {
+	in       []string
+	want     string
+	wantExit int
+}
+
+// runMain runs all the tests, and checks the output and exit code.
+func runMain(t *testing.T, tests []mainTest) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			exit, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"testuni", "-q"}, tt.in...)
+
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+
+			if int(*exit) != tt.wantExit {
+				t.Fatalf("wrong exit: %d", *exit)
+			}
+			if d := ztest.Diff(outbuf.String(), tt.want); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
 func TestCLI(t *testing.T) {
 	tests := []struct {
 		in   []string
 		want string
 	}{
 		{[]string{"xxx"}, "uni: unknown command"},
+		{[]string{"c"}, "uni: ambiguous command"},
 		//{[]string{""}, "uni: unknown command"},
 		//{[]string{}, "Show this help"},
 		{[]string{"e", "-t"}, "testuni: -t: needs an argument"},
@@ -27,6 +68,7 @@ func TestCLI(t *testing.T) {
 		{[]string{"e", "-t", "xx"}, "invalid skin"},
 		{[]string{"e", "-gender", "xx"}, "invalid gender"},
 		{[]string{"e", "-g", "xxsxxxx"}, `invalid gender: "xxsxxxx"`},
+		{[]string{"case", "-to", "xx"}, `invalid case mapping: "xx"`},
 	}
 
 	for _, tt := range tests {
@@ -63,6 +105,16 @@ func TestIdentify(t *testing.T) {
 		{[]string{"i", ""}, ""},
 		{[]string{"i", "a"}, "SMALL LETTER A"},
 		{[]string{"i", `"`}, "&quot;"}, // Make sure it uses the lower-case and short variant.
+		{[]string{"i", "一"}, "CJK UNIFIED IDEOGRAPH-4E00"},
+		{[]string{"i", "-f", "%(name) %(jamo)", "각"}, "HANGUL SYLLABLE GAG U+1100 U+1161 U+11A8"},
+		{[]string{"i", "-f", "%(name) %(jamo)", "가"}, "HANGUL SYLLABLE GA U+1100 U+1161\n"},
+		{[]string{"i", "-f", "%(name) %(cpoint)", "\u0b95\u0bcd\u0bb7"}, "TAMIL SYLLABLE KSSA U+0B95 U+0BCD U+0BB7\n"},
+		{[]string{"i", "-f", "%(name)", "\u0b95\u0bcd\u0bb7\u0bbe\u0b95"}, "TAMIL SYLLABLE KSSAA\nTAMIL LETTER KA\n"},
+		{[]string{"i", "-f", "%(name)", "-no-emoji", "1\ufe0f\u20e3"}, "KEYCAP DIGIT ONE\n"},
+		{[]string{"i", "-f", "%(name) (%(cat))", "\u0b95\u0bcd\u0bb7"}, "TAMIL SYLLABLE KSSA (sequence)\n"},
+		{[]string{"i", "-f", "%(keysym)|%(keysyms)", "\u00d8"}, "Oslash|Oslash, Ooblique\n"},
+		{[]string{"i", "-f", "%(html)|%(html_all)", "<"}, "&lt;|&lt;, &LT;\n"},
+		{[]string{"i", "-f", "%(cpoint)|%(html)|%(html_all)", "\u2242\u0338"}, "U+2242 U+0338|&nesim;|&nesim;, &NotEqualTilde;\n"},
 	}
 
 	for _, tt := range tests {
@@ -95,11 +147,26 @@ func TestSearch(t *testing.T) {
 		{[]string{"s", ""}, "need search term", 1, 1},
 
 		{[]string{"-q", "s", "asterism"}, "ASTERISM", 1, -1},
+		{[]string{"-q", "se", "asterism"}, "ASTERISM", 1, -1},
+		{[]string{"-q", "seg", "asterism"}, "'asterism'", 1, -1},
 		{[]string{"-q", "s", "floral"}, "HEART", 3, -1},
 		{[]string{"-q", "s", "floral", "bullet"}, "HEART", 2, -1},
 		{[]string{"-q", "s", "rightwards arrow", "heavy"}, "HEAVY", 16, -1},
 
 		{[]string{"-qo", "s", "floral", "bullet"}, "WHITE BULLET", 15, -1},
+		{[]string{"-q", "s", "disguised"}, "DISGUISED FACE", 1, -1},
+		{[]string{"-q", "s", "-max-version", "12.1", "disguised"}, "", 0, 1},
+		{[]string{"-q", "s", "zwj"}, "ZERO WIDTH JOINER", 2, -1},
+		{[]string{"-q", "s", "apostrophe-quote"}, "APOSTROPHE", 1, -1},
+		{[]string{"-q", "s", "latin", "letter gha"}, "LATIN CAPITAL LETTER OI", 2, -1},
+
+		{[]string{"-q", "s", "hangul syllable gag"}, "HANGUL SYLLABLE GAGG", 3, -1},
+		{[]string{"-q", "s", "ideograph-4e00"}, "SQUARED CJK UNIFIED IDEOGRAPH-4E00", 2, -1},
+		{[]string{"-q", "s", "tangut ideograph-18d0"}, "TANGUT IDEOGRAPH-18D08", 9, -1},
+
+		{[]string{"-q", "s", "-f", "%(name) %(cpoint)", "keycap digit one"}, "KEYCAP DIGIT ONE U+0031 U+FE0F U+20E3", 1, -1},
+		{[]string{"-q", "s", "tamil syllable kssa"}, "TAMIL SYLLABLE KSSAU", 4, -1},
+		{[]string{"-q", "s", "-f", "%(name) (%(cat))", "tamil syllable kssa"}, "TAMIL SYLLABLE KSSA (sequence)\n", 4, -1},
 
 		{[]string{"s", "nomatch_nomatch"}, "no matches", 1, 1},
 		{[]string{"-q", "s", "nomatch_nomatch"}, "", 0, 1},
@@ -150,6 +217,29 @@ func TestPrint(t *testing.T) {
 		{[]string{"-q", "p", "0d90"}, "CAPITAL LETTER Z", 1, -1},
 		{[]string{"-q", "p", "0D90"}, "CAPITAL LETTER Z", 1, -1},
 
+		{[]string{"-q", "p", "name:NBSP"}, "NO-BREAK SPACE", 1, -1},
+		{[]string{"-q", "p", "name:bom", "name:ZWJ"}, "ZERO WIDTH JOINER", 2, -1},
+		{[]string{"-q", "p", "name:latin capital letter gha"}, "LATIN CAPITAL LETTER OI", 1, -1},
+		{[]string{"-q", "p", "name:euro sign"}, "EURO SIGN", 1, -1},
+		{[]string{"-q", "p", "name:apostrophe-quote"}, "APOSTROPHE", 1, -1},
+		{[]string{"p", "name:nonsense"}, `unknown name: "nonsense"`, 1, 1},
+		{[]string{"-q", "p", `\N{EURO SIGN}`}, "EURO SIGN", 1, -1},
+		{[]string{"p", `\N{nonsense}`}, `unknown name: "nonsense"`, 1, 1},
+
+		{[]string{"-q", "p", "&euro;"}, "EURO SIGN", 1, -1},
+		{[]string{"-q", "p", "&#x20AC;"}, "EURO SIGN", 1, -1},
+		{[]string{"-q", "p", "&#8364;"}, "EURO SIGN", 1, -1},
+		{[]string{"-q", "p", "&NotEqualTilde;"}, "MINUS TILDE", 2, -1},
+		{[]string{"-q", "p", "&nesim;"}, "COMBINING LONG SOLIDUS OVERLAY", 2, -1},
+		{[]string{"p", "&nonsense;"}, `unknown HTML entity: "&nonsense;"`, 1, 1},
+		{[]string{"-q", "p", "keysym:EuroSign"}, "EURO SIGN", 1, -1},
+		{[]string{"p", "keysym:eurosign"}, `unknown keysym: "eurosign"`, 1, 1},
+		{[]string{"-q", "p", "digraph:=e"}, "EURO SIGN", 1, -1},
+		{[]string{"p", "digraph:=x"}, `unknown digraph: "=x"`, 1, 1},
+		{[]string{"-q", "p", "\u20ac"}, "EURO SIGN", 1, -1},
+		{[]string{"-q", "p", "+"}, "PLUS SIGN", 1, -1},
+		{[]string{"-q", "p", "a"}, "LINE FEED", 1, -1},
+
 		{[]string{"p", ""}, `invalid codepoint: not a number or codepoint: ""`, 1, 1},
 		{[]string{"p", "nonsense"}, `invalid codepoint: not a number or codepoint: "nonsense"`, 1, 1},
 		{[]string{"p", "2042..xxx"}, `invalid codepoint: not a number or codepoint: "xxx"`, 1, 1},
@@ -157,11 +247,29 @@ func TestPrint(t *testing.T) {
 		{[]string{"p", "xxx..xxx"}, `invalid codepoint: not a number or codepoint: "xxx"`, 1, 1},
 
 		{[]string{"-q", "p", "U+3402"}, "'㐂'", 1, -1},
-		{[]string{"-q", "p", "U+3402..U+3404"}, "<CJK Ideograph Extension A>", 3, -1},
-		{[]string{"-q", "p", "OtherPunctuation"}, "ASTERISM", 593, -1},
-		{[]string{"-q", "p", "Po"}, "ASTERISM", 593, -1},
+		{[]string{"-q", "p", "U+3402..U+3404"}, "CJK UNIFIED IDEOGRAPH-3403", 3, -1},
+		{[]string{"-q", "p", "name:hangul syllable hih"}, "U+D7A3", 1, -1},
+		{[]string{"-q", "p", "name:CJK UNIFIED IDEOGRAPH-3134A"}, "U+3134A", 1, -1},
+		{[]string{"-q", "p", "OtherPunctuation"}, "ASTERISM", 605, -1},
+		{[]string{"-q", "p", "Po"}, "ASTERISM", 605, -1},
 		{[]string{"-q", "p", "GeneralPunctuation"}, "ASTERISM", 111, -1},
-		{[]string{"-q", "p", "all"}, "ASTERISM", 33797, -1},
+		{[]string{"-q", "p", "all"}, "ASTERISM", 34626, -1},
+		{[]string{"-q", "p", "White_Space"}, "NO-BREAK SPACE", 25, -1},
+		{[]string{"-q", "p", "age:13.0"}, "DISGUISED FACE", 957, -1},
+		{[]string{"-q", "p", "age:14.0"}, "MELTING FACE", 832, -1},
+		{[]string{"-q", "p", "age:<=1.1", "-max-version", "1.1"}, "LATIN CAPITAL LETTER A", 6678, -1},
+		{[]string{"-q", "p", "age:>=13.0", "-max-version", "12.1"}, "", 0, -1},
+		{[]string{"-q", "p", "1F978", "-max-version", "12.1"}, "", 0, -1},
+		{[]string{"-q", "p", "1FAE0", "-max-version", "13.0"}, "", 0, -1},
+		{[]string{"p", "age:xx"}, `invalid age: "xx"`, 1, 1},
+		{[]string{"-q", "p", "Cyrillic"}, "CYRILLIC CAPITAL LETTER A", 443, -1},
+		{[]string{"-q", "p", "cyrl"}, "CYRILLIC CAPITAL LETTER A", 443, -1},
+		{[]string{"-q", "p", "block:Cyrillic"}, "CYRILLIC CAPITAL LETTER A", 256, -1},
+		{[]string{"-q", "p", "Old_Italic"}, "OLD ITALIC LETTER A", 39, -1},
+		{[]string{"-q", "p", "wspace"}, "NO-BREAK SPACE", 25, -1},
+		{[]string{"-q", "p", "dash"}, "HYPHEN-MINUS", 30, -1},
+		{[]string{"-q", "p", "Emoji_Presentation"}, "WATCH", 1185, -1},
+		{[]string{"-q", "p", "epres"}, "WATCH", 1185, -1},
 
 		{[]string{"-q", "-r", "p", "U9"}, "'\t'", 1, -1},
 	}
@@ -191,6 +299,454 @@ func TestPrint(t *testing.T) {
 	}
 }
 
+func TestCase(t *testing.T) {
+	runMain(t, []mainTest{
+		{[]string{"case", "-to", "upper", "straße"}, "upper  STRASSE\n", -1},
+		{[]string{"case", "-to", "title", "straße"}, "title  Straße\n", -1},
+		{[]string{"case", "-to", "fold", "straße"}, "fold  strasse\n", -1},
+		{[]string{"case", "-to", "lower", "ΌΣΟΣ"}, "lower  όσος\n", -1},
+		{[]string{"case", "-to", "upper,lower", "-lang", "tr", "Iİi"},
+			"upper  Iİİ\nlower  ıii\n", -1},
+		{[]string{"case", "-to", "lower", "-lang", "lt", "Ì"}, "lower  i\u0307\u0300\n", -1},
+	})
+}
+
+func TestNameAliases(t *testing.T) {
+	runMain(t, []mainTest{
+		{[]string{"i", "\u01a2", "-f", "%(alias)|%(abbr)"}, "LATIN CAPITAL LETTER GHA|\n", -1},
+		{[]string{"i", "\ufeff", "-f", "%(alias)|%(abbr)"}, "BYTE ORDER MARK|BOM, ZWNBSP\n", -1},
+		{[]string{"i", "\t", "-f", "%(alias)|%(abbr)"}, "CHARACTER TABULATION, HORIZONTAL TABULATION|HT, TAB\n", -1},
+		{[]string{"i", "\u200d", "-f", "%(abbr)", "-j"}, "[{\n\t\"abbr\": [\n\t\t\"ZWJ\"\n\t]\n}]\n", -1},
+	})
+}
+
+func TestMaxVersion(t *testing.T) {
+	runMain(t, []mainTest{
+		{[]string{"i", "-max-version", "12.0", "-f", "%(char) %(age)", "a\U0001f978"}, "" +
+			"uni: WARNING: U+1F978 DISGUISED FACE is from Unicode 13.0, which is newer than 12.0\n" +
+			"a 1.1\n" +
+			"\U0001f978 13.0\n", -1},
+		{[]string{"i", "-max-version", "13.0", "-f", "%(char) %(age)", "a\U0001f978"}, "" +
+			"a 1.1\n" +
+			"\U0001f978 13.0\n", -1},
+		{[]string{"e", "-f", "%(emoji) %(version)", "disguised"}, "🥸 13.0\n", -1},
+	})
+}
+
+func TestScripts(t *testing.T) {
+	runMain(t, []mainTest{
+		{[]string{"i", "-scripts", "Hello, мир!"}, "" +
+			"Latin    Latn 5  'Hello'\n" +
+			"Common   Zyyy 3  ', !'\n" +
+			"Cyrillic Cyrl 3  'мир'\n", -1},
+		{[]string{"i", "-f", "%(script)|%(scriptx)", "a\u0483\u3001"}, "" +
+			"Latin|Latin\n" +
+			"Cyrillic|Cyrillic, Old_Permic\n" +
+			"Common|Bopomofo, Han, Hangul, Hiragana, Katakana, Yi\n", -1},
+	})
+}
+
+func TestNormalize(t *testing.T) {
+	runMain(t, []mainTest{
+		{[]string{"norm", "abc"}, "" +
+			"NFC  yes 'abc' U+0061 U+0062 U+0063\n" +
+			"NFD  yes 'abc' U+0061 U+0062 U+0063\n" +
+			"NFKC yes 'abc' U+0061 U+0062 U+0063\n" +
+			"NFKD yes 'abc' U+0061 U+0062 U+0063\n", -1},
+		{[]string{"norm", "-f", "%(form) %(normalized) %(text) %(cpoint)", "\u00c5\ufb01"}, "" +
+			"NFC yes \u00c5\ufb01 U+00C5 U+FB01\n" +
+			"NFD no A\u030a\ufb01 U+0041 U+030A U+FB01\n" +
+			"NFKC no \u00c5fi U+00C5 U+0066 U+0069\n" +
+			"NFKD no A\u030afi U+0041 U+030A U+0066 U+0069\n", 1},
+		{[]string{"norm", "-form", "nfc,NFKC", "A\u030a"}, "" +
+			"NFC  no '\u00c5' U+00C5\n" +
+			"NFKC no '\u00c5' U+00C5\n", 1},
+		{[]string{"norm", "-form", "nfc", "-f", "%(quick_check) %(normalized)", "\u00e9"}, "yes yes\n", -1},
+		{[]string{"norm", "-form", "nfc", "-f", "%(quick_check) %(normalized)", "e\u0301"}, "maybe no\n", 1},
+		{[]string{"norm", "-form", "nfc", "-f", "%(quick_check) %(normalized)", "\u0323\u0301"}, "maybe yes\n", -1},
+		{[]string{"norm", "-form", "nfc", "-f", "%(quick_check) %(normalized)", "\u0301\u0323"}, "no no\n", 1},
+		{[]string{"norm", "-form", "nfc", "-f", "%(text) %(cpoint)", "\u1100\u1161\u11a8"}, "\uac01 U+AC01\n", 1},
+		{[]string{"norm", "-form", "nfd", "-f", "%(text) %(cpoint)", "\uac01"}, "\u1100\u1161\u11a8 U+1100 U+1161 U+11A8\n", 1},
+		{[]string{"norm", "-form", "nfd", "-explain", "-f", "%(cpoint) %(ccc) %(name)", "\u1e69"}, "" +
+			"U+0073 0 LATIN SMALL LETTER S\n" +
+			"U+0323 220 COMBINING DOT BELOW\n" +
+			"U+0307 230 COMBINING DOT ABOVE\n", 1},
+		{[]string{"norm", "-explain", "x"}, "testuni: normalize: -explain needs a single -form\n", 1},
+		{[]string{"norm", "-form", "nfx", "x"}, "testuni: invalid normalization form: \"nfx\"\n", 1},
+		{[]string{"i", "-f", "%(decomp)|%(ccc)", "\u00a0\u00c5\u0301"}, "" +
+			"<noBreak> U+0020|0\n" +
+			"U+0041 U+030A|0\n" +
+			"|230\n", -1},
+	})
+}
+
+func TestEmojiSequences(t *testing.T) {
+	runMain(t, []mainTest{
+		{[]string{"i", "-f", "%(char q) %(cpoint) %(name) (%(cat))", "a\U0001f9d1\u200d\U0001f692\U0001f1f3\U0001f1f1"}, "" +
+			"'a' U+0061 LATIN SMALL LETTER A (Lowercase_Letter)\n" +
+			"'\U0001f9d1\u200d\U0001f692' U+1F9D1 U+200D U+1F692 firefighter (People & Body)\n" +
+			"'\U0001f1f3\U0001f1f1' U+1F1F3 U+1F1F1 flag: Netherlands (Flags)\n", -1},
+
+		// Skin tone and gender variants, and without the variation selector.
+		{[]string{"i", "-f", "%(cpoint) %(name)", "\U0001f469\U0001f3fd\u200d\U0001f692 \U0001f937\U0001f3fb\u200d\u2642\ufe0f \U0001f937\U0001f3fb\u200d\u2642"}, "" +
+			"U+1F469 U+1F3FD U+200D U+1F692 woman firefighter: medium skin tone\n" +
+			"U+0020 SPACE\n" +
+			"U+1F937 U+1F3FB U+200D U+2642 U+FE0F man shrugging: light skin tone\n" +
+			"U+0020 SPACE\n" +
+			"U+1F937 U+1F3FB U+200D U+2642 man shrugging: light skin tone\n", -1},
+
+		{[]string{"i", "-f", "%(name) | %(group) | %(subgroup) | %(cldr)", "1\ufe0f\u20e3"},
+			"keycap: 1 | Symbols | keycap | \n", -1},
+		{[]string{"i", "-f", "%(name) | %(group) | %(subgroup) | %(cldr)", "\U0001f44d\U0001f3ff"},
+			"thumbs up: dark skin tone | People & Body | hand-fingers-closed | +1, hand\n", -1},
+
+		// Every codepoint on its own row if it doesn't fit in the columns.
+		{[]string{"i", "-f", "%(char q l:4) %(cpoint l:7) %(dec l:6) %(name)", "a\U0001f1f3\U0001f1f1"}, "" +
+			"'a' U+0061  97     LATIN SMALL LETTER A\n" +
+			"'\U0001f1f3\U0001f1f1'                flag: Netherlands\n" +
+			"    '\U0001f1f3' U+1F1F3 127475 REGIONAL INDICATOR SYMBOL LETTER N\n" +
+			"    '\U0001f1f1' U+1F1F1 127473 REGIONAL INDICATOR SYMBOL LETTER L\n", -1},
+
+		// Single codepoints and unknown sequences are unchanged.
+		{[]string{"i", "-f", "%(cpoint) %(name)", "\U0001f600\U0001f468\u200d\U0001f469"}, "" +
+			"U+1F600 GRINNING FACE\n" +
+			"U+1F468 MAN\n" +
+			"U+200D ZERO WIDTH JOINER\n" +
+			"U+1F469 WOMAN\n", -1},
+
+		{[]string{"i", "-no-emoji", "-f", "%(cpoint) %(name)", "\U0001f9d1\u200d\U0001f692"}, "" +
+			"U+1F9D1 ADULT\n" +
+			"U+200D ZERO WIDTH JOINER\n" +
+			"U+1F692 FIRE ENGINE\n", -1},
+
+		{[]string{"i", "-no-emoji", "-f", "%(char q)%(wide_padding)|%(emoji_props)|%(presentation)", "a1\u263a\u231a\U0001f1f3"}, "" +
+			"'a' ||\n" +
+			"'1' |Emoji, Emoji_Component|text\n" +
+			"'\u263a' |Emoji, Extended_Pictographic|text\n" +
+			"'\u231a'|Emoji, Emoji_Presentation, Extended_Pictographic|emoji\n" +
+			"'\U0001f1f3'|Emoji, Emoji_Presentation, Emoji_Component|emoji\n", -1},
+
+		{[]string{"i", "-graphemes", "-f", "%(cpoint) %(name)", "\U0001f1f3\U0001f1f1"}, "" +
+			"U+1F1F3 U+1F1F1 flag: Netherlands\n" +
+			"    U+1F1F3 REGIONAL INDICATOR SYMBOL LETTER N\n" +
+			"    U+1F1F1 REGIONAL INDICATOR SYMBOL LETTER L\n", -1},
+	})
+}
+
+func TestGraphemes(t *testing.T) {
+	runMain(t, []mainTest{
+		{[]string{"i", "-graphemes", "-no-emoji", "-f", "%(char q) %(cpoint) %(name)", "e\u0301a\U0001f468\u200d\U0001f469 \U0001f1f3\U0001f1f1\r\n"}, "" +
+			"'e\u0301' U+0065 U+0301 LATIN SMALL LETTER E + COMBINING ACUTE ACCENT\n" +
+			"    'e' U+0065 LATIN SMALL LETTER E\n" +
+			"    '\u25cc\u0301' U+0301 COMBINING ACUTE ACCENT\n" +
+			"'a' U+0061 LATIN SMALL LETTER A\n" +
+			"'\U0001f468\u200d\U0001f469' U+1F468 U+200D U+1F469 MAN + ZERO WIDTH JOINER + WOMAN\n" +
+			"    '\U0001f468' U+1F468 MAN\n" +
+			"    '\ufffd' U+200D ZERO WIDTH JOINER\n" +
+			"    '\U0001f469' U+1F469 WOMAN\n" +
+			"' ' U+0020 SPACE\n" +
+			"'\U0001f1f3\U0001f1f1' U+1F1F3 U+1F1F1 REGIONAL INDICATOR SYMBOL LETTER N + REGIONAL INDICATOR SYMBOL LETTER L\n" +
+			"    '\U0001f1f3' U+1F1F3 REGIONAL INDICATOR SYMBOL LETTER N\n" +
+			"    '\U0001f1f1' U+1F1F1 REGIONAL INDICATOR SYMBOL LETTER L\n" +
+			"'\r\n' U+000D U+000A CARRIAGE RETURN (CR) + LINE FEED (LF)\n" +
+			"    '\u240d' U+000D CARRIAGE RETURN (CR)\n" +
+			"    '\u240a' U+000A LINE FEED (LF)\n", -1},
+
+		// Columns also work without -graphemes.
+		{[]string{"i", "-f", "%(cluster_index) %(grapheme) %(cpoint)", "e\u0301a\u1100\u1161\u11a8"}, "" +
+			"1 e\u0301 U+0065\n" +
+			"1 e\u0301 U+0301\n" +
+			"2 a U+0061\n" +
+			"3 \u1100\u1161\u11a8 U+1100\n" +
+			"3 \u1100\u1161\u11a8 U+1161\n" +
+			"3 \u1100\u1161\u11a8 U+11A8\n", -1},
+
+		{[]string{"i", "-graphemes", "-j", "-f", "%(grapheme) %(cpoint)", "e\u0301a"}, "" +
+			`[{` + "\n" +
+			`	"codepoints": [` + "\n" +
+			`		{` + "\n" +
+			`			"cpoint": "U+0065",` + "\n" +
+			"\t\t\t\"grapheme\": \"e\u0301\"" + "\n" +
+			`		},` + "\n" +
+			`		{` + "\n" +
+			`			"cpoint": "U+0301",` + "\n" +
+			"\t\t\t\"grapheme\": \"e\u0301\"" + "\n" +
+			`		}` + "\n" +
+			`	],` + "\n" +
+			`	"cpoint": "U+0065 U+0301",` + "\n" +
+			"\t\"grapheme\": \"e\u0301\"" + "\n" +
+			`}, {` + "\n" +
+			`	"codepoints": [` + "\n" +
+			`		{` + "\n" +
+			`			"cpoint": "U+0061",` + "\n" +
+			`			"grapheme": "a"` + "\n" +
+			`		}` + "\n" +
+			`	],` + "\n" +
+			`	"cpoint": "U+0061",` + "\n" +
+			`	"grapheme": "a"` + "\n" +
+			`}]` + "\n", -1},
+	})
+}
+
+func TestNumber(t *testing.T) {
+	runMain(t, []mainTest{
+		{[]string{"number", "42", "\u0661\u0662\u066b\u0665", "\u0967\u0968\u0969", "\uff11\uff12", "\U0001d7cf\U0001d7d0"}, "" +
+			"'42'  42\n" +
+			"'\u0661\u0662\u066b\u0665'  12.5\n" +
+			"'\u0967\u0968\u0969'  123\n" +
+			"'\uff11\uff12'  12\n" +
+			"'\U0001d7cf\U0001d7d0'  12\n", -1},
+		{[]string{"number", "\u216f\u216f\u2169\u2169\u2160", "\u2169\u2163", "\u00bd", "3\u00bd", "\u2153", "\u2473", "\u4e94"}, "" +
+			"'\u216f\u216f\u2169\u2169\u2160'  2021\n" +
+			"'\u2169\u2163'  14\n" +
+			"'\u00bd'  0.5\n" +
+			"'3\u00bd'  3.5\n" +
+			"'\u2153'  0.333333333333\n" +
+			"'\u2473'  20\n" +
+			"'\u4e94'  5\n", -1},
+		{[]string{"number", "-f", "%(number)", "+1.50", "\u2212\u0968"}, "1.5\n-2\n", -1},
+		{[]string{"number", "1\u0662"}, "testuni: number: cannot parse \"1\u0662\": mixed digits from different sets at position 2: '\u0662'\n", 1},
+		{[]string{"number", "1.2.3"}, "testuni: number: cannot parse \"1.2.3\": more than one decimal separator at position 4\n", 1},
+		{[]string{"number", "12a"}, "testuni: number: cannot parse \"12a\": not a number at position 3: 'a'\n", 1},
+		{[]string{"number", "-f", "%(number)", "1,000", "1,234,567", "\u0661\u066c\u0662\u0663\u0664\u066b\u0665", "\u00b9\u00b2\u00b3", "\u00b9\u2070\u2074"}, "" +
+			"1000\n1234567\n1234.5\n123\n104\n", -1},
+		{[]string{"number", "1,5"}, "testuni: number: cannot parse \"1,5\": thousands separator not between groups of three digits\n", 1},
+		{[]string{"number", "1234,567"}, "testuni: number: cannot parse \"1234,567\": thousands separator not between groups of three digits\n", 1},
+		{[]string{"number", "1.234,5"}, "testuni: number: cannot parse \"1.234,5\": thousands separator after the decimal separator at position 6\n", 1},
+		{[]string{"number", "-f", "%(number)", "\u2169\u2160\u2169", "\u216b\u2160", "\u2170\u2179"}, "19\n13\n9\n", -1},
+		{[]string{"number", "\u2160\u2160\u2169"}, "testuni: number: cannot parse \"\u2160\u2160\u2169\": invalid Roman numeral: \"\u2160\u2160\u2169\"\n", 1},
+		{[]string{"number", "\u2164\u2164"}, "testuni: number: cannot parse \"\u2164\u2164\": invalid Roman numeral: \"\u2164\u2164\"\n", 1},
+		{[]string{"i", "-f", "%(numtype)|%(numval)", "\u00bd5a\u4eac"}, "Numeric|1/2\nDecimal|5\n|\n|\n", -1},
+	})
+}
+
+func TestBidi(t *testing.T) {
+	runMain(t, []mainTest{
+		{[]string{"bidi", "hello \u05e9\u05dc\u05d5\u05dd 123", "\u0645\u0631\u062d\u0628\u0627 123 world"}, "" +
+			"'hello \u05e9\u05dc\u05d5\u05dd 123'  ltr  00000011111222  'hello 123 \u05dd\u05d5\u05dc\u05e9'\n" +
+			"'\u0645\u0631\u062d\u0628\u0627 123 world'  rtl  111111222122222  'world 123 \u0627\u0628\u062d\u0631\u0645'\n", -1},
+
+		// Brackets are mirrored.
+		{[]string{"bidi", "a \u2067\u05d0\u05d1 (\u05d2)!\u2069 c"},
+			"'a [RLI]\u05d0\u05d1 (\u05d2)![PDI] c'  ltr  0001111111000  'a [RLI]!(\u05d2) \u05d1\u05d0[PDI] c'\n", -1},
+
+		// Overrides and embeddings are removed.
+		{[]string{"bidi", "a\u202eabc\u202c d"}, "'a[RLO]abc[PDF] d'  ltr  0x111x00  'acba d'\n", -1},
+		{[]string{"bidi", "-raw", "-f", "%(visual)", "a\u202eabc\u202c d"}, "acba d\n", -1},
+		{[]string{"bidi", "-dir", "rtl", "-f", "%(dir) %(levels) %(visual)", "abc 12"}, "rtl 222222 abc 12\n", -1},
+		{[]string{"bidi", "-dir", "up", "x"}, "testuni: invalid -dir: \"up\"\n", 1},
+
+		{[]string{"bidi", "-explain", "-f", "%(line) %(cpoint) %(bidi) %(level) %(order) %(explicit)", "a\u202eb\u2067c\u2069\u202c", "x"}, "" +
+			"1 U+0061 L 0 1 \n" +
+			"1 U+202E RLO x  \n" +
+			"1 U+0062 L 1 4 RLO\n" +
+			"1 U+2067 RLI 1 3 RLO\n" +
+			"1 U+0063 L 4 2 RLO > RLI\n" +
+			"1 U+2069 PDI 0 5 RLO\n" +
+			"1 U+202C PDF x  RLO\n" +
+			"2 U+0078 L 0 1 \n", -1},
+
+		{[]string{"i", "-f", "%(bidi) %(mirror)", "(a\u221b"}, "ON U+0029\nL \nON yes\n", -1},
+	})
+}
+
+func TestSegment(t *testing.T) {
+	runMain(t, []mainTest{
+		{[]string{"segment", "Hello, w\u00f6rld", "3.14"}, "" +
+			" 0  0  'Hello'\n" +
+			" 5  5  ','\n" +
+			" 6  6  ' '\n" +
+			" 7  7  'w\u00f6rld'\n" +
+			"13 12  ' '\n" +
+			"14 13  '3.14'\n", -1},
+
+		{[]string{"segment", "-by", "sentence", "-f", "%(rune) %(segment)", "Mr. Smith left. He said \"hi.\" then left.\nOK"}, "" +
+			"0 Mr. \n" +
+			"4 Smith left. \n" +
+			"16 He said \"hi.\" then left.\n\n" +
+			"41 OK\n", -1},
+
+		{[]string{"segment", "-by", "grapheme", "-f", "%(byte) %(bytes) %(runes) %(segment)", "e\u0301\U0001f1f3\U0001f1f1x"}, "" +
+			"0 3 2 e\u0301\n" +
+			"3 8 2 \U0001f1f3\U0001f1f1\n" +
+			"11 1 1 x\n", -1},
+
+		{[]string{"segment", "-by", "line", "-f", "%(segment q)", "The (\u201cquick\u201d) fox can\u2019t jump 32.3 feet, $(12.35) right?"}, "" +
+			"'The '\n" +
+			"'(\u201cquick\u201d) '\n" +
+			"'fox '\n" +
+			"'can\u2019t '\n" +
+			"'jump '\n" +
+			"'32.3 '\n" +
+			"'feet, '\n" +
+			"'$(12.35) '\n" +
+			"'right?'\n", -1},
+
+		{[]string{"segment", "-j", "-f", "%(segment) %(byte) %(rune)", "a b"}, "" +
+			`[{` + "\n" +
+			`	"byte": "0",` + "\n" +
+			`	"rune": "0",` + "\n" +
+			`	"segment": "a"` + "\n" +
+			`}, {` + "\n" +
+			`	"byte": "1",` + "\n" +
+			`	"rune": "1",` + "\n" +
+			`	"segment": " "` + "\n" +
+			`}, {` + "\n" +
+			`	"byte": "2",` + "\n" +
+			`	"rune": "2",` + "\n" +
+			`	"segment": "b"` + "\n" +
+			`}]` + "\n", -1},
+
+		{[]string{"segment", "-by", "paragraph", "x"}, "testuni: invalid -by: \"paragraph\"\n", 1},
+	})
+}
+
+func TestWrap(t *testing.T) {
+	runMain(t, []mainTest{
+		{[]string{"wrap", "-width", "20", "The quick (\u201cbrown\u201d) fox can\u2019t jump 32.3 feet, right?\nOK"}, "" +
+			"19  allowed    'The quick (\u201cbrown\u201d)'\n" +
+			"19  allowed    'fox can\u2019t jump 32.3'\n" +
+			"12  mandatory  'feet, right?'\n" +
+			" 2  end        'OK'\n", -1},
+
+		// Wide characters are two columns.
+		{[]string{"wrap", "-width", "10", "-f", "%(width) %(text)", "\u65e5\u672c\u8a9e\u306e\u30c6\u30ad\u30b9\u30c8\u3067\u3059\u3002"}, "" +
+			"10 \u65e5\u672c\u8a9e\u306e\u30c6\n" +
+			"8 \u30ad\u30b9\u30c8\u3067\n" +
+			"4 \u3059\u3002\n", -1},
+
+		// Segments that are too long go over the width.
+		{[]string{"wrap", "-width", "5", "-f", "%(line) %(text) %(breaks)", "a verylongword b c"}, "" +
+			"1 a a\n" +
+			"2 verylongword verylongword\n" +
+			"3 b c b \u00f7c\n", -1},
+
+		{[]string{"wrap", "-width", "0", "x"}, "testuni: wrap: invalid -width: 0\n", 1},
+	})
+}
+
+func TestCompose(t *testing.T) {
+	runMain(t, []mainTest{
+		{[]string{"i", "-f", "%(compose)", "\u20ac#\u2713"}, "" +
+			"<Multi_key> <equal> <e>\n" +
+			"<Multi_key> <plus> <plus>\n" +
+			"<Multi_key> <v> <slash>\n", -1},
+		{[]string{"i", "-f", "%(compose)", "\u00e9"}, "<dead_acute> <e>\n", -1},
+		{[]string{"i", "-f", "%(compose)", "\\"}, "<Multi_key> <slash> <slash>\n", -1},
+		{[]string{"i", "-f", "%(compose)", "\u00d7"}, "<Multi_key> <x> <x>\n", -1},
+		{[]string{"i", "-f", "%(compose)", "E"}, "<Multi_key> <e> <equal>\n", -1},
+		{[]string{"i", "-j", "-f", "%(compose)", "a"}, "[{\n\t\"compose\": []\n}]\n", -1},
+
+		{[]string{"p", "-f", "%(cpoint)", "compose:=e"}, "U+20AC\n", -1},
+		{[]string{"p", "-f", "%(cpoint)", "compose:<Multi_key> <v> <slash>"}, "U+2713\n", -1},
+		{[]string{"p", "-f", "%(cpoint)", "compose:<dead_acute>"}, "U+00E9\n", -1},
+		{[]string{"p", "-f", "%(dec)", "compose:shrug"}, "40\n41\n47\n92\n95\n95\n175\n175\n12484\n", -1},
+		{[]string{"p", "compose:=x"}, "testuni: unknown compose sequence: \"=x\"\n", 1},
+		{[]string{"p", "compose:<Multi_key"}, "testuni: invalid compose sequence: \"<Multi_key\"\n", 1},
+	})
+}
+
+func TestComposeFile(t *testing.T) {
+	defer func(f, l string) {
+		os.Setenv("XCOMPOSEFILE", f)
+		os.Setenv("LC_CTYPE", l)
+		composeIndex = nil
+	}(os.Getenv("XCOMPOSEFILE"), os.Getenv("LC_CTYPE"))
+
+	var (
+		in     = []string{"i", "-f", "%(compose)", "\u00d7\u20ac"}
+		user   = "<Multi_key> <x> <x>\n\n"
+		system = "\n<Multi_key> <e> <equal>, <Multi_key> <equal> <e>\n"
+		none   = "\n\n"
+	)
+	tests := []struct {
+		file, lang string
+		in         []string
+		want       string
+	}{
+		// The user's file is used instead of the system one if it exists.
+		{"testdata/compose/XCompose-noinclude", "en_US.UTF-8", in, user},
+		{"testdata/compose/nonexistent", "en_US.UTF-8", in, system},
+
+		// Find the system file for the locale.
+		{"testdata/compose/nonexistent", "en_US.utf8", in, system},
+		{"testdata/compose/nonexistent", "en_US", in, system},
+		{"testdata/compose/nonexistent", "C.UTF-8", in, system},
+		{"testdata/compose/nonexistent", "C", in, system},
+		{"testdata/compose/nonexistent", "POSIX", in, system},
+		{"testdata/compose/nonexistent", "de_DE@euro", in, system},
+		{"testdata/compose/nonexistent", "de_DE.utf-8@euro", in, system},
+		{"testdata/compose/nonexistent", "american.utf8", in, system},
+		{"testdata/compose/nonexistent", "english", in, none},
+		{"testdata/compose/nonexistent", "xx_XX.UTF-8", in, none},
+
+		// Only loaded when the compose column is used.
+		{"testdata/compose/XCompose-loop", "en_US.UTF-8", []string{"i", "a"},
+			"'a'  U+0061  97     61          &#x61;     LATIN SMALL LETTER A (Lowercase_Letter)\n"},
+		{"testdata/compose/XCompose-loop", "en_US.UTF-8", []string{"i", "-f", "%(compose)", "a"}, "" +
+			"uni: WARNING: loading Compose file: unidata.LoadCompose: too many levels of includes in \"testdata/compose/XCompose-loop\"\n\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file+"_"+tt.lang+"_"+strings.Join(tt.in, "_"), func(t *testing.T) {
+			os.Setenv("XCOMPOSEFILE", tt.file)
+			os.Setenv("LC_CTYPE", tt.lang)
+			composeIndex = nil
+			exit, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"testuni", "-q"}, tt.in...)
+
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+
+			if d := ztest.Diff(outbuf.String(), tt.want); d != "" {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestConfusable(t *testing.T) {
+	tests := []struct {
+		in        []string
+		want      string
+		wantLines int
+		wantExit  int
+	}{
+		{[]string{"-q", "confusable", "m"}, "'rn'", 17, -1},
+		{[]string{"-q", "confusable", "а"}, "LATIN SMALL LETTER A", 23, -1},
+		{[]string{"confusable", "paypal", "раураl"}, "'paypal'", 3, -1},
+		{[]string{"confusable", "paypal", "paypa1"}, "'paypal'", 3, -1},
+		{[]string{"confusable", "paypal", "payqal"}, "not confusable", 4, 1},
+		{[]string{"-q", "confusable", "paypal", "payqal"}, "'payqal'", 2, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.in, "_"), func(t *testing.T) {
+			exit, _, outbuf := zli.Test(t)
+			os.Args = append([]string{"testuni"}, tt.in...)
+
+			func() {
+				defer exit.Recover()
+				main()
+			}()
+
+			if int(*exit) != tt.wantExit {
+				t.Fatalf("wrong exit: %d", *exit)
+			}
+
+			out := outbuf.String()
+			if lines := strings.Count(out, "\n"); lines != tt.wantLines {
+				t.Errorf("wrong # of lines\nout:  %d\nwant: %d", lines, tt.wantLines)
+			}
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("wrong output\nout:  %q\nwant: %q", out, tt.want)
+			}
+		})
+	}
+}
+
 func TestEmoji(t *testing.T) {
 	tests := []struct {
 		in   []string
@@ -203,12 +759,14 @@ func TestEmoji(t *testing.T) {
 		//[]string{}},
 
 		{[]string{"e", "-q", "group:hands"},
-			[]string{"👏", "🙌", "👐", "🤲", "🤝", "🙏"}},
+			[]string{"👏", "🙌", "🫶", "👐", "🤲", "🤝", "🙏"}},
 		{[]string{"e", "-q", "-tone", "dark", "g:hands"},
-			[]string{"👏🏿", "🙌🏿", "👐🏿", "🤲🏿", "🤝", "🙏🏿"}},
+			[]string{"👏🏿", "🙌🏿", "🫶🏿", "👐🏿", "🤲🏿", "🤝🏿", "🙏🏿"}},
 
 		{[]string{"e", "-q", "shrug"},
 			[]string{"🤷"}},
+		{[]string{"e", "-q", "-max-version", "3.0", "group:hands"},
+			[]string{"👏", "🙌", "👐", "🤝", "🙏"}},
 		{[]string{"e", "-q", "shrug", "-gender", "all"},
 			[]string{"🤷", "🤷Z♂S", "🤷Z♀S"}},
 		{[]string{"e", "-q", "-gender", "m", "shrug"},
@@ -234,6 +792,29 @@ func TestEmoji(t *testing.T) {
 		{[]string{"e", "-q", "-gender", "m", "-tone", "mediumdark", "detective"},
 			[]string{"🕵🏾Z♂S"}},
 
+		{[]string{"e", "-q", "-tone", "light:dark", "women holding hands"},
+			[]string{"👩🏻Z🤝Z👩🏿"}},
+		{[]string{"e", "-q", "-tone", "medium", "women holding hands"},
+			[]string{"👭🏽"}},
+		{[]string{"e", "-q", "-tone", "light,light:dark", "women holding hands"},
+			[]string{"👭🏻", "👩🏻Z🤝Z👩🏿"}},
+		{[]string{"e", "-q", "-tone", "light:dark", "handshake"},
+			[]string{"🫱🏻Z🫲🏿"}},
+		{[]string{"e", "-q", "-tone", "light,dark", "handshake"},
+			[]string{"🤝🏻", "🤝🏿"}},
+		{[]string{"e", "-q", "-tone", "light,light:dark", "handshake"},
+			[]string{"🤝🏻", "🫱🏻Z🫲🏿"}},
+		{[]string{"e", "-q", "-tone", "light:dark", "thumbs up"},
+			[]string{"👍🏻"}},
+		{[]string{"e", "-q", "-tone", "medium:dark", "kiss: woman, man"},
+			[]string{"👩🏽Z❤SZ💋Z👨🏿"}},
+		{[]string{"e", "-q", "-tone", "all", "thumbs up"},
+			[]string{"👍", "👍🏻", "👍🏼", "👍🏽", "👍🏾", "👍🏿"}},
+		{[]string{"e", "-qo", "-tone", "dark", "thumbs up", "shrug"},
+			[]string{"👍🏿", "🤷🏿"}},
+		{[]string{"e", "-qo", "-gender", "m", "shrug", "zimbabwe"},
+			[]string{"🤷Z♂S", "🇿🇼"}},
+
 		{[]string{"e", "-qo", "zimbabwe", "#", "england"},
 			[]string{"#S⃣", "🇿🇼", "🏴󠁧󠁢󠁥󠁮󠁧󠁿"}},
 	}
@@ -276,11 +857,9 @@ func TestAllEmoji(t *testing.T) {
 
 	// grep -v '^#' unidata/.cache/emoji-test.txt |
 	//     grep fully-qualified |
-	//     grep -Ev '(holding hands|kiss:|couple with heart).*tone' |
 	//     grep -Eo '# .+? E[0-9]' |
 	//     cut -d ' ' -f2 >| testdata/emojis
 	//
-	// double tones: 70
 	// family: 145
 	w, err := ioutil.ReadFile("./testdata/emojis")
 	if err != nil {
@@ -326,18 +905,55 @@ func TestJSON(t *testing.T) {
 	main()
 
 	want := ` [{
+	"abbr": [],
+	"age": "2.1",
+	"alias": [],
+	"bidi": "ET",
 	"block": "Currency Symbols",
 	"cat": "Currency_Symbol",
+	"ccc": "0",
 	"char": "€",
+	"cldr": "",
+	"cldr_full": "",
+	"cluster_index": "1",
+	"compose": [
+		"<Multi_key> <equal> <e>"
+	],
 	"cpoint": "U+20AC",
 	"dec": "8364",
+	"decomp": "",
 	"digraph": "=e",
+	"emoji_props": "",
+	"fold": "",
+	"grapheme": "€",
+	"group": "",
 	"hex": "20ac",
 	"html": "&euro;",
+	"html_all": [
+		"&euro;"
+	],
+	"jamo": "",
 	"json": "\\u20AC",
 	"keysym": "EuroSign",
+	"keysyms": [
+		"EuroSign"
+	],
+	"linebreak": "PR",
+	"lower": "",
+	"mirror": "",
 	"name": "EURO SIGN",
+	"numtype": "",
+	"numval": "",
 	"plane": "Basic Multilingual Plane",
+	"presentation": "",
+	"props": "Grapheme_Base",
+	"script": "Common",
+	"scriptx": [
+		"Common"
+	],
+	"subgroup": "",
+	"title": "",
+	"upper": "",
 	"utf16be": "20 AC",
 	"utf16le": "AC 20",
 	"utf8": "e2 82 ac",
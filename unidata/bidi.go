@@ -0,0 +1,797 @@
+package unidata
+
+import (
+	"fmt"
+	"sort"
+)
+
+// BidiClass is the Bidi_Class property.
+type BidiClass uint8
+
+// Bidi classes.
+const (
+	BidiL   BidiClass = iota // Left-to-right
+	BidiR                    // Right-to-left
+	BidiAL                   // Arabic letter
+	BidiEN                   // European number
+	BidiES                   // European separator
+	BidiET                   // European terminator
+	BidiAN                   // Arabic number
+	BidiCS                   // Common separator
+	BidiNSM                  // Nonspacing mark
+	BidiBN                   // Boundary neutral
+	BidiB                    // Paragraph separator
+	BidiS                    // Segment separator
+	BidiWS                   // Whitespace
+	BidiON                   // Other neutral
+	BidiLRE                  // Left-to-right embedding
+	BidiLRO                  // Left-to-right override
+	BidiRLE                  // Right-to-left embedding
+	BidiRLO                  // Right-to-left override
+	BidiPDF                  // Pop directional format
+	BidiLRI                  // Left-to-right isolate
+	BidiRLI                  // Right-to-left isolate
+	BidiFSI                  // First strong isolate
+	BidiPDI                  // Pop directional isolate
+)
+
+var bidiNames = []string{"L", "R", "AL", "EN", "ES", "ET", "AN", "CS", "NSM",
+	"BN", "B", "S", "WS", "ON", "LRE", "LRO", "RLE", "RLO", "PDF", "LRI", "RLI",
+	"FSI", "PDI"}
+
+// String gets the short name, such as "AL".
+func (b BidiClass) String() string {
+	if int(b) < len(bidiNames) {
+		return bidiNames[b]
+	}
+	return fmt.Sprintf("BidiClass(%d)", uint8(b))
+}
+
+// ParseBidiClass parses a short Bidi_Class name such as "AL".
+func ParseBidiClass(s string) (BidiClass, error) {
+	for i, n := range bidiNames {
+		if n == s {
+			return BidiClass(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown bidi class: %q", s)
+}
+
+func (b BidiClass) isolateInitiator() bool { return b == BidiLRI || b == BidiRLI || b == BidiFSI }
+
+// Removed by rule X9.
+func (b BidiClass) removed() bool {
+	return b == BidiBN || (b >= BidiLRE && b <= BidiPDF)
+}
+
+// BidiBracket is a paired bracket from BidiBrackets.txt.
+type BidiBracket struct {
+	Pair rune // The matching bracket.
+	Open bool // Opening or closing bracket.
+}
+
+// BidiClass gets the Bidi_Class property.
+func (c Codepoint) BidiClass() BidiClass { return bidiClass(c.Codepoint) }
+
+// BidiMirrored reports if this has the Bidi_Mirrored property: if it should be
+// displayed mirrored in right-to-left text.
+func (c Codepoint) BidiMirrored() bool { return inRanges(c.Codepoint, BidiMirrored) }
+
+// BidiMirroringGlyph gets the Bidi_Mirroring_Glyph property: the character
+// that has the mirrored image of this one, such as ")" for "(". This is -1 if
+// there is none; a character can be mirrored without having a mirroring glyph.
+func (c Codepoint) BidiMirroringGlyph() rune {
+	if m, ok := BidiMirroringGlyphs[c.Codepoint]; ok {
+		return m
+	}
+	return -1
+}
+
+// Classes are looked up a lot by the algorithm, so put them in one sorted
+// list.
+var bidiTable = func() [][3]rune {
+	var t [][3]rune
+	for class, ranges := range BidiClasses {
+		for _, r := range ranges {
+			t = append(t, [3]rune{r[0], r[1], rune(class)})
+		}
+	}
+	sort.Slice(t, func(i, j int) bool { return t[i][0] < t[j][0] })
+	return t
+}()
+
+func bidiClass(r rune) BidiClass {
+	i := sort.Search(len(bidiTable), func(i int) bool { return bidiTable[i][1] >= r })
+	if i < len(bidiTable) && r >= bidiTable[i][0] {
+		return BidiClass(bidiTable[i][2])
+	}
+	return BidiL
+}
+
+// Direction is the direction of a paragraph.
+type Direction uint8
+
+// Paragraph directions.
+const (
+	DirectionAuto Direction = iota // Use the first strong character (rules P2 and P3).
+	DirectionLTR
+	DirectionRTL
+)
+
+// ParseDirection parses "auto", "ltr", or "rtl".
+func ParseDirection(s string) (Direction, error) {
+	switch s {
+	case "auto", "":
+		return DirectionAuto, nil
+	case "ltr":
+		return DirectionLTR, nil
+	case "rtl":
+		return DirectionRTL, nil
+	}
+	return 0, fmt.Errorf("unknown direction: %q", s)
+}
+
+// BidiParagraph is a paragraph with the embedding levels resolved by the
+// Unicode Bidirectional Algorithm.
+type BidiParagraph struct {
+	Text    []rune
+	Classes []BidiClass // Bidi_Class of every character.
+	Level   int         // Paragraph embedding level; 0 for LTR and 1 for RTL.
+
+	// Resolved embedding level of every character; this is -1 for the
+	// characters that are removed by rule X9 (embeddings, overrides, PDF, and
+	// BN).
+	Levels []int
+
+	// The explicit embeddings, overrides, and isolates every character is
+	// in, from outermost to innermost; this doesn't include the formatting
+	// character itself.
+	Explicit [][]BidiClass
+}
+
+// Bidi runs the Unicode Bidirectional Algorithm (UAX #9) over text, and
+// returns the resolved levels for every paragraph in it.
+//
+// This treats every paragraph as a single line.
+//
+// https://www.unicode.org/reports/tr9/
+func Bidi(text string, dir Direction) []BidiParagraph {
+	var (
+		paras []BidiParagraph
+		cur   []rune
+	)
+	for _, r := range text {
+		cur = append(cur, r)
+		if bidiClass(r) == BidiB {
+			paras = append(paras, resolveParagraph(cur, dir))
+			cur = nil
+		}
+	}
+	if len(cur) > 0 || len(paras) == 0 {
+		paras = append(paras, resolveParagraph(cur, dir))
+	}
+	return paras
+}
+
+// Reorder gets the indexes of Text in visual order (rule L2); the characters
+// that were removed by rule X9 are not included.
+func (p BidiParagraph) Reorder() []int {
+	var (
+		order           = make([]int, 0, len(p.Text))
+		highest, lowOdd = 0, 127
+	)
+	for i, l := range p.Levels {
+		if l == -1 {
+			continue
+		}
+		order = append(order, i)
+		if l > highest {
+			highest = l
+		}
+		if l%2 == 1 && l < lowOdd {
+			lowOdd = l
+		}
+	}
+
+	// From the highest level to the lowest odd level, reverse any contiguous
+	// sequence of characters that are at that level or higher.
+	for level := highest; level >= lowOdd; level-- {
+		for i := 0; i < len(order); i++ {
+			if p.Levels[order[i]] < level {
+				continue
+			}
+			j := i
+			for j < len(order) && p.Levels[order[j]] >= level {
+				j++
+			}
+			for a, b := i, j-1; a < b; a, b = a+1, b-1 {
+				order[a], order[b] = order[b], order[a]
+			}
+			i = j
+		}
+	}
+	return order
+}
+
+// Visual gets the text in visual order, with mirrored characters in
+// right-to-left runs replaced by their mirroring glyph (rule L4).
+func (p BidiParagraph) Visual() string {
+	order := p.Reorder()
+	v := make([]rune, 0, len(order))
+	for _, i := range order {
+		r := p.Text[i]
+		if p.Levels[i]%2 == 1 {
+			if m, ok := BidiMirroringGlyphs[r]; ok {
+				r = m
+			}
+		}
+		v = append(v, r)
+	}
+	return string(v)
+}
+
+const bidiMaxDepth = 125
+
+func resolveParagraph(text []rune, dir Direction) BidiParagraph {
+	p := BidiParagraph{
+		Text:     text,
+		Classes:  make([]BidiClass, len(text)),
+		Levels:   make([]int, len(text)),
+		Explicit: make([][]BidiClass, len(text)),
+	}
+	for i, r := range text {
+		p.Classes[i] = bidiClass(r)
+	}
+	matchingPDI := matchIsolates(p.Classes)
+
+	switch dir {
+	case DirectionLTR:
+		p.Level = 0
+	case DirectionRTL:
+		p.Level = 1
+	default:
+		p.Level = firstStrong(p.Classes, matchingPDI, 0, len(text))
+	}
+
+	types := make([]BidiClass, len(text))
+	copy(types, p.Classes)
+	p.explicit(types, matchingPDI)
+
+	// Resolve the weak and neutral types and the implicit levels per isolating
+	// run sequence.
+	for _, seq := range p.runSequences(matchingPDI) {
+		seq.resolve(types)
+	}
+
+	p.resetWhitespace()
+	return p
+}
+
+// matchIsolates finds the matching PDI for every isolate initiator (BD9); this
+// is len(classes) for initiators without a matching PDI.
+func matchIsolates(classes []BidiClass) map[int]int {
+	m := make(map[int]int)
+	var stack []int
+	for i, c := range classes {
+		switch {
+		case c.isolateInitiator():
+			stack = append(stack, i)
+		case c == BidiPDI && len(stack) > 0:
+			m[stack[len(stack)-1]] = i
+			stack = stack[:len(stack)-1]
+		case c == BidiB:
+			stack = stack[:0]
+		}
+	}
+	for _, i := range stack {
+		m[i] = len(classes)
+	}
+	return m
+}
+
+// firstStrong gets the level from the first strong character between start and
+// end, skipping any isolates (rules P2 and P3).
+func firstStrong(classes []BidiClass, matchingPDI map[int]int, start, end int) int {
+	for i := start; i < end; i++ {
+		switch c := classes[i]; {
+		case c == BidiL:
+			return 0
+		case c == BidiR || c == BidiAL:
+			return 1
+		case c.isolateInitiator():
+			i = matchingPDI[i]
+		}
+	}
+	return 0
+}
+
+// explicit sets the explicit embedding levels and directional overrides (rules
+// X1 to X8).
+func (p *BidiParagraph) explicit(types []BidiClass, matchingPDI map[int]int) {
+	type status struct {
+		level    int
+		override BidiClass // BidiON for neutral.
+		isolate  bool
+		opener   BidiClass
+	}
+	var (
+		stack                                    = []status{{level: p.Level, override: BidiON}}
+		overflowIsolate, overflowEmbed, isolates int
+	)
+	context := func() []BidiClass {
+		if len(stack) == 1 {
+			return nil
+		}
+		c := make([]BidiClass, 0, len(stack)-1)
+		for _, s := range stack[1:] {
+			c = append(c, s.opener)
+		}
+		return c
+	}
+	nextLevel := func(rtl bool) int {
+		l := stack[len(stack)-1].level + 1
+		if (l%2 == 1) != rtl {
+			l++
+		}
+		return l
+	}
+
+	for i, c := range p.Classes {
+		top := stack[len(stack)-1]
+		p.Levels[i] = top.level
+		p.Explicit[i] = context()
+
+		switch c {
+		// X2-X5
+		case BidiRLE, BidiLRE, BidiRLO, BidiLRO:
+			l := nextLevel(c == BidiRLE || c == BidiRLO)
+			if l <= bidiMaxDepth && overflowIsolate == 0 && overflowEmbed == 0 {
+				s := status{level: l, override: BidiON, opener: c}
+				switch c {
+				case BidiRLO:
+					s.override = BidiR
+				case BidiLRO:
+					s.override = BidiL
+				}
+				stack = append(stack, s)
+			} else if overflowIsolate == 0 {
+				overflowEmbed++
+			}
+
+		// X5a-X5c
+		case BidiRLI, BidiLRI, BidiFSI:
+			if top.override != BidiON {
+				types[i] = top.override
+			}
+			rtl := c == BidiRLI
+			if c == BidiFSI {
+				rtl = firstStrong(p.Classes, matchingPDI, i+1, matchingPDI[i]) == 1
+			}
+			l := nextLevel(rtl)
+			if l <= bidiMaxDepth && overflowIsolate == 0 && overflowEmbed == 0 {
+				isolates++
+				stack = append(stack, status{level: l, override: BidiON, isolate: true, opener: c})
+			} else {
+				overflowIsolate++
+			}
+
+		// X6a
+		case BidiPDI:
+			if overflowIsolate > 0 {
+				overflowIsolate--
+			} else if isolates > 0 {
+				overflowEmbed = 0
+				for !stack[len(stack)-1].isolate {
+					stack = stack[:len(stack)-1]
+				}
+				stack = stack[:len(stack)-1]
+				isolates--
+			}
+			top = stack[len(stack)-1]
+			p.Levels[i] = top.level
+			p.Explicit[i] = context()
+			if top.override != BidiON {
+				types[i] = top.override
+			}
+
+		// X7
+		case BidiPDF:
+			switch {
+			case overflowIsolate > 0:
+			case overflowEmbed > 0:
+				overflowEmbed--
+			case !top.isolate && len(stack) >= 2:
+				stack = stack[:len(stack)-1]
+			}
+
+		// X8
+		case BidiB:
+			p.Levels[i] = p.Level
+
+		case BidiBN:
+
+		// X6
+		default:
+			if top.override != BidiON {
+				types[i] = top.override
+			}
+		}
+	}
+}
+
+// An isolating run sequence (BD13).
+type runSequence struct {
+	p        *BidiParagraph
+	indexes  []int
+	level    int
+	sos, eos BidiClass
+}
+
+// runSequences gets the isolating run sequences (rules X9 and X10).
+func (p *BidiParagraph) runSequences(matchingPDI map[int]int) []runSequence {
+	// Level runs, without the removed characters.
+	var (
+		runs [][]int
+		run  []int
+	)
+	for i, c := range p.Classes {
+		if c.removed() {
+			continue
+		}
+		if len(run) > 0 && p.Levels[run[0]] != p.Levels[i] {
+			runs = append(runs, run)
+			run = nil
+		}
+		run = append(run, i)
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+
+	// Join the runs that end with an isolate initiator with the run that starts
+	// with the matching PDI.
+	startsWith := make(map[int]int)
+	for i, r := range runs {
+		startsWith[r[0]] = i
+	}
+	var (
+		seqs []runSequence
+		used = make([]bool, len(runs))
+	)
+	for i := range runs {
+		if used[i] {
+			continue
+		}
+		var idx []int
+		for j := i; ; {
+			used[j] = true
+			idx = append(idx, runs[j]...)
+			last := runs[j][len(runs[j])-1]
+			if !p.Classes[last].isolateInitiator() {
+				break
+			}
+			next, ok := startsWith[matchingPDI[last]]
+			if !ok {
+				break
+			}
+			j = next
+		}
+		seqs = append(seqs, p.newSequence(idx))
+	}
+	return seqs
+}
+
+func (p *BidiParagraph) newSequence(idx []int) runSequence {
+	s := runSequence{p: p, indexes: idx, level: p.Levels[idx[0]]}
+
+	prev := p.Level
+	for i := idx[0] - 1; i >= 0; i-- {
+		if !p.Classes[i].removed() {
+			prev = p.Levels[i]
+			break
+		}
+	}
+	next := p.Level
+	if last := idx[len(idx)-1]; !p.Classes[last].isolateInitiator() {
+		for i := last + 1; i < len(p.Classes); i++ {
+			if !p.Classes[i].removed() {
+				next = p.Levels[i]
+				break
+			}
+		}
+	}
+	if s.level > prev {
+		prev = s.level
+	}
+	if s.level > next {
+		next = s.level
+	}
+	s.sos, s.eos = levelDir(prev), levelDir(next)
+	return s
+}
+
+func levelDir(l int) BidiClass {
+	if l%2 == 1 {
+		return BidiR
+	}
+	return BidiL
+}
+
+// Strong direction for rules N0-N2: EN and AN are treated as R.
+func strongDir(c BidiClass) (BidiClass, bool) {
+	switch c {
+	case BidiL:
+		return BidiL, true
+	case BidiR, BidiAL, BidiEN, BidiAN:
+		return BidiR, true
+	}
+	return BidiON, false
+}
+
+func isNI(c BidiClass) bool {
+	switch c {
+	case BidiB, BidiS, BidiWS, BidiON, BidiLRI, BidiRLI, BidiFSI, BidiPDI:
+		return true
+	}
+	return false
+}
+
+func (s runSequence) resolve(allTypes []BidiClass) {
+	t := make([]BidiClass, len(s.indexes))
+	for i, idx := range s.indexes {
+		t[i] = allTypes[idx]
+	}
+
+	s.weak(t)
+	s.brackets(t)
+	s.neutral(t)
+
+	// I1, I2
+	for i, idx := range s.indexes {
+		l := s.p.Levels[idx]
+		switch {
+		case l%2 == 0 && t[i] == BidiR:
+			l++
+		case l%2 == 0 && (t[i] == BidiAN || t[i] == BidiEN):
+			l += 2
+		case l%2 == 1 && (t[i] == BidiL || t[i] == BidiEN || t[i] == BidiAN):
+			l++
+		}
+		s.p.Levels[idx] = l
+	}
+}
+
+// weak resolves the weak types (rules W1 to W7).
+func (s runSequence) weak(t []BidiClass) {
+	// W1
+	for i := range t {
+		if t[i] != BidiNSM {
+			continue
+		}
+		switch {
+		case i == 0:
+			t[i] = s.sos
+		case t[i-1].isolateInitiator() || t[i-1] == BidiPDI:
+			t[i] = BidiON
+		default:
+			t[i] = t[i-1]
+		}
+	}
+
+	// W2, W3
+	last := s.sos
+	for i := range t {
+		switch t[i] {
+		case BidiL, BidiR:
+			last = t[i]
+		case BidiAL:
+			last = t[i]
+			t[i] = BidiR
+		case BidiEN:
+			if last == BidiAL {
+				t[i] = BidiAN
+			}
+		}
+	}
+
+	// W4
+	for i := 1; i < len(t)-1; i++ {
+		switch {
+		case t[i] == BidiES && t[i-1] == BidiEN && t[i+1] == BidiEN:
+			t[i] = BidiEN
+		case t[i] == BidiCS && t[i-1] == BidiEN && t[i+1] == BidiEN:
+			t[i] = BidiEN
+		case t[i] == BidiCS && t[i-1] == BidiAN && t[i+1] == BidiAN:
+			t[i] = BidiAN
+		}
+	}
+
+	// W5
+	for i := 0; i < len(t); i++ {
+		if t[i] != BidiET {
+			continue
+		}
+		j := i
+		for j < len(t) && t[j] == BidiET {
+			j++
+		}
+		if (i > 0 && t[i-1] == BidiEN) || (j < len(t) && t[j] == BidiEN) {
+			for k := i; k < j; k++ {
+				t[k] = BidiEN
+			}
+		}
+		i = j
+	}
+
+	// W6
+	for i := range t {
+		if t[i] == BidiES || t[i] == BidiET || t[i] == BidiCS {
+			t[i] = BidiON
+		}
+	}
+
+	// W7
+	last = s.sos
+	for i := range t {
+		switch t[i] {
+		case BidiL, BidiR:
+			last = t[i]
+		case BidiEN:
+			if last == BidiL {
+				t[i] = BidiL
+			}
+		}
+	}
+}
+
+// brackets resolves paired brackets (rule N0).
+func (s runSequence) brackets(t []BidiClass) {
+	e := levelDir(s.level)
+	for _, pair := range s.bracketPairs(t) {
+		var found, opposite bool
+		for i := pair[0] + 1; i < pair[1]; i++ {
+			if d, ok := strongDir(t[i]); ok {
+				if d == e {
+					found = true
+					break
+				}
+				opposite = true
+			}
+		}
+
+		var dir BidiClass
+		switch {
+		case found:
+			dir = e
+		case opposite:
+			// Use the direction of the context before the opening bracket if
+			// that's the opposite direction, and the embedding direction
+			// otherwise.
+			ctx := s.sos
+			for i := pair[0] - 1; i >= 0; i-- {
+				if d, ok := strongDir(t[i]); ok {
+					ctx = d
+					break
+				}
+			}
+			dir = e
+			if ctx != e {
+				dir = ctx
+			}
+		default:
+			continue
+		}
+
+		for _, i := range pair {
+			t[i] = dir
+			// Any NSM following a bracket gets the same type.
+			for j := i + 1; j < len(t) && s.p.Classes[s.indexes[j]] == BidiNSM; j++ {
+				t[j] = dir
+			}
+		}
+	}
+}
+
+// bracketPairs identifies the bracket pairs (BD16); the positions are indexes
+// in the sequence, and the pairs are sorted by the opening bracket.
+func (s runSequence) bracketPairs(t []BidiClass) [][2]int {
+	type opener struct {
+		pos   int
+		close rune
+	}
+	var (
+		pairs [][2]int
+		stack []opener
+	)
+outer:
+	for i, idx := range s.indexes {
+		if t[i] != BidiON {
+			continue
+		}
+		r := canonicalBracket(s.p.Text[idx])
+		b, ok := BidiBrackets[r]
+		if !ok {
+			continue
+		}
+		if b.Open {
+			if len(stack) == 63 {
+				break
+			}
+			stack = append(stack, opener{i, canonicalBracket(b.Pair)})
+			continue
+		}
+		for j := len(stack) - 1; j >= 0; j-- {
+			if stack[j].close == r {
+				pairs = append(pairs, [2]int{stack[j].pos, i})
+				stack = stack[:j]
+				continue outer
+			}
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i][0] < pairs[j][0] })
+	return pairs
+}
+
+// The angle brackets U+2329 and U+232A are canonically equivalent to U+3008
+// and U+3009, and should match either.
+func canonicalBracket(r rune) rune {
+	switch r {
+	case 0x2329:
+		return 0x3008
+	case 0x232a:
+		return 0x3009
+	}
+	return r
+}
+
+// neutral resolves the neutral and isolate formatting characters (rules N1 and
+// N2).
+func (s runSequence) neutral(t []BidiClass) {
+	e := levelDir(s.level)
+	for i := 0; i < len(t); i++ {
+		if !isNI(t[i]) {
+			continue
+		}
+		j := i
+		for j < len(t) && isNI(t[j]) {
+			j++
+		}
+
+		before, after := s.sos, s.eos
+		if i > 0 {
+			before, _ = strongDir(t[i-1])
+		}
+		if j < len(t) {
+			after, _ = strongDir(t[j])
+		}
+		dir := e
+		if before == after {
+			dir = before
+		}
+		for k := i; k < j; k++ {
+			t[k] = dir
+		}
+		i = j
+	}
+}
+
+// resetWhitespace resets segment separators, paragraph separators, and any
+// trailing whitespace to the paragraph level (rule L1).
+func (p *BidiParagraph) resetWhitespace() {
+	trailing := true
+	for i := len(p.Classes) - 1; i >= 0; i-- {
+		switch c := p.Classes[i]; {
+		case c.removed():
+			p.Levels[i] = -1
+		case c == BidiS || c == BidiB:
+			p.Levels[i] = p.Level
+			trailing = true
+		case c == BidiWS || c.isolateInitiator() || c == BidiPDI:
+			if trailing {
+				p.Levels[i] = p.Level
+			}
+		default:
+			trailing = false
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package unidata
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestBidi(t *testing.T) {
+	fp, err := os.Open("testdata/BidiCharacterTest.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fp.Close()
+
+	var (
+		scan = bufio.NewScanner(fp)
+		n    int
+	)
+	for scan.Scan() {
+		n++
+		line := scan.Text()
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		f := strings.Split(line, ";")
+		if len(f) != 5 {
+			t.Fatalf("line %d: wrong number of fields", n)
+		}
+		text := parseCodepoints(t, f[0])
+
+		var dir Direction
+		switch f[1] {
+		case "0":
+			dir = DirectionLTR
+		case "1":
+			dir = DirectionRTL
+		case "2":
+			dir = DirectionAuto
+		default:
+			t.Fatalf("line %d: unknown direction %q", n, f[1])
+		}
+
+		paras := Bidi(text, dir)
+		if len(paras) != 1 {
+			t.Errorf("line %d: %d paragraphs", n, len(paras))
+			continue
+		}
+		p := paras[0]
+
+		if have := strconv.Itoa(p.Level); have != f[2] {
+			t.Errorf("line %d: %s: wrong paragraph level\nhave: %s\nwant: %s", n, f[0], have, f[2])
+		}
+
+		levels := make([]string, len(p.Levels))
+		for i, l := range p.Levels {
+			levels[i] = "x"
+			if l > -1 {
+				levels[i] = strconv.Itoa(l)
+			}
+		}
+		if have := strings.Join(levels, " "); have != f[3] {
+			t.Errorf("line %d: %s: wrong levels\nhave: %s\nwant: %s", n, f[0], have, f[3])
+		}
+
+		order := make([]string, 0, len(p.Text))
+		for _, i := range p.Reorder() {
+			order = append(order, strconv.Itoa(i))
+		}
+		if have := strings.Join(order, " "); have != f[4] {
+			t.Errorf("line %d: %s: wrong order\nhave: %s\nwant: %s", n, f[0], have, f[4])
+		}
+	}
+	if err := scan.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
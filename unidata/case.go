@@ -0,0 +1,195 @@
+package unidata
+
+import (
+	"strings"
+)
+
+const (
+	caseUpper = iota
+	caseLower
+	caseTitle
+	caseFold
+)
+
+var specialCases = func() map[rune][]SpecialCase {
+	m := make(map[rune][]SpecialCase)
+	for _, s := range SpecialCasings {
+		m[s.Codepoint] = append(m[s.Codepoint], s)
+	}
+	return m
+}()
+
+// ToUpper maps s to uppercase.
+//
+// The lang is a language tag such as "tr" or "lt-LT" to apply the
+// language-specific rules from SpecialCasing.txt; only Turkish, Azeri, and
+// Lithuanian have any. The language-independent rules are always applied.
+func ToUpper(s, lang string) string { return mapCase(s, lang, caseUpper) }
+
+// ToLower maps s to lowercase; see ToUpper for lang.
+func ToLower(s, lang string) string { return mapCase(s, lang, caseLower) }
+
+// ToTitle maps s to titlecase; see ToUpper for lang.
+//
+// The first cased character of every word is mapped to titlecase and the rest
+// to lowercase. A word starts at any cased character that isn't preceded by
+// another cased character (ignoring case-ignorable characters in between).
+func ToTitle(s, lang string) string { return mapCase(s, lang, caseTitle) }
+
+// Fold applies full case folding to s; see ToUpper for lang.
+func Fold(s, lang string) string { return mapCase(s, lang, caseFold) }
+
+func mapCase(s, lang string, which int) string {
+	if i := strings.IndexAny(lang, "-_"); i > -1 {
+		lang = lang[:i]
+	}
+	lang = strings.ToLower(lang)
+
+	var (
+		b      strings.Builder
+		runes  = []rune(s)
+		inWord bool
+	)
+	b.Grow(len(s))
+	for i, r := range runes {
+		w := which
+		if which == caseTitle {
+			if inWord {
+				w = caseLower
+			}
+			if hasProp(r, PropCased) {
+				inWord = true
+			} else if !hasProp(r, PropCaseIgnorable) {
+				inWord = false
+			}
+		}
+
+		if m, ok := special(runes, i, lang, w); ok {
+			b.WriteString(string(m))
+			continue
+		}
+
+		cm := Casemaps[r]
+		if m := pick(w, cm.Upper, cm.Lower, cm.Title, cm.Fold); m == nil {
+			b.WriteRune(r)
+		} else {
+			b.WriteString(string(m))
+		}
+	}
+	return b.String()
+}
+
+func pick(which int, upper, lower, title, fold []rune) []rune {
+	switch which {
+	case caseUpper:
+		return upper
+	case caseLower:
+		return lower
+	case caseTitle:
+		return title
+	default:
+		return fold
+	}
+}
+
+// special finds a conditional case mapping for runes[i].
+func special(runes []rune, i int, lang string, which int) ([]rune, bool) {
+	for _, s := range specialCases[runes[i]] {
+		if s.Lang != "" && s.Lang != lang {
+			continue
+		}
+		if !inContext(runes, i, s.Context) {
+			continue
+		}
+
+		if m := pick(which, s.Upper, s.Lower, s.Title, s.Fold); m != nil {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+// inContext reports if runes[i] is in the casing context ctx.
+//
+// http://www.unicode.org/versions/latest/ch03.pdf#G33992
+func inContext(runes []rune, i int, ctx string) bool {
+	switch ctx {
+	case "":
+		return true
+	case "Final_Sigma":
+		return finalSigma(runes, i)
+	case "After_Soft_Dotted":
+		return after(runes, i, func(r rune) bool { return hasProp(r, PropSoftDotted) })
+	case "After_I":
+		return after(runes, i, func(r rune) bool { return r == 'I' })
+	case "More_Above":
+		for _, r := range runes[i+1:] {
+			switch CombiningClasses[r] {
+			case 230:
+				return true
+			case 0:
+				return false
+			}
+		}
+		return false
+	case "Before_Dot":
+		return beforeDot(runes, i)
+	case "Not_Before_Dot":
+		return !beforeDot(runes, i)
+	default:
+		return false
+	}
+}
+
+// C is preceded by a cased letter, and C is not followed by a cased letter
+// (both ignoring case-ignorable characters in between).
+func finalSigma(runes []rune, i int) bool {
+	before := false
+	for j := i - 1; j >= 0; j-- {
+		if !hasProp(runes[j], PropCaseIgnorable) {
+			before = hasProp(runes[j], PropCased)
+			break
+		}
+	}
+	if !before {
+		return false
+	}
+	for _, r := range runes[i+1:] {
+		if !hasProp(r, PropCaseIgnorable) {
+			return !hasProp(r, PropCased)
+		}
+	}
+	return true
+}
+
+// There is a character matching f before C, with no intervening character of
+// combining class 0 or 230.
+func after(runes []rune, i int, f func(rune) bool) bool {
+	for j := i - 1; j >= 0; j-- {
+		if f(runes[j]) {
+			return true
+		}
+		if c := CombiningClasses[runes[j]]; c == 0 || c == 230 {
+			return false
+		}
+	}
+	return false
+}
+
+// C is followed by U+0307 COMBINING DOT ABOVE, with no intervening character of
+// combining class 0 or 230.
+func beforeDot(runes []rune, i int) bool {
+	for _, r := range runes[i+1:] {
+		if r == 0x307 {
+			return true
+		}
+		if c := CombiningClasses[r]; c == 0 || c == 230 {
+			return false
+		}
+	}
+	return false
+}
+
+func hasProp(r rune, p Property) bool {
+	return inRanges(r, Props[p])
+}
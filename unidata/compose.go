@@ -0,0 +1,360 @@
+package unidata
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ComposeSequence is a sequence of keys from an X11 Compose file.
+type ComposeSequence struct {
+	Keys []string // Keysym names: "Multi_key", "equal", "e".
+	Text string   // Text it produces: "€".
+}
+
+// String formats the keys as in the Compose file: "<Multi_key> <equal> <e>".
+func (c ComposeSequence) String() string {
+	return "<" + strings.Join(c.Keys, "> <") + ">"
+}
+
+// HasPrefix reports if the sequence starts with the keys.
+func (c ComposeSequence) HasPrefix(keys []string) bool {
+	if len(keys) > len(c.Keys) {
+		return false
+	}
+	for i := range keys {
+		if keys[i] != c.Keys[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadCompose loads the X11 Compose sequences for the locale lang (e.g.
+// "en_US.UTF-8"), as libX11 would.
+//
+// This reads the user's Compose file ($XCOMPOSEFILE or ~/.XCompose) if it
+// exists, and the locale's Compose file from $XLOCALEDIR or
+// /usr/share/X11/locale otherwise. The user's file can include the locale's
+// file with 'include "%L"'.
+func LoadCompose(lang string) ([]ComposeSequence, error) {
+	c := composer{lang: lang, seen: make(map[string]int)}
+	file := c.userFile()
+	if file == "" {
+		file = c.systemFile()
+	}
+	if file == "" {
+		return nil, nil
+	}
+	if err := c.load(file, 0); err != nil {
+		return nil, err
+	}
+	return c.seqs, nil
+}
+
+// ComposeKeys gets the keysym names for the characters in s, for example
+// "equal", "e" for "=e"; this returns false if there is no keysym for a
+// character.
+func ComposeKeys(s string) ([]string, bool) {
+	keys := make([]string, 0, len(s))
+	for _, r := range s {
+		info, ok := Find(r)
+		if !ok || len(info.KeySyms) == 0 {
+			return nil, false
+		}
+		keys = append(keys, info.KeySyms[0])
+	}
+	return keys, true
+}
+
+type composer struct {
+	lang string
+	seqs []ComposeSequence
+	seen map[string]int // Index in seqs by the keys.
+}
+
+func (c composer) localeDir() string {
+	if d := os.Getenv("XLOCALEDIR"); d != "" {
+		return d
+	}
+	return "/usr/share/X11/locale"
+}
+
+// userFile gets the user's Compose file, or "" if it doesn't exist.
+func (c composer) userFile() string {
+	file := os.Getenv("XCOMPOSEFILE")
+	if file == "" {
+		h, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		file = filepath.Join(h, ".XCompose")
+	}
+	if _, err := os.Stat(file); err != nil {
+		return ""
+	}
+	return file
+}
+
+// systemFile finds the Compose file for the locale in compose.dir, which has
+// lines as "en_US.UTF-8/Compose: en_US.UTF-8"; older versions don't have the
+// colon.
+//
+// The codeset is normalized ("en_US.utf8" is "en_US.UTF-8"), and locales that
+// aren't in compose.dir are looked up in locale.alias as libX11 does (older
+// versions only have "C.UTF-8" there). Locales without a codeset ("C", "en_US")
+// are looked up as UTF-8, and the @modifier is removed if there is no file with
+// it ("de_DE@euro" is "de_DE.UTF-8").
+func (c composer) systemFile() string {
+	files := make(map[string]string)
+	for _, l := range c.readLocaleFile("compose.dir") {
+		if _, ok := files[l[1]]; !ok {
+			files[l[1]] = l[0]
+		}
+	}
+	if len(files) == 0 {
+		return ""
+	}
+	alias := make(map[string]string)
+	for _, l := range c.readLocaleFile("locale.alias") {
+		if _, ok := alias[l[0]]; !ok {
+			alias[l[0]] = l[1]
+		}
+	}
+
+	lang := c.lang
+	if lang == "" || lang == "POSIX" {
+		lang = "C"
+	}
+	canon, mod := canonLocale(lang)
+	for _, l := range []string{lang, canon + mod, canon, alias[lang], alias[canon+mod], alias[canon]} {
+		if f, ok := files[l]; ok && l != "" {
+			return filepath.Join(c.localeDir(), f)
+		}
+	}
+	return ""
+}
+
+// readLocaleFile reads a file with two columns from the locale directory, such
+// as compose.dir or locale.alias; the colon after the first column is removed.
+func (c composer) readLocaleFile(name string) [][2]string {
+	fp, err := os.Open(filepath.Join(c.localeDir(), name))
+	if err != nil {
+		return nil
+	}
+	defer fp.Close()
+
+	var lines [][2]string
+	scan := bufio.NewScanner(fp)
+	for scan.Scan() {
+		f := strings.Fields(scan.Text())
+		if len(f) != 2 || strings.HasPrefix(f[0], "#") {
+			continue
+		}
+		lines = append(lines, [2]string{strings.TrimSuffix(f[0], ":"), f[1]})
+	}
+	return lines
+}
+
+// canonLocale normalizes the codeset of a locale and splits off the modifier:
+// "en_US.utf8@euro" is "en_US.UTF-8" and "@euro". Locales without a codeset use
+// UTF-8.
+func canonLocale(lang string) (string, string) {
+	var mod string
+	if i := strings.IndexByte(lang, '@'); i > -1 {
+		lang, mod = lang[:i], lang[i:]
+	}
+	cs := "UTF-8"
+	if i := strings.IndexByte(lang, '.'); i > -1 {
+		lang, cs = lang[:i], lang[i+1:]
+		switch n := strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(cs)); {
+		case n == "utf8":
+			cs = "UTF-8"
+		case strings.HasPrefix(n, "iso8859"):
+			cs = "ISO8859-" + n[7:]
+		}
+	}
+	return lang + "." + cs, mod
+}
+
+func (c *composer) load(file string, depth int) error {
+	if depth > 10 {
+		return fmt.Errorf("unidata.LoadCompose: too many levels of includes in %q", file)
+	}
+
+	fp, err := os.Open(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("unidata.LoadCompose: %w", err)
+	}
+	defer fp.Close()
+
+	scan := bufio.NewScanner(fp)
+	for scan.Scan() {
+		line := strings.TrimSpace(scan.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		// include "%L"
+		if strings.HasPrefix(line, "include") {
+			inc, _, ok := composeString(strings.TrimSpace(line[7:]))
+			if !ok {
+				continue
+			}
+			inc = c.expand(inc)
+			if inc == "" {
+				continue
+			}
+			if err := c.load(inc, depth+1); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// <Multi_key> <equal> <e> : "€" EuroSign # EURO SIGN
+		colon := strings.Index(line, ":")
+		if colon == -1 {
+			continue
+		}
+		var keys []string
+		for _, k := range strings.Fields(line[:colon]) {
+			// Modifiers such as "!Ctrl" aren't supported; skip these lines.
+			if !strings.HasPrefix(k, "<") || !strings.HasSuffix(k, ">") {
+				keys = nil
+				break
+			}
+			keys = append(keys, k[1:len(k)-1])
+		}
+		if len(keys) == 0 {
+			continue
+		}
+
+		right := strings.TrimSpace(line[colon+1:])
+		text, rest, ok := composeString(right)
+		if !ok {
+			// No string, just the keysym: "<dead_acute> <e> : eacute".
+			text = ""
+			rest = right
+		}
+		if text == "" {
+			f := strings.Fields(rest)
+			if len(f) == 0 || f[0][0] == '#' {
+				continue
+			}
+			text = composeKeysym(f[0])
+			if text == "" {
+				continue
+			}
+		}
+
+		seq := ComposeSequence{Keys: keys, Text: text}
+		k := strings.Join(keys, " ")
+		if i, ok := c.seen[k]; ok {
+			c.seqs[i] = seq
+			continue
+		}
+		c.seen[k] = len(c.seqs)
+		c.seqs = append(c.seqs, seq)
+	}
+	return scan.Err()
+}
+
+// expand the substitutions in include paths: %L (the locale's Compose file),
+// %H ($HOME), %S (the system locale directory), and %% (a literal %).
+func (c composer) expand(path string) string {
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		if path[i] != '%' || i == len(path)-1 {
+			b.WriteByte(path[i])
+			continue
+		}
+		i++
+		switch path[i] {
+		case 'L':
+			sys := c.systemFile()
+			if sys == "" {
+				return ""
+			}
+			b.WriteString(sys)
+		case 'H':
+			b.WriteString(os.Getenv("HOME"))
+		case 'S':
+			b.WriteString(c.localeDir())
+		default:
+			b.WriteByte(path[i])
+		}
+	}
+	return b.String()
+}
+
+// composeString parses the quoted string at the start of s, returning the
+// string and the remainder of s.
+func composeString(s string) (string, string, bool) {
+	if !strings.HasPrefix(s, `"`) {
+		return "", s, false
+	}
+
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			return b.String(), s[i+1:], true
+		case '\\':
+			if i == len(s)-1 {
+				return "", s, false
+			}
+			i++
+			switch {
+			case s[i] == 'n':
+				b.WriteByte('\n')
+			case s[i] == 'x' || s[i] == 'X':
+				j := i + 1
+				for j < len(s) && j < i+3 && strings.IndexByte("0123456789abcdefABCDEF", s[j]) > -1 {
+					j++
+				}
+				n, err := strconv.ParseUint(s[i+1:j], 16, 8)
+				if err != nil {
+					return "", s, false
+				}
+				b.WriteByte(byte(n))
+				i = j - 1
+			case s[i] >= '0' && s[i] <= '7':
+				j := i
+				for j < len(s) && j < i+3 && s[j] >= '0' && s[j] <= '7' {
+					j++
+				}
+				n, err := strconv.ParseUint(s[i:j], 8, 8)
+				if err != nil {
+					return "", s, false
+				}
+				b.WriteByte(byte(n))
+				i = j - 1
+			default:
+				b.WriteByte(s[i])
+			}
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", s, false
+}
+
+// composeKeysym gets the text for a keysym name, which is either a known name
+// ("EuroSign") or the codepoint ("U20AC").
+func composeKeysym(name string) string {
+	if info, ok := FindKeySym(name); ok {
+		return string(info.Codepoint)
+	}
+	if len(name) > 1 && name[0] == 'U' {
+		if n, err := strconv.ParseUint(name[1:], 16, 32); err == nil {
+			return string(rune(n))
+		}
+	}
+	return ""
+}
@@ -0,0 +1,59 @@
+package unidata
+
+import (
+	"sort"
+	"strings"
+)
+
+// Map the skeleton to all the codepoints that have this skeleton.
+var skeletons = func() map[string][]rune {
+	m := make(map[string][]rune)
+	for cp, proto := range Confusables {
+		m[proto] = append(m[proto], cp)
+	}
+	for _, v := range m {
+		sort.Slice(v, func(i, j int) bool { return v[i] < v[j] })
+	}
+	return m
+}()
+
+// Skeleton gets the skeleton of s, as defined by UTS #39; two strings are
+// confusable if they have the same skeleton.
+//
+// https://www.unicode.org/reports/tr39/#Confusable_Detection
+func Skeleton(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, c := range Normalize(s, NFD) {
+		if proto, ok := Confusables[c]; ok {
+			b.WriteString(proto)
+		} else {
+			b.WriteRune(c)
+		}
+	}
+	return Normalize(b.String(), NFD)
+}
+
+// Confusable reports if a and b are visually confusable, that is, if they have
+// the same skeleton.
+func Confusable(a, b string) bool {
+	return Skeleton(a) == Skeleton(b)
+}
+
+// ConfusableWith gets everything that is visually confusable with cp; this may
+// include sequences of more than one codepoint (such as "rn" for "m"). The
+// list doesn't include cp itself.
+func ConfusableWith(cp rune) []string {
+	skel := Skeleton(string(cp))
+
+	var c []string
+	if skel != string(cp) {
+		c = append(c, skel)
+	}
+	for _, s := range skeletons[skel] {
+		if s != cp {
+			c = append(c, string(s))
+		}
+	}
+	return c
+}
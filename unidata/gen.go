@@ -32,6 +32,17 @@ func main() {
 
 	zli.F(run("codepoints"))
 	zli.F(run("emojis"))
+	zli.F(run("props"))
+	zli.F(run("case"))
+	zli.F(run("confusables"))
+	zli.F(run("namealiases"))
+	zli.F(run("namedsequences"))
+	zli.F(run("norm"))
+	zli.F(run("scripts"))
+	zli.F(run("age"))
+	zli.F(run("numeric"))
+	zli.F(run("bidi"))
+	zli.F(run("segment"))
 }
 
 func run(which string) error {
@@ -40,6 +51,28 @@ func run(which string) error {
 		return mkcodepoints()
 	case "emojis":
 		return mkemojis()
+	case "props":
+		return mkprops()
+	case "case":
+		return mkcase()
+	case "confusables":
+		return mkconfusables()
+	case "namealiases":
+		return mknamealiases()
+	case "namedsequences":
+		return mknamedsequences()
+	case "norm":
+		return mknorm()
+	case "scripts":
+		return mkscripts()
+	case "age":
+		return mkage()
+	case "numeric":
+		return mknumeric()
+	case "bidi":
+		return mkbidi()
+	case "segment":
+		return mksegment()
 	default:
 		return fmt.Errorf("unknown file: %q\n", which)
 	}
@@ -87,6 +120,7 @@ func mkemojis() error {
 
 	var (
 		emojis          = make(map[string][]string)
+		names           = make(map[string]string)
 		order           []string
 		group, subgroup string
 		groups          []string
@@ -124,10 +158,14 @@ func mkemojis() error {
 
 		codepoints := strings.TrimSpace(strings.Split(line, ";")[0])
 
-		// Get the name from the comment:
+		// Get the version and name from the comment:
 		//   # 😀 E2.0 grinning face
 		//   # 🦶🏿 E11.0 foot: dark skin tone
-		name := strings.SplitN(comment, " ", 3)[2]
+		var (
+			c       = strings.SplitN(comment, " ", 3)
+			version = strings.TrimPrefix(c[1], "E")
+			name    = c[2]
+		)
 
 		const (
 			GenderNone = 0
@@ -135,7 +173,7 @@ func mkemojis() error {
 			GenderRole = 2
 		)
 
-		tone := false
+		tone, tones := false, 0
 		gender := GenderNone
 		var cp []string
 		splitCodepoints := strings.Split(codepoints, " ")
@@ -149,6 +187,7 @@ func mkemojis() error {
 			// Skin tones
 			case 0x1f3fb, 0x1f3fc, 0x1f3fd, 0x1f3fe, 0x1f3ff:
 				tone = true
+				tones++
 			// ZWJ
 			case 0x200d:
 				// No nothing
@@ -176,29 +215,33 @@ func mkemojis() error {
 			}
 		}
 
-		// This ignores combining the "holding hands" and "kissing" with
-		// different skin tone variants:
+		key := strings.Join(cp, ", ")
+
+		// Emoji with two people can have a different skin tone for every
+		// person, which sometimes uses a different sequence than the emoji
+		// without skin tone:
 		//
-		// 1F468 1F3FB 200D 1F91D 200D 1F468 1F3FF 👨🏻‍🤝‍👨🏿
-		// E12.1 men holding hands: light skin tone, dark skin tone
+		// 1F46D                                    👭 E1.0 women holding hands
+		// 1F46D 1F3FB                              👭🏻 E12.0 women holding hands: light skin tone
+		// 1F469 1F3FB 200D 1F91D 200D 1F469 1F3FF  👩🏻‍🤝‍👩🏿 E12.1 women holding hands: light skin tone, dark skin tone
 		//
 		// 1F9D1 1F3FB 200D 2764 FE0F 200D 1F48B 200D 1F9D1 1F3FF 🧑🏻‍❤️‍💋‍🧑🏿
 		// E13.1 kiss: person, person, light skin tone, dark skin tone
 		//
-		// There is no good way to select this with the current UX/flagset, and
-		// to be honest I don't think it's very important either.
-		if tone && strings.Contains(name, "holding hands") {
-			gender = 0
-			tone = false
-			continue
-		}
-		if tone && (strings.Contains(name, "kiss:") || strings.Contains(name, "couple with heart")) {
-			tone = false
+		// The tones are always after the first and last person, so store the
+		// sequence without them.
+		if tones > 1 {
+			base, ok := emojis[key]
+			if !ok {
+				base, ok = emojis[names[strings.SplitN(name, ":", 2)[0]]]
+			}
+			if !ok {
+				return fmt.Errorf("not found: %q %q", key, name)
+			}
+			base[4], base[7] = "true", key
 			continue
 		}
 
-		key := strings.Join(cp, ", ")
-
 		// Newer gendered emoji; combine "person", "man", or "women" with
 		// something related to that:
 		//
@@ -260,7 +303,8 @@ func mkemojis() error {
 		}
 
 		emojis[key] = []string{
-			strings.Join(cp, ", "), name, group, subgroup, "false", "0"}
+			strings.Join(cp, ", "), name, group, subgroup, "false", "0", version, ""}
+		names[name] = key
 		order = append(order, key)
 	}
 
@@ -271,12 +315,19 @@ func mkemojis() error {
 		e := emojis[k]
 
 		g, _ := strconv.Atoi(e[5])
-		var cp []rune
+		var cp, multi []rune
 		for _, c := range strings.Split(e[0], ", ") {
 			n, err := strconv.ParseUint(c[2:], 16, 32)
 			zli.F(err)
 			cp = append(cp, rune(n))
 		}
+		if e[7] != "" {
+			for _, c := range strings.Split(e[7], ", ") {
+				n, err := strconv.ParseUint(c[2:], 16, 32)
+				zli.F(err)
+				multi = append(multi, rune(n))
+			}
+		}
 
 		var groupID, subgroupID int
 		for i, g := range groups {
@@ -299,6 +350,8 @@ func mkemojis() error {
 			Subgroup:   subgroupID,
 			SkinTones:  e[4] == "true",
 			Genders:    g,
+			Version:    e[6],
+			MultiTone:  multi,
 		}
 		emo[i].CLDR = cldr[strings.ReplaceAll(strings.ReplaceAll(emo[i].String(), "\ufe0f", ""), "\ufe0e", "")]
 	}
@@ -327,60 +380,34 @@ func mkemojis() error {
 		}
 		cp = cp[:len(cp)-2]
 
-		//                   CP   Name Grp  Sgr  CLDR sk  gnd
-		write(fp, "\t{[]rune{%s}, %#v, %#v, %#v, %#v, %t, %d},\n",
-			cp, e.Name, e.Group, e.Subgroup, e.CLDR, e.SkinTones, e.Genders)
+		multi := "nil"
+		if len(e.MultiTone) > 0 {
+			multi = ""
+			for _, c := range e.MultiTone {
+				multi += fmt.Sprintf("0x%x, ", c)
+			}
+			multi = "[]rune{" + multi[:len(multi)-2] + "}"
+		}
+
+		//                   CP   Name Grp  Sgr  CLDR sk  gnd Ver  Multi
+		write(fp, "\t{[]rune{%s}, %#v, %#v, %#v, %#v, %t, %d, %#v, %s},\n",
+			cp, e.Name, e.Group, e.Subgroup, e.CLDR, e.SkinTones, e.Genders, e.Version, multi)
 	}
 	write(fp, "}\n\n")
 
 	return nil
 }
 
-// TODO: add casefolding
-// https://unicode.org/Public/13.0.0/ucd/CaseFolding.txt
-// CaseFold []rune
-
-// TODO: add properties:
-// https://unicode.org/Public/13.0.0/ucd/PropList.txt
-// "uni p dash" should print all dashes.
-//
-//
-// TODO: add "confusable" information from
-// https://www.unicode.org/Public/idna/13.0.0/
-// and/or
-// https://www.unicode.org/Public/security/13.0.0/
-//
-//
-// TODO: add "alias" information from
-// https://unicode.org/Public/13.0.0/ucd/NamesList.txt
-// This is generated from other sources, but I can't really find where it gts
-// that "x (modifier letter prime - 02B9)" from.
-//
-// 0027	APOSTROPHE
-// 	= apostrophe-quote (1.0)
-// 	= APL quote
-// 	* neutral (vertical) glyph with mixed usage
-// 	* 2019 is preferred for apostrophe
-// 	* preferred characters in English for paired quotation marks are 2018 & 2019
-// 	* 05F3 is preferred for geresh when writing Hebrew
-// 	x (modifier letter prime - 02B9)
-// 	x (modifier letter apostrophe - 02BC)
-// 	x (modifier letter vertical line - 02C8)
-// 	x (combining acute accent - 0301)
-// 	x (hebrew punctuation geresh - 05F3)
-// 	x (prime - 2032)
-// 	x (latin small letter saltillo - A78C)
-
 // http://www.unicode.org/reports/tr44/
 func mkcodepoints() error {
 	text, err := fetch("https://www.unicode.org/Public/UCD/latest/ucd/UnicodeData.txt")
 	zli.F(err)
 
 	var (
-		widths   = loadwidths()
-		entities = loadentities()
-		digraphs = loaddigraphs()
-		keysyms  = loadkeysyms()
+		widths            = loadwidths()
+		entities, entseqs = loadentities()
+		digraphs          = loaddigraphs()
+		keysyms           = loadkeysyms()
 	)
 
 	fp, err := os.Create("gen_codepoints.go")
@@ -403,8 +430,6 @@ func mkcodepoints() error {
 		// Some properties (most notably control characters) all have the name
 		// as <control>, which isn't very useful. The old (obsolete) Unicode 1
 		// name field has a more useful name.
-		// TODO: add this information from:
-		// https://www.unicode.org/Public/UCD/latest/ucd/NamesList.txt
 		name := s[1]
 		if name[0] == '<' && len(s[10]) > 1 {
 			name = s[10]
@@ -414,22 +439,841 @@ func mkcodepoints() error {
 		zli.F(err)
 		cp := rune(c)
 
-		entitiy := entities[cp]
-		digraph := digraphs[cp]
-		keysym := ""
-		if _, ok := keysyms[cp]; ok {
+		var entity, keysym string
+		if len(entities[cp]) > 0 {
+			entity = entities[cp][0]
+		}
+		if len(keysyms[cp]) > 0 {
 			keysym = keysyms[cp][0]
 		}
 
-		//             CP     Wid    Cat Name Vim HTML Enti KSym
-		write(fp, "\t0x%x: {0x%[1]x, %d, %d, %#v, %#v, %#v, %#v},\n",
-			cp, widths[cp], unidata.Catmap[string(s[2])], string(name), digraph, entitiy, keysym)
+		//             CP     Wid    Cat Name Vim HTML KSym HTML KSym
+		write(fp, "\t0x%x: {0x%[1]x, %d, %d, %#v, %#v, %#v, %#v, %s, %s},\n",
+			cp, widths[cp], unidata.Catmap[string(s[2])], string(name), digraphs[cp],
+			entity, keysym, fmtstrings(entities[cp]), fmtstrings(keysyms[cp]))
+	}
+	write(fp, "}\n\n")
+
+	seqs := make([]string, 0, len(entseqs))
+	for k := range entseqs {
+		seqs = append(seqs, k)
+	}
+	sort.Strings(seqs)
+	write(fp, "var HTMLSequences = map[string][]string{\n")
+	for _, k := range seqs {
+		write(fp, "\t%s: %s,\n", strconv.QuoteToASCII(k), fmtstrings(entseqs[k]))
+	}
+	write(fp, "}\n")
+	return nil
+}
+
+func mkprops() error {
+	props := make(map[unidata.Property][][2]rune)
+	for _, url := range []string{
+		"https://www.unicode.org/Public/UCD/latest/ucd/PropList.txt",
+		"https://www.unicode.org/Public/UCD/latest/ucd/DerivedCoreProperties.txt",
+		"https://www.unicode.org/Public/UCD/latest/ucd/emoji/emoji-data.txt",
+	} {
+		for name, r := range loadranges(url) {
+			// Skip the Other_ contributory properties, as well as anything
+			// new we don't know about yet.
+			p, ok := unidata.Propmap[unidata.CanonicalCategory(name)]
+			if !ok {
+				continue
+			}
+			props[p] = r
+		}
 	}
 
+	fp, err := os.Create("gen_props.go")
+	zli.F(err)
+	defer func() { zli.F(fp.Close()) }()
+
+	write(fp, "// Code generated by gen.go; DO NOT EDIT\n\n"+
+		"package unidata\n\n"+
+		"var Props = map[Property][][2]rune{\n")
+	for i := 0; i < 64; i++ {
+		p := unidata.Property(1 << i)
+		r, ok := props[p]
+		if !ok {
+			continue
+		}
+		write(fp, "\t0x%x: { // %s\n", uint64(p), unidata.Propnames[p])
+		for _, rr := range r {
+			write(fp, "\t\t{0x%x, 0x%x},\n", rr[0], rr[1])
+		}
+		write(fp, "\t},\n")
+	}
 	write(fp, "}\n")
 	return nil
 }
 
+func mkcase() error {
+	maps := make(map[rune]*unidata.CaseMapping)
+	get := func(cp rune) *unidata.CaseMapping {
+		if _, ok := maps[cp]; !ok {
+			maps[cp] = &unidata.CaseMapping{}
+		}
+		return maps[cp]
+	}
+
+	// Simple mappings from UnicodeData.txt; the titlecase is the same as the
+	// uppercase if it's blank.
+	text, err := fetch("https://www.unicode.org/Public/UCD/latest/ucd/UnicodeData.txt")
+	zli.F(err)
+	for _, line := range bytes.Split(text, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		s := bytes.Split(line, []byte(";"))
+		cp := torune(string(s[0]))
+		upper, lower, title := torunes(string(s[12])), torunes(string(s[13])), torunes(string(s[14]))
+		if title == nil {
+			title = upper
+		}
+		if upper != nil || lower != nil || title != nil {
+			m := get(cp)
+			m.Upper, m.Lower, m.Title = upper, lower, title
+		}
+	}
+
+	// Full mappings from SpecialCasing.txt; the conditional ones are stored
+	// separately.
+	var special []unidata.SpecialCase
+	text, err = fetch("https://www.unicode.org/Public/UCD/latest/ucd/SpecialCasing.txt")
+	zli.F(err)
+	for _, line := range strings.Split(string(text), "\n") {
+		if p := strings.Index(line, "#"); p > -1 {
+			line = strings.TrimSpace(line[:p])
+		}
+		if len(line) == 0 {
+			continue
+		}
+
+		// <code>; <lower>; <title>; <upper>; (<condition_list>;)?
+		s := strings.Split(line, ";")
+		cp := torune(s[0])
+		lower, title, upper := torunes(s[1]), torunes(s[2]), torunes(s[3])
+		for _, m := range []*[]rune{&lower, &title, &upper} {
+			if *m == nil { // Maps to nothing, e.g. U+0307 after I in Turkish.
+				*m = []rune{}
+			}
+		}
+		if cond := strings.TrimSpace(s[4]); cond != "" {
+			sc := unidata.SpecialCase{Codepoint: cp, Lower: lower, Title: title, Upper: upper}
+			for _, c := range strings.Fields(cond) {
+				if strings.ToLower(c) == c {
+					sc.Lang = c
+				} else {
+					sc.Context = c
+				}
+			}
+			special = append(special, sc)
+			continue
+		}
+
+		m := get(cp)
+		m.Lower, m.Title, m.Upper = lower, title, upper
+	}
+
+	// Full case folding (status C and F) from CaseFolding.txt, and the
+	// Turkic ones (status T) as a special case.
+	text, err = fetch("https://www.unicode.org/Public/UCD/latest/ucd/CaseFolding.txt")
+	zli.F(err)
+	for _, line := range strings.Split(string(text), "\n") {
+		if p := strings.Index(line, "#"); p > -1 {
+			line = strings.TrimSpace(line[:p])
+		}
+		if len(line) == 0 {
+			continue
+		}
+
+		// <code>; <status>; <mapping>;
+		s := strings.Split(line, ";")
+		cp, mapping := torune(s[0]), torunes(s[2])
+		switch strings.TrimSpace(s[1]) {
+		case "C", "F":
+			get(cp).Fold = mapping
+		case "T":
+			for _, l := range []string{"tr", "az"} {
+				special = append(special, unidata.SpecialCase{Codepoint: cp, Fold: mapping, Lang: l})
+			}
+		}
+	}
+
+	// Don't need to store anything that maps to itself.
+	cps := make([]rune, 0, len(maps))
+	for cp, m := range maps {
+		for _, mm := range []*[]rune{&m.Upper, &m.Lower, &m.Title, &m.Fold} {
+			if len(*mm) == 1 && (*mm)[0] == cp {
+				*mm = nil
+			}
+		}
+		if m.Upper == nil && m.Lower == nil && m.Title == nil && m.Fold == nil {
+			continue
+		}
+		cps = append(cps, cp)
+	}
+	sort.Slice(cps, func(i, j int) bool { return cps[i] < cps[j] })
+
+	fp, err := os.Create("gen_case.go")
+	zli.F(err)
+	defer func() { zli.F(fp.Close()) }()
+
+	write(fp, "// Code generated by gen.go; DO NOT EDIT\n\n"+
+		"package unidata\n\n"+
+		"var Casemaps = map[rune]CaseMapping{\n")
+	for _, cp := range cps {
+		m := maps[cp]
+		//                 CP     Upper Lower Title Fold
+		write(fp, "\t0x%x: {%s, %s, %s, %s},\n",
+			cp, fmtrunes(m.Upper), fmtrunes(m.Lower), fmtrunes(m.Title), fmtrunes(m.Fold))
+	}
+	write(fp, "}\n\n")
+
+	write(fp, "var SpecialCasings = []SpecialCase{\n")
+	for _, s := range special {
+		//                 CP    Lower Title Upper Fold Lang Context
+		write(fp, "\t{0x%x, %s, %s, %s, %s, %#v, %#v},\n",
+			s.Codepoint, fmtrunes(s.Lower), fmtrunes(s.Title), fmtrunes(s.Upper), fmtrunes(s.Fold),
+			s.Lang, s.Context)
+	}
+	write(fp, "}\n")
+	return nil
+}
+
+// https://www.unicode.org/reports/tr39/#Confusable_Detection
+func mkconfusables() error {
+	text, err := fetch("https://www.unicode.org/Public/security/latest/confusables.txt")
+	zli.F(err)
+	text = bytes.TrimPrefix(text, []byte("\ufeff"))
+
+	type conf struct {
+		cp    rune
+		proto []rune
+	}
+	var confs []conf
+	for _, line := range strings.Split(string(text), "\n") {
+		if p := strings.Index(line, "#"); p > -1 {
+			line = strings.TrimSpace(line[:p])
+		}
+		if len(line) == 0 {
+			continue
+		}
+
+		// 0430 ;	0061 ;	MA	# ( а → a ) CYRILLIC SMALL LETTER A → LATIN SMALL LETTER A
+		s := strings.Split(line, ";")
+		confs = append(confs, conf{cp: torune(s[0]), proto: torunes(s[1])})
+	}
+	sort.Slice(confs, func(i, j int) bool { return confs[i].cp < confs[j].cp })
+
+	fp, err := os.Create("gen_confusables.go")
+	zli.F(err)
+	defer func() { zli.F(fp.Close()) }()
+
+	write(fp, "// Code generated by gen.go; DO NOT EDIT\n\n"+
+		"package unidata\n\n"+
+		"var Confusables = map[rune]string{\n")
+	for _, c := range confs {
+		// Escape everything as it's rather confusing otherwise.
+		write(fp, "\t0x%x: %+q,\n", c.cp, string(c.proto))
+	}
+	write(fp, "}\n")
+	return nil
+}
+
+func mknamealiases() error {
+	text, err := fetch("https://www.unicode.org/Public/UCD/latest/ucd/NameAliases.txt")
+	zli.F(err)
+
+	types := map[string]unidata.AliasType{
+		"correction":   unidata.AliasCorrection,
+		"control":      unidata.AliasControl,
+		"alternate":    unidata.AliasAlternate,
+		"figment":      unidata.AliasFigment,
+		"abbreviation": unidata.AliasAbbreviation,
+	}
+
+	var (
+		aliases = make(map[rune][]unidata.NameAlias)
+		order   []rune
+	)
+	for _, line := range strings.Split(string(text), "\n") {
+		if p := strings.Index(line, "#"); p > -1 {
+			line = strings.TrimSpace(line[:p])
+		}
+		if len(line) == 0 {
+			continue
+		}
+
+		// 01A2;LATIN CAPITAL LETTER GHA;correction
+		s := strings.Split(line, ";")
+		t, ok := types[s[2]]
+		if !ok {
+			return fmt.Errorf("mknamealiases: unknown type %q", s[2])
+		}
+		cp := torune(s[0])
+		if _, ok := aliases[cp]; !ok {
+			order = append(order, cp)
+		}
+		aliases[cp] = append(aliases[cp], unidata.NameAlias{Name: s[1], Type: t})
+	}
+	sort.Slice(order, func(i, j int) bool { return order[i] < order[j] })
+
+	fp, err := os.Create("gen_namealiases.go")
+	zli.F(err)
+	defer func() { zli.F(fp.Close()) }()
+
+	write(fp, "// Code generated by gen.go; DO NOT EDIT\n\n"+
+		"package unidata\n\n"+
+		"var NameAliases = map[rune][]NameAlias{\n")
+	for _, cp := range order {
+		write(fp, "\t0x%x: {", cp)
+		for i, a := range aliases[cp] {
+			if i > 0 {
+				write(fp, ", ")
+			}
+			write(fp, "{%#v, %d}", a.Name, a.Type)
+		}
+		write(fp, "},\n")
+	}
+	write(fp, "}\n\n")
+
+	// Unicode 1.0 names from UnicodeData.txt; these are informative, and
+	// NamesList.txt lists them as informal aliases.
+	text, err = fetch("https://www.unicode.org/Public/UCD/latest/ucd/UnicodeData.txt")
+	zli.F(err)
+	write(fp, "var Unicode1Names = map[rune]string{\n")
+	for _, line := range bytes.Split(text, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		s := bytes.Split(line, []byte(";"))
+		if len(s[10]) > 0 {
+			write(fp, "\t0x%x: %#v,\n", torune(string(s[0])), string(s[10]))
+		}
+	}
+	write(fp, "}\n")
+	return nil
+}
+
+func mknamedsequences() error {
+	text, err := fetch("https://www.unicode.org/Public/UCD/latest/ucd/NamedSequences.txt")
+	zli.F(err)
+
+	fp, err := os.Create("gen_namedsequences.go")
+	zli.F(err)
+	defer func() { zli.F(fp.Close()) }()
+
+	write(fp, "// Code generated by gen.go; DO NOT EDIT\n\n"+
+		"package unidata\n\n"+
+		"var NamedSequences = []NamedSequence{\n")
+	for _, line := range strings.Split(string(text), "\n") {
+		if p := strings.Index(line, "#"); p > -1 {
+			line = strings.TrimSpace(line[:p])
+		}
+		if len(line) == 0 {
+			continue
+		}
+
+		// KEYCAP DIGIT ONE;0031 FE0F 20E3
+		s := strings.Split(line, ";")
+		if len(s) != 2 {
+			return fmt.Errorf("mknamedsequences: invalid line: %q", line)
+		}
+		var cp string
+		for _, r := range torunes(s[1]) {
+			cp += fmt.Sprintf("0x%x, ", r)
+		}
+		write(fp, "\t{%#v, []rune{%s}},\n", s[0], cp[:len(cp)-2])
+	}
+	write(fp, "}\n")
+	return nil
+}
+
+func mknorm() error {
+	text, err := fetch("https://www.unicode.org/Public/UCD/latest/ucd/UnicodeData.txt")
+	zli.F(err)
+
+	var (
+		decomps = make(map[rune]unidata.Decomposition)
+		ccc     = make(map[rune]uint8)
+		order   []rune
+	)
+	for _, line := range strings.Split(string(text), "\n") {
+		if len(line) == 0 {
+			continue
+		}
+
+		// 00A0;NO-BREAK SPACE;Zs;0;CS;<noBreak> 0020;;;;N;NON-BREAKING SPACE;;;;
+		s := strings.Split(line, ";")
+		cp := torune(s[0])
+		c, err := strconv.ParseUint(s[3], 10, 8)
+		zli.F(err)
+		if c == 0 && s[5] == "" {
+			continue
+		}
+		order = append(order, cp)
+		ccc[cp] = uint8(c)
+		if s[5] != "" {
+			var d unidata.Decomposition
+			if s[5][0] == '<' {
+				p := strings.Index(s[5], ">")
+				d.Tag, s[5] = s[5][1:p], s[5][p+1:]
+			}
+			d.Mapping = torunes(s[5])
+			decomps[cp] = d
+		}
+	}
+
+	props := make(map[string][][2]rune)
+	for k, v := range loadranges("https://www.unicode.org/Public/UCD/latest/ucd/DerivedNormalizationProps.txt") {
+		props[strings.Join(strings.Fields(k), "")] = v
+	}
+
+	fp, err := os.Create("gen_norm.go")
+	zli.F(err)
+	defer func() { zli.F(fp.Close()) }()
+
+	write(fp, "// Code generated by gen.go; DO NOT EDIT\n\n"+
+		"package unidata\n\n"+
+		"var Decompositions = map[rune]Decomposition{\n")
+	for _, cp := range order {
+		if d, ok := decomps[cp]; ok {
+			write(fp, "\t0x%x: {%#v, %s},\n", cp, d.Tag, fmtrunes(d.Mapping))
+		}
+	}
+	write(fp, "}\n\n")
+
+	write(fp, "var CombiningClasses = map[rune]uint8{\n")
+	for _, cp := range order {
+		if ccc[cp] != 0 {
+			write(fp, "\t0x%x: %d,\n", cp, ccc[cp])
+		}
+	}
+	write(fp, "}\n\n")
+
+	writeRanges := func(r [][2]rune) {
+		write(fp, "{")
+		for i, rr := range r {
+			if i > 0 {
+				write(fp, ", ")
+			}
+			write(fp, "{0x%x, 0x%x}", rr[0], rr[1])
+		}
+		write(fp, "}")
+	}
+
+	write(fp, "var CompositionExclusions = [][2]rune")
+	writeRanges(props["Full_Composition_Exclusion"])
+	write(fp, "\n\n")
+
+	for _, qc := range []string{"Not", "Maybe"} {
+		write(fp, "var %sNormalized = map[Form][][2]rune{\n", qc)
+		for _, f := range unidata.Forms {
+			r := props[f.String()+"_QC;"+qc[:1]]
+			if len(r) == 0 {
+				continue
+			}
+			write(fp, "\t%s: ", f)
+			writeRanges(r)
+			write(fp, ",\n")
+		}
+		write(fp, "}\n\n")
+	}
+	return nil
+}
+
+func mkscripts() error {
+	text, err := fetch("https://www.unicode.org/Public/UCD/latest/ucd/PropertyValueAliases.txt")
+	zli.F(err)
+
+	// sc ; Cyrl ; Cyrillic
+	codes := make(map[string]string)
+	for _, line := range strings.Split(string(text), "\n") {
+		if p := strings.Index(line, "#"); p > -1 {
+			line = strings.TrimSpace(line[:p])
+		}
+		s := strings.Split(line, ";")
+		if len(s) < 3 || strings.TrimSpace(s[0]) != "sc" {
+			continue
+		}
+		codes[strings.TrimSpace(s[1])] = strings.TrimSpace(s[2])
+	}
+
+	scripts := loadranges("https://www.unicode.org/Public/UCD/latest/ucd/Scripts.txt")
+
+	// 0483 ; Cyrl Perm
+	ext := make(map[string][][2]rune)
+	for k, r := range loadranges("https://www.unicode.org/Public/UCD/latest/ucd/ScriptExtensions.txt") {
+		for _, c := range strings.Fields(k) {
+			name, ok := codes[c]
+			if !ok {
+				return fmt.Errorf("mkscripts: unknown script code %q", c)
+			}
+			ext[name] = append(ext[name], r...)
+		}
+	}
+	for k, r := range ext {
+		ext[k] = mergeranges(r)
+	}
+
+	fp, err := os.Create("gen_scripts.go")
+	zli.F(err)
+	defer func() { zli.F(fp.Close()) }()
+
+	write(fp, "// Code generated by gen.go; DO NOT EDIT\n\n"+
+		"package unidata\n\n")
+
+	for _, v := range []struct {
+		name string
+		m    map[string][][2]rune
+	}{{"Scripts", scripts}, {"ScriptExtensions", ext}} {
+		names := make([]string, 0, len(v.m))
+		for k := range v.m {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+
+		write(fp, "var %s = map[string][][2]rune{\n", v.name)
+		for _, n := range names {
+			write(fp, "\t%#v: {\n", n)
+			for _, rr := range v.m[n] {
+				write(fp, "\t\t{0x%x, 0x%x},\n", rr[0], rr[1])
+			}
+			write(fp, "\t},\n")
+		}
+		write(fp, "}\n\n")
+	}
+
+	c := make([]string, 0, len(codes))
+	for k := range codes {
+		c = append(c, k)
+	}
+	sort.Strings(c)
+	write(fp, "var ScriptCodes = map[string]string{\n")
+	for _, k := range c {
+		write(fp, "\t%#v: %#v,\n", k, codes[k])
+	}
+	write(fp, "}\n")
+	return nil
+}
+
+func mkage() error {
+	ages := loadranges("https://www.unicode.org/Public/UCD/latest/ucd/DerivedAge.txt")
+
+	versions := make([]string, 0, len(ages))
+	for v := range ages {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return unidata.CompareVersion(versions[i], versions[j]) < 0
+	})
+
+	fp, err := os.Create("gen_age.go")
+	zli.F(err)
+	defer func() { zli.F(fp.Close()) }()
+
+	write(fp, "// Code generated by gen.go; DO NOT EDIT\n\n"+
+		"package unidata\n\n"+
+		"var Ages = map[string][][2]rune{\n")
+	for _, v := range versions {
+		write(fp, "\t%#v: {\n", v)
+		for _, rr := range ages[v] {
+			write(fp, "\t\t{0x%x, 0x%x},\n", rr[0], rr[1])
+		}
+		write(fp, "\t},\n")
+	}
+	write(fp, "}\n")
+	return nil
+}
+
+func mknumeric() error {
+	types := map[string]unidata.NumericType{
+		"Decimal": unidata.NumericDecimal,
+		"Digit":   unidata.NumericDigit,
+		"Numeric": unidata.NumericNumeric,
+	}
+	nums := make(map[rune]unidata.Numeric)
+	for t, ranges := range loadranges("https://www.unicode.org/Public/UCD/latest/ucd/extracted/DerivedNumericType.txt") {
+		nt, ok := types[t]
+		if !ok {
+			return fmt.Errorf("mknumeric: unknown type %q", t)
+		}
+		for _, r := range ranges {
+			for cp := r[0]; cp <= r[1]; cp++ {
+				nums[cp] = unidata.Numeric{Type: nt}
+			}
+		}
+	}
+
+	// 00BD          ; 0.5 ; ; 1/2 # No       VULGAR FRACTION ONE HALF
+	for v, ranges := range loadranges("https://www.unicode.org/Public/UCD/latest/ucd/extracted/DerivedNumericValues.txt") {
+		s := strings.Split(v, ";")
+		rat := strings.SplitN(strings.TrimSpace(s[len(s)-1]), "/", 2)
+		num, err := strconv.ParseInt(rat[0], 10, 64)
+		zli.F(err)
+		den := int64(1)
+		if len(rat) > 1 {
+			den, err = strconv.ParseInt(rat[1], 10, 64)
+			zli.F(err)
+		}
+		for _, r := range ranges {
+			for cp := r[0]; cp <= r[1]; cp++ {
+				n, ok := nums[cp]
+				if !ok {
+					return fmt.Errorf("mknumeric: no numeric type for U+%04X", cp)
+				}
+				n.Numerator, n.Denominator = num, den
+				nums[cp] = n
+			}
+		}
+	}
+
+	order := make([]rune, 0, len(nums))
+	for cp := range nums {
+		order = append(order, cp)
+	}
+	sort.Slice(order, func(i, j int) bool { return order[i] < order[j] })
+
+	fp, err := os.Create("gen_numeric.go")
+	zli.F(err)
+	defer func() { zli.F(fp.Close()) }()
+
+	write(fp, "// Code generated by gen.go; DO NOT EDIT\n\n"+
+		"package unidata\n\n"+
+		"var Numerics = map[rune]Numeric{\n")
+	for _, cp := range order {
+		n := nums[cp]
+		write(fp, "\t0x%x: {%d, %d, %d},\n", cp, n.Type, n.Numerator, n.Denominator)
+	}
+	write(fp, "}\n")
+	return nil
+}
+
+func mkbidi() error {
+	classes := make(map[unidata.BidiClass][][2]rune)
+	for k, r := range loadranges("https://www.unicode.org/Public/UCD/latest/ucd/extracted/DerivedBidiClass.txt") {
+		c, err := unidata.ParseBidiClass(k)
+		if err != nil {
+			return fmt.Errorf("mkbidi: %w", err)
+		}
+		classes[c] = r
+	}
+
+	text, err := fetch("https://www.unicode.org/Public/UCD/latest/ucd/UnicodeData.txt")
+	zli.F(err)
+	var mirrored [][2]rune
+	for _, line := range strings.Split(string(text), "\n") {
+		// 0028;LEFT PARENTHESIS;Ps;0;ON;;;;;Y;OPENING PARENTHESIS;;;;
+		s := strings.Split(line, ";")
+		if len(s) < 10 || s[9] != "Y" {
+			continue
+		}
+		cp := torune(s[0])
+		mirrored = append(mirrored, [2]rune{cp, cp})
+	}
+	mirrored = mergeranges(mirrored)
+
+	// 0028; 0029 # LEFT PARENTHESIS
+	// 0028; 0029; o # LEFT PARENTHESIS
+	readPairs := func(url string) ([]rune, map[rune][]string) {
+		text, err := fetch(url)
+		zli.F(err)
+		var (
+			order []rune
+			m     = make(map[rune][]string)
+		)
+		for _, line := range strings.Split(string(text), "\n") {
+			if p := strings.Index(line, "#"); p > -1 {
+				line = line[:p]
+			}
+			s := strings.Split(line, ";")
+			if len(s) < 2 {
+				continue
+			}
+			cp := torune(s[0])
+			order = append(order, cp)
+			for i := range s[1:] {
+				m[cp] = append(m[cp], strings.TrimSpace(s[i+1]))
+			}
+		}
+		return order, m
+	}
+	mirrorOrder, mirrors := readPairs("https://www.unicode.org/Public/UCD/latest/ucd/BidiMirroring.txt")
+	bracketOrder, brackets := readPairs("https://www.unicode.org/Public/UCD/latest/ucd/BidiBrackets.txt")
+
+	fp, err := os.Create("gen_bidi.go")
+	zli.F(err)
+	defer func() { zli.F(fp.Close()) }()
+
+	write(fp, "// Code generated by gen.go; DO NOT EDIT\n\n"+
+		"package unidata\n\n"+
+		"var BidiClasses = map[BidiClass][][2]rune{\n")
+	for c := unidata.BidiL; c <= unidata.BidiPDI; c++ {
+		if len(classes[c]) == 0 {
+			continue
+		}
+		write(fp, "\tBidi%s: {\n", c)
+		for _, rr := range classes[c] {
+			write(fp, "\t\t{0x%x, 0x%x},\n", rr[0], rr[1])
+		}
+		write(fp, "\t},\n")
+	}
+	write(fp, "}\n\n")
+
+	write(fp, "var BidiMirrored = [][2]rune{\n")
+	for _, rr := range mirrored {
+		write(fp, "\t{0x%x, 0x%x},\n", rr[0], rr[1])
+	}
+	write(fp, "}\n\n")
+
+	write(fp, "var BidiMirroringGlyphs = map[rune]rune{\n")
+	for _, cp := range mirrorOrder {
+		write(fp, "\t0x%x: 0x%x,\n", cp, torune(mirrors[cp][0]))
+	}
+	write(fp, "}\n\n")
+
+	write(fp, "var BidiBrackets = map[rune]BidiBracket{\n")
+	for _, cp := range bracketOrder {
+		write(fp, "\t0x%x: {0x%x, %t},\n", cp, torune(brackets[cp][0]), brackets[cp][1] == "o")
+	}
+	write(fp, "}\n")
+	return nil
+}
+
+func mksegment() error {
+	fp, err := os.Create("gen_segment.go")
+	zli.F(err)
+	defer func() { zli.F(fp.Close()) }()
+
+	write(fp, "// Code generated by gen.go; DO NOT EDIT\n\n"+
+		"package unidata\n\n")
+
+	for _, v := range []struct {
+		name, url string
+	}{
+		{"GraphemeBreaks", "https://www.unicode.org/Public/UCD/latest/ucd/auxiliary/GraphemeBreakProperty.txt"},
+		{"WordBreaks", "https://www.unicode.org/Public/UCD/latest/ucd/auxiliary/WordBreakProperty.txt"},
+		{"SentenceBreaks", "https://www.unicode.org/Public/UCD/latest/ucd/auxiliary/SentenceBreakProperty.txt"},
+		{"LineBreaks", "https://www.unicode.org/Public/UCD/latest/ucd/LineBreak.txt"},
+	} {
+		m := loadranges(v.url)
+		names := make([]string, 0, len(m))
+		for k := range m {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+
+		write(fp, "var %s = map[string][][2]rune{\n", v.name)
+		for _, n := range names {
+			write(fp, "\t%#v: {\n", n)
+			for _, rr := range m[n] {
+				write(fp, "\t\t{0x%x, 0x%x},\n", rr[0], rr[1])
+			}
+			write(fp, "\t},\n")
+		}
+		write(fp, "}\n\n")
+	}
+	return nil
+}
+
+// torune converts a hex codepoint such as "00DF" to a rune.
+func torune(s string) rune {
+	cp, err := strconv.ParseUint(strings.TrimSpace(s), 16, 32)
+	zli.F(err)
+	return rune(cp)
+}
+
+// torunes converts a list of hex codepoints such as "0053 0073" to runes.
+//
+// This returns nil for a blank string.
+func torunes(s string) []rune {
+	f := strings.Fields(s)
+	if len(f) == 0 {
+		return nil
+	}
+	r := make([]rune, 0, len(f))
+	for _, c := range f {
+		r = append(r, torune(c))
+	}
+	return r
+}
+
+// fmtrunes formats a list of runes as Go code; an empty list (rather than nil)
+// is written as []rune{}.
+func fmtrunes(r []rune) string {
+	if r == nil {
+		return "nil"
+	}
+	s := make([]string, 0, len(r))
+	for _, c := range r {
+		s = append(s, fmt.Sprintf("0x%x", c))
+	}
+	return "[]rune{" + strings.Join(s, ", ") + "}"
+}
+
+// fmtstrings formats a list of strings as Go code, like fmtrunes.
+func fmtstrings(s []string) string {
+	if s == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("%#v", s)
+}
+
+// loadranges loads a UCD file in the format:
+//
+//	0009..000D    ; White_Space # Cc   [5] <control-0009>..<control-000D>
+//	0020          ; White_Space # Zs       SPACE
+//
+// The ranges are returned per value, sorted and with adjacent ranges merged.
+func loadranges(url string) map[string][][2]rune {
+	text, err := fetch(url)
+	zli.F(err)
+
+	ranges := make(map[string][][2]rune)
+	for _, line := range bytes.Split(text, []byte("\n")) {
+		if p := bytes.Index(line, []byte("#")); p > -1 {
+			line = bytes.TrimSpace(line[:p])
+		}
+		if len(line) == 0 {
+			continue
+		}
+
+		s := bytes.SplitN(line, []byte(";"), 2)
+		rng := bytes.Split(bytes.TrimSpace(s[0]), []byte(".."))
+		start, err := strconv.ParseUint(string(rng[0]), 16, 32)
+		zli.F(err)
+		end := start
+		if len(rng) > 1 {
+			end, err = strconv.ParseUint(string(rng[1]), 16, 32)
+			zli.F(err)
+		}
+
+		v := string(bytes.TrimSpace(s[1]))
+		ranges[v] = append(ranges[v], [2]rune{rune(start), rune(end)})
+	}
+
+	for v, r := range ranges {
+		ranges[v] = mergeranges(r)
+	}
+	return ranges
+}
+
+// mergeranges sorts the ranges and merges adjacent ones.
+func mergeranges(r [][2]rune) [][2]rune {
+	sort.Slice(r, func(i, j int) bool { return r[i][0] < r[j][0] })
+	merged := r[:1]
+	for _, rr := range r[1:] {
+		if l := &merged[len(merged)-1]; rr[0] == l[1]+1 {
+			l[1] = rr[1]
+		} else {
+			merged = append(merged, rr)
+		}
+	}
+	return merged
+}
+
 func loadwidths() map[rune]uint8 {
 	text, err := fetch("http://www.unicode.org/Public/UCD/latest/ucd/EastAsianWidth.txt")
 	zli.F(err)
@@ -489,7 +1333,9 @@ func getwidth(w string) uint8 {
 	}
 }
 
-func loadentities() map[rune]string {
+// loadentities loads the HTML entities for single codepoints, and for sequences
+// of codepoints.
+func loadentities() (map[rune][]string, map[string][]string) {
 	j, err := fetch("https://html.spec.whatwg.org/entities.json")
 	zli.F(err)
 
@@ -515,41 +1361,28 @@ func loadentities() map[rune]string {
 	for i, j := 0, len(sorted)-1; i < j; i, j = i+1, j-1 {
 		sorted[i], sorted[j] = sorted[j], sorted[i]
 	}
-	sort.Slice(sorted, func(i, j int) bool {
+	sort.SliceStable(sorted, func(i, j int) bool {
 		return len(sorted[i]) < len(sorted[j])
 	})
 
-	entities := make(map[rune]string)
-	var seen []rune
+	var (
+		entities = make(map[rune][]string)
+		seqs     = make(map[string][]string)
+	)
 	for _, ent := range sorted {
 		cp := out[ent].Codepoints
 
-		// TODO: some entities represent two codepoints; for example
-		// &NotEqualTilde; is U+02242 (MINUS TILDE) plus U+000338 (COMBINING
-		// LONG SOLIDUS OVERLAY).
-		// I can't be bothered to implement this right now.
+		// Some entities represent two codepoints; for example &NotEqualTilde;
+		// is U+02242 (MINUS TILDE) plus U+000338 (COMBINING LONG SOLIDUS
+		// OVERLAY).
 		if len(cp) != 1 {
+			seqs[string(cp)] = append(seqs[string(cp)], strings.Trim(ent, "&;"))
 			continue
 		}
-
-		found := false
-		for _, s := range seen {
-			if cp[0] == s {
-				found = true
-				break
-			}
-		}
-		if found {
-			continue
-		}
-
-		entities[cp[0]] = strings.Trim(ent, "&;")
-
-		// TODO: don't need seen?
-		seen = append(seen, cp[0])
+		entities[cp[0]] = append(entities[cp[0]], strings.Trim(ent, "&;"))
 	}
 
-	return entities
+	return entities, seqs
 }
 
 func loadkeysyms() map[rune][]string {
@@ -31,6 +31,7 @@ var EmojiSubgroups = map[string][]string{
 		"face-costume",
 		"cat-face",
 		"monkey-face",
+		"heart",
 		"emotion",
 	},
 	"People & Body": []string{
@@ -139,1677 +140,1714 @@ var EmojiSubgroups = map[string][]string{
 }
 
 var Emojis = []Emoji{
-	{[]rune{0x1f600}, "grinning face", 0, 0, []string{"face", "grin", "grinning face"}, false, 0},
-	{[]rune{0x1f603}, "grinning face with big eyes", 0, 0, []string{"face", "grinning face with big eyes", "mouth", "open", "smile"}, false, 0},
-	{[]rune{0x1f604}, "grinning face with smiling eyes", 0, 0, []string{"eye", "face", "grinning face with smiling eyes", "mouth", "open", "smile"}, false, 0},
-	{[]rune{0x1f601}, "beaming face with smiling eyes", 0, 0, []string{"beaming face with smiling eyes", "eye", "face", "grin", "smile"}, false, 0},
-	{[]rune{0x1f606}, "grinning squinting face", 0, 0, []string{"face", "grinning squinting face", "laugh", "mouth", "satisfied", "smile"}, false, 0},
-	{[]rune{0x1f605}, "grinning face with sweat", 0, 0, []string{"cold", "face", "grinning face with sweat", "open", "smile", "sweat"}, false, 0},
-	{[]rune{0x1f923}, "rolling on the floor laughing", 0, 0, []string{"face", "floor", "laugh", "rofl", "rolling", "rolling on the floor laughing", "rotfl"}, false, 0},
-	{[]rune{0x1f602}, "face with tears of joy", 0, 0, []string{"face", "face with tears of joy", "joy", "laugh", "tear"}, false, 0},
-	{[]rune{0x1f642}, "slightly smiling face", 0, 0, []string{"face", "slightly smiling face", "smile"}, false, 0},
-	{[]rune{0x1f643}, "upside-down face", 0, 0, []string{"face", "upside-down"}, false, 0},
-	{[]rune{0x1f609}, "winking face", 0, 0, []string{"face", "wink", "winking face"}, false, 0},
-	{[]rune{0x1f60a}, "smiling face with smiling eyes", 0, 0, []string{"blush", "eye", "face", "smile", "smiling face with smiling eyes"}, false, 0},
-	{[]rune{0x1f607}, "smiling face with halo", 0, 0, []string{"angel", "face", "fantasy", "halo", "innocent", "smiling face with halo"}, false, 0},
-	{[]rune{0x1f970}, "smiling face with hearts", 0, 1, []string{"adore", "crush", "hearts", "in love", "smiling face with hearts"}, false, 0},
-	{[]rune{0x1f60d}, "smiling face with heart-eyes", 0, 1, []string{"eye", "face", "love", "smile", "smiling face with heart-eyes"}, false, 0},
-	{[]rune{0x1f929}, "star-struck", 0, 1, []string{"eyes", "face", "grinning", "star", "star-struck"}, false, 0},
-	{[]rune{0x1f618}, "face blowing a kiss", 0, 1, []string{"face", "face blowing a kiss", "kiss"}, false, 0},
-	{[]rune{0x1f617}, "kissing face", 0, 1, []string{"face", "kiss", "kissing face"}, false, 0},
-	{[]rune{0x263a, 0xfe0f}, "smiling face", 0, 1, []string{"face", "outlined", "relaxed", "smile", "smiling face"}, false, 0},
-	{[]rune{0x1f61a}, "kissing face with closed eyes", 0, 1, []string{"closed", "eye", "face", "kiss", "kissing face with closed eyes"}, false, 0},
-	{[]rune{0x1f619}, "kissing face with smiling eyes", 0, 1, []string{"eye", "face", "kiss", "kissing face with smiling eyes", "smile"}, false, 0},
-	{[]rune{0x1f972}, "smiling face with tear", 0, 1, []string{"grateful", "proud", "relieved", "smiling", "smiling face with tear", "tear", "touched"}, false, 0},
-	{[]rune{0x1f60b}, "face savoring food", 0, 2, []string{"delicious", "face", "face savoring food", "savouring", "smile", "yum"}, false, 0},
-	{[]rune{0x1f61b}, "face with tongue", 0, 2, []string{"face", "face with tongue", "tongue"}, false, 0},
-	{[]rune{0x1f61c}, "winking face with tongue", 0, 2, []string{"eye", "face", "joke", "tongue", "wink", "winking face with tongue"}, false, 0},
-	{[]rune{0x1f92a}, "zany face", 0, 2, []string{"eye", "goofy", "large", "small", "zany face"}, false, 0},
-	{[]rune{0x1f61d}, "squinting face with tongue", 0, 2, []string{"eye", "face", "horrible", "squinting face with tongue", "taste", "tongue"}, false, 0},
-	{[]rune{0x1f911}, "money-mouth face", 0, 2, []string{"face", "money", "money-mouth face", "mouth"}, false, 0},
-	{[]rune{0x1f917}, "hugging face", 0, 3, []string{"face", "hug", "hugging"}, false, 0},
-	{[]rune{0x1f92d}, "face with hand over mouth", 0, 3, []string{"face with hand over mouth", "whoops"}, false, 0},
-	{[]rune{0x1f92b}, "shushing face", 0, 3, []string{"quiet", "shush", "shushing face"}, false, 0},
-	{[]rune{0x1f914}, "thinking face", 0, 3, []string{"face", "thinking"}, false, 0},
-	{[]rune{0x1f910}, "zipper-mouth face", 0, 4, []string{"face", "mouth", "zipper", "zipper-mouth face"}, false, 0},
-	{[]rune{0x1f928}, "face with raised eyebrow", 0, 4, []string{"distrust", "face with raised eyebrow", "skeptic"}, false, 0},
-	{[]rune{0x1f610}, "neutral face", 0, 4, []string{"deadpan", "face", "meh", "neutral"}, false, 0},
-	{[]rune{0x1f611}, "expressionless face", 0, 4, []string{"expressionless", "face", "inexpressive", "meh", "unexpressive"}, false, 0},
-	{[]rune{0x1f636}, "face without mouth", 0, 4, []string{"face", "face without mouth", "mouth", "quiet", "silent"}, false, 0},
-	{[]rune{0x1f636, 0x1f32b, 0xfe0f}, "face in clouds", 0, 4, []string{"absentminded", "face in clouds", "face in the fog", "head in clouds"}, false, 0},
-	{[]rune{0x1f60f}, "smirking face", 0, 4, []string{"face", "smirk", "smirking face"}, false, 0},
-	{[]rune{0x1f612}, "unamused face", 0, 4, []string{"face", "unamused", "unhappy"}, false, 0},
-	{[]rune{0x1f644}, "face with rolling eyes", 0, 4, []string{"eyeroll", "eyes", "face", "face with rolling eyes", "rolling"}, false, 0},
-	{[]rune{0x1f62c}, "grimacing face", 0, 4, []string{"face", "grimace", "grimacing face"}, false, 0},
-	{[]rune{0x1f62e, 0x1f4a8}, "face exhaling", 0, 4, []string{"exhale", "face exhaling", "gasp", "groan", "relief", "whisper", "whistle"}, false, 0},
-	{[]rune{0x1f925}, "lying face", 0, 4, []string{"face", "lie", "lying face", "pinocchio"}, false, 0},
-	{[]rune{0x1f60c}, "relieved face", 0, 5, []string{"face", "relieved"}, false, 0},
-	{[]rune{0x1f614}, "pensive face", 0, 5, []string{"dejected", "face", "pensive"}, false, 0},
-	{[]rune{0x1f62a}, "sleepy face", 0, 5, []string{"face", "sleep", "sleepy face"}, false, 0},
-	{[]rune{0x1f924}, "drooling face", 0, 5, []string{"drooling", "face"}, false, 0},
-	{[]rune{0x1f634}, "sleeping face", 0, 5, []string{"face", "sleep", "sleeping face", "zzz"}, false, 0},
-	{[]rune{0x1f637}, "face with medical mask", 0, 6, []string{"cold", "doctor", "face", "face with medical mask", "mask", "sick"}, false, 0},
-	{[]rune{0x1f912}, "face with thermometer", 0, 6, []string{"face", "face with thermometer", "ill", "sick", "thermometer"}, false, 0},
-	{[]rune{0x1f915}, "face with head-bandage", 0, 6, []string{"bandage", "face", "face with head-bandage", "hurt", "injury"}, false, 0},
-	{[]rune{0x1f922}, "nauseated face", 0, 6, []string{"face", "nauseated", "vomit"}, false, 0},
-	{[]rune{0x1f92e}, "face vomiting", 0, 6, []string{"face vomiting", "sick", "vomit", "puke"}, false, 0},
-	{[]rune{0x1f927}, "sneezing face", 0, 6, []string{"face", "gesundheit", "sneeze", "sneezing face"}, false, 0},
-	{[]rune{0x1f975}, "hot face", 0, 6, []string{"feverish", "heat stroke", "hot", "hot face", "red-faced", "sweating"}, false, 0},
-	{[]rune{0x1f976}, "cold face", 0, 6, []string{"blue-faced", "cold", "cold face", "freezing", "frostbite", "icicles"}, false, 0},
-	{[]rune{0x1f974}, "woozy face", 0, 6, []string{"dizzy", "intoxicated", "tipsy", "uneven eyes", "wavy mouth", "woozy face"}, false, 0},
-	{[]rune{0x1f635}, "knocked-out face", 0, 6, []string{"dead", "face", "knocked out", "knocked-out face"}, false, 0},
-	{[]rune{0x1f635, 0x1f4ab}, "face with spiral eyes", 0, 6, []string{"dizzy", "face with spiral eyes", "hypnotized", "spiral", "trouble", "whoa"}, false, 0},
-	{[]rune{0x1f92f}, "exploding head", 0, 6, []string{"exploding head", "mind blown", "shocked"}, false, 0},
-	{[]rune{0x1f920}, "cowboy hat face", 0, 7, []string{"cowboy", "cowgirl", "face", "hat"}, false, 0},
-	{[]rune{0x1f973}, "partying face", 0, 7, []string{"celebration", "hat", "horn", "party", "partying face"}, false, 0},
-	{[]rune{0x1f978}, "disguised face", 0, 7, []string{"disguise", "disguised face", "face", "glasses", "incognito", "nose"}, false, 0},
-	{[]rune{0x1f60e}, "smiling face with sunglasses", 0, 8, []string{"bright", "cool", "face", "smiling face with sunglasses", "sun", "sunglasses"}, false, 0},
-	{[]rune{0x1f913}, "nerd face", 0, 8, []string{"face", "geek", "nerd"}, false, 0},
-	{[]rune{0x1f9d0}, "face with monocle", 0, 8, []string{"face with monocle", "stuffy"}, false, 0},
-	{[]rune{0x1f615}, "confused face", 0, 9, []string{"confused", "face", "meh"}, false, 0},
-	{[]rune{0x1f61f}, "worried face", 0, 9, []string{"face", "worried"}, false, 0},
-	{[]rune{0x1f641}, "slightly frowning face", 0, 9, []string{"face", "frown", "slightly frowning face"}, false, 0},
-	{[]rune{0x2639, 0xfe0f}, "frowning face", 0, 9, []string{"face", "frown", "frowning face"}, false, 0},
-	{[]rune{0x1f62e}, "face with open mouth", 0, 9, []string{"face", "face with open mouth", "mouth", "open", "sympathy"}, false, 0},
-	{[]rune{0x1f62f}, "hushed face", 0, 9, []string{"face", "hushed", "stunned", "surprised"}, false, 0},
-	{[]rune{0x1f632}, "astonished face", 0, 9, []string{"astonished", "face", "shocked", "totally"}, false, 0},
-	{[]rune{0x1f633}, "flushed face", 0, 9, []string{"dazed", "face", "flushed"}, false, 0},
-	{[]rune{0x1f97a}, "pleading face", 0, 9, []string{"begging", "mercy", "pleading face", "puppy eyes"}, false, 0},
-	{[]rune{0x1f626}, "frowning face with open mouth", 0, 9, []string{"face", "frown", "frowning face with open mouth", "mouth", "open"}, false, 0},
-	{[]rune{0x1f627}, "anguished face", 0, 9, []string{"anguished", "face"}, false, 0},
-	{[]rune{0x1f628}, "fearful face", 0, 9, []string{"face", "fear", "fearful", "scared"}, false, 0},
-	{[]rune{0x1f630}, "anxious face with sweat", 0, 9, []string{"anxious face with sweat", "blue", "cold", "face", "rushed", "sweat"}, false, 0},
-	{[]rune{0x1f625}, "sad but relieved face", 0, 9, []string{"disappointed", "face", "relieved", "sad but relieved face", "whew"}, false, 0},
-	{[]rune{0x1f622}, "crying face", 0, 9, []string{"cry", "crying face", "face", "sad", "tear"}, false, 0},
-	{[]rune{0x1f62d}, "loudly crying face", 0, 9, []string{"cry", "face", "loudly crying face", "sad", "sob", "tear"}, false, 0},
-	{[]rune{0x1f631}, "face screaming in fear", 0, 9, []string{"face", "face screaming in fear", "fear", "munch", "scared", "scream"}, false, 0},
-	{[]rune{0x1f616}, "confounded face", 0, 9, []string{"confounded", "face"}, false, 0},
-	{[]rune{0x1f623}, "persevering face", 0, 9, []string{"face", "persevere", "persevering face"}, false, 0},
-	{[]rune{0x1f61e}, "disappointed face", 0, 9, []string{"disappointed", "face"}, false, 0},
-	{[]rune{0x1f613}, "downcast face with sweat", 0, 9, []string{"cold", "downcast face with sweat", "face", "sweat"}, false, 0},
-	{[]rune{0x1f629}, "weary face", 0, 9, []string{"face", "tired", "weary"}, false, 0},
-	{[]rune{0x1f62b}, "tired face", 0, 9, []string{"face", "tired"}, false, 0},
-	{[]rune{0x1f971}, "yawning face", 0, 9, []string{"bored", "tired", "yawn", "yawning face"}, false, 0},
-	{[]rune{0x1f624}, "face with steam from nose", 0, 10, []string{"face", "face with steam from nose", "triumph", "won"}, false, 0},
-	{[]rune{0x1f621}, "pouting face", 0, 10, []string{"angry", "face", "mad", "pouting", "rage", "red"}, false, 0},
-	{[]rune{0x1f620}, "angry face", 0, 10, []string{"angry", "face", "mad", "anger"}, false, 0},
-	{[]rune{0x1f92c}, "face with symbols on mouth", 0, 10, []string{"face with symbols on mouth", "swearing"}, false, 0},
-	{[]rune{0x1f608}, "smiling face with horns", 0, 10, []string{"face", "fairy tale", "fantasy", "horns", "smile", "smiling face with horns"}, false, 0},
-	{[]rune{0x1f47f}, "angry face with horns", 0, 10, []string{"angry face with horns", "demon", "devil", "face", "fantasy", "imp"}, false, 0},
-	{[]rune{0x1f480}, "skull", 0, 10, []string{"death", "face", "fairy tale", "monster", "skull"}, false, 0},
-	{[]rune{0x2620, 0xfe0f}, "skull and crossbones", 0, 10, []string{"crossbones", "death", "face", "monster", "skull", "skull and crossbones"}, false, 0},
-	{[]rune{0x1f4a9}, "pile of poo", 0, 11, []string{"dung", "face", "monster", "pile of poo", "poo", "poop"}, false, 0},
-	{[]rune{0x1f921}, "clown face", 0, 11, []string{"clown", "face"}, false, 0},
-	{[]rune{0x1f479}, "ogre", 0, 11, []string{"creature", "face", "fairy tale", "fantasy", "monster", "ogre"}, false, 0},
-	{[]rune{0x1f47a}, "goblin", 0, 11, []string{"creature", "face", "fairy tale", "fantasy", "goblin", "monster"}, false, 0},
-	{[]rune{0x1f47b}, "ghost", 0, 11, []string{"creature", "face", "fairy tale", "fantasy", "ghost", "monster"}, false, 0},
-	{[]rune{0x1f47d}, "alien", 0, 11, []string{"alien", "creature", "extraterrestrial", "face", "fantasy", "ufo"}, false, 0},
-	{[]rune{0x1f47e}, "alien monster", 0, 11, []string{"alien", "creature", "extraterrestrial", "face", "monster", "ufo"}, false, 0},
-	{[]rune{0x1f916}, "robot", 0, 11, []string{"face", "monster", "robot"}, false, 0},
-	{[]rune{0x1f63a}, "grinning cat", 0, 12, []string{"cat", "face", "grinning", "mouth", "open", "smile"}, false, 0},
-	{[]rune{0x1f638}, "grinning cat with smiling eyes", 0, 12, []string{"cat", "eye", "face", "grin", "grinning cat with smiling eyes", "smile"}, false, 0},
-	{[]rune{0x1f639}, "cat with tears of joy", 0, 12, []string{"cat", "cat with tears of joy", "face", "joy", "tear"}, false, 0},
-	{[]rune{0x1f63b}, "smiling cat with heart-eyes", 0, 12, []string{"cat", "eye", "face", "heart", "love", "smile", "smiling cat with heart-eyes"}, false, 0},
-	{[]rune{0x1f63c}, "cat with wry smile", 0, 12, []string{"cat", "cat with wry smile", "face", "ironic", "smile", "wry"}, false, 0},
-	{[]rune{0x1f63d}, "kissing cat", 0, 12, []string{"cat", "eye", "face", "kiss", "kissing cat"}, false, 0},
-	{[]rune{0x1f640}, "weary cat", 0, 12, []string{"cat", "face", "oh", "surprised", "weary"}, false, 0},
-	{[]rune{0x1f63f}, "crying cat", 0, 12, []string{"cat", "cry", "crying cat", "face", "sad", "tear"}, false, 0},
-	{[]rune{0x1f63e}, "pouting cat", 0, 12, []string{"cat", "face", "pouting"}, false, 0},
-	{[]rune{0x1f648}, "see-no-evil monkey", 0, 13, []string{"evil", "face", "forbidden", "monkey", "see", "see-no-evil monkey"}, false, 0},
-	{[]rune{0x1f649}, "hear-no-evil monkey", 0, 13, []string{"evil", "face", "forbidden", "hear", "hear-no-evil monkey", "monkey"}, false, 0},
-	{[]rune{0x1f64a}, "speak-no-evil monkey", 0, 13, []string{"evil", "face", "forbidden", "monkey", "speak", "speak-no-evil monkey"}, false, 0},
-	{[]rune{0x1f48b}, "kiss mark", 0, 14, []string{"kiss", "kiss mark", "lips"}, false, 0},
-	{[]rune{0x1f48c}, "love letter", 0, 14, []string{"heart", "letter", "love", "mail"}, false, 0},
-	{[]rune{0x1f498}, "heart with arrow", 0, 14, []string{"arrow", "cupid", "heart with arrow"}, false, 0},
-	{[]rune{0x1f49d}, "heart with ribbon", 0, 14, []string{"heart with ribbon", "ribbon", "valentine"}, false, 0},
-	{[]rune{0x1f496}, "sparkling heart", 0, 14, []string{"excited", "sparkle", "sparkling heart"}, false, 0},
-	{[]rune{0x1f497}, "growing heart", 0, 14, []string{"excited", "growing", "growing heart", "nervous", "pulse"}, false, 0},
-	{[]rune{0x1f493}, "beating heart", 0, 14, []string{"beating", "beating heart", "heartbeat", "pulsating"}, false, 0},
-	{[]rune{0x1f49e}, "revolving hearts", 0, 14, []string{"revolving", "revolving hearts"}, false, 0},
-	{[]rune{0x1f495}, "two hearts", 0, 14, []string{"love", "two hearts"}, false, 0},
-	{[]rune{0x1f49f}, "heart decoration", 0, 14, []string{"heart", "heart decoration"}, false, 0},
-	{[]rune{0x2763, 0xfe0f}, "heart exclamation", 0, 14, []string{"exclamation", "heart exclamation", "mark", "punctuation"}, false, 0},
-	{[]rune{0x1f494}, "broken heart", 0, 14, []string{"break", "broken", "broken heart"}, false, 0},
-	{[]rune{0x2764, 0xfe0f, 0x1f525}, "heart on fire", 0, 14, []string{"burn", "heart", "heart on fire", "love", "lust", "sacred heart"}, false, 0},
-	{[]rune{0x2764, 0xfe0f, 0x1fa79}, "mending heart", 0, 14, []string{"healthier", "improving", "mending", "mending heart", "recovering", "recuperating", "well"}, false, 0},
-	{[]rune{0x2764, 0xfe0f}, "red heart", 0, 14, []string{"heart", "red heart"}, false, 0},
-	{[]rune{0x1f9e1}, "orange heart", 0, 14, []string{"orange", "orange heart"}, false, 0},
-	{[]rune{0x1f49b}, "yellow heart", 0, 14, []string{"yellow", "yellow heart"}, false, 0},
-	{[]rune{0x1f49a}, "green heart", 0, 14, []string{"green", "green heart"}, false, 0},
-	{[]rune{0x1f499}, "blue heart", 0, 14, []string{"blue", "blue heart"}, false, 0},
-	{[]rune{0x1f49c}, "purple heart", 0, 14, []string{"purple", "purple heart"}, false, 0},
-	{[]rune{0x1f90e}, "brown heart", 0, 14, []string{"brown", "heart"}, false, 0},
-	{[]rune{0x1f5a4}, "black heart", 0, 14, []string{"black", "black heart", "evil", "wicked"}, false, 0},
-	{[]rune{0x1f90d}, "white heart", 0, 14, []string{"heart", "white"}, false, 0},
-	{[]rune{0x1f4af}, "hundred points", 0, 14, []string{"100", "full", "hundred", "hundred points", "score"}, false, 0},
-	{[]rune{0x1f4a2}, "anger symbol", 0, 14, []string{"anger symbol", "angry", "comic", "mad"}, false, 0},
-	{[]rune{0x1f4a5}, "collision", 0, 14, []string{"boom", "collision", "comic"}, false, 0},
-	{[]rune{0x1f4ab}, "dizzy", 0, 14, []string{"comic", "dizzy", "star"}, false, 0},
-	{[]rune{0x1f4a6}, "sweat droplets", 0, 14, []string{"comic", "splashing", "sweat", "sweat droplets"}, false, 0},
-	{[]rune{0x1f4a8}, "dashing away", 0, 14, []string{"comic", "dash", "dashing away", "running"}, false, 0},
-	{[]rune{0x1f573, 0xfe0f}, "hole", 0, 14, []string{"hole"}, false, 0},
-	{[]rune{0x1f4a3}, "bomb", 0, 14, []string{"bomb", "comic"}, false, 0},
-	{[]rune{0x1f4ac}, "speech balloon", 0, 14, []string{"balloon", "bubble", "comic", "dialog", "speech"}, false, 0},
-	{[]rune{0x1f441, 0xfe0f, 0x1f5e8, 0xfe0f}, "eye in speech bubble", 0, 14, []string{"eye", "eye in speech bubble", "speech bubble", "witness"}, false, 0},
-	{[]rune{0x1f5e8, 0xfe0f}, "left speech bubble", 0, 14, []string{"dialog", "left speech bubble", "speech"}, false, 0},
-	{[]rune{0x1f5ef, 0xfe0f}, "right anger bubble", 0, 14, []string{"angry", "balloon", "bubble", "mad", "right anger bubble"}, false, 0},
-	{[]rune{0x1f4ad}, "thought balloon", 0, 14, []string{"balloon", "bubble", "comic", "thought"}, false, 0},
-	{[]rune{0x1f4a4}, "zzz", 0, 14, []string{"comic", "sleep", "zzz"}, false, 0},
-	{[]rune{0x1f44b}, "waving hand", 1, 0, []string{"hand", "wave", "waving"}, true, 0},
-	{[]rune{0x1f91a}, "raised back of hand", 1, 0, []string{"backhand", "raised", "raised back of hand"}, true, 0},
-	{[]rune{0x1f590, 0xfe0f}, "hand with fingers splayed", 1, 0, []string{"finger", "hand", "hand with fingers splayed", "splayed"}, true, 0},
-	{[]rune{0x270b}, "raised hand", 1, 0, []string{"hand", "high 5", "high five", "raised hand"}, true, 0},
-	{[]rune{0x1f596}, "vulcan salute", 1, 0, []string{"finger", "hand", "spock", "vulcan", "vulcan salute"}, true, 0},
-	{[]rune{0x1f44c}, "OK hand", 1, 1, []string{"hand", "OK"}, true, 0},
-	{[]rune{0x1f90c}, "pinched fingers", 1, 1, []string{"fingers", "hand gesture", "interrogation", "pinched", "sarcastic"}, true, 0},
-	{[]rune{0x1f90f}, "pinching hand", 1, 1, []string{"pinching hand", "small amount"}, true, 0},
-	{[]rune{0x270c, 0xfe0f}, "victory hand", 1, 1, []string{"hand", "v", "victory"}, true, 0},
-	{[]rune{0x1f91e}, "crossed fingers", 1, 1, []string{"cross", "crossed fingers", "finger", "hand", "luck"}, true, 0},
-	{[]rune{0x1f91f}, "love-you gesture", 1, 1, []string{"hand", "ILY", "love-you gesture"}, true, 0},
-	{[]rune{0x1f918}, "sign of the horns", 1, 1, []string{"finger", "hand", "horns", "rock-on", "sign of the horns"}, true, 0},
-	{[]rune{0x1f919}, "call me hand", 1, 1, []string{"call", "call me hand", "hand"}, true, 0},
-	{[]rune{0x1f448}, "backhand index pointing left", 1, 2, []string{"backhand", "backhand index pointing left", "finger", "hand", "index", "point"}, true, 0},
-	{[]rune{0x1f449}, "backhand index pointing right", 1, 2, []string{"backhand", "backhand index pointing right", "finger", "hand", "index", "point"}, true, 0},
-	{[]rune{0x1f446}, "backhand index pointing up", 1, 2, []string{"backhand", "backhand index pointing up", "finger", "hand", "point", "up"}, true, 0},
-	{[]rune{0x1f595}, "middle finger", 1, 2, []string{"finger", "hand", "middle finger"}, true, 0},
-	{[]rune{0x1f447}, "backhand index pointing down", 1, 2, []string{"backhand", "backhand index pointing down", "down", "finger", "hand", "point"}, true, 0},
-	{[]rune{0x261d, 0xfe0f}, "index pointing up", 1, 2, []string{"finger", "hand", "index", "index pointing up", "point", "up"}, true, 0},
-	{[]rune{0x1f44d}, "thumbs up", 1, 3, []string{"+1", "hand", "thumb", "thumbs up", "up"}, true, 0},
-	{[]rune{0x1f44e}, "thumbs down", 1, 3, []string{"-1", "down", "hand", "thumb", "thumbs down"}, true, 0},
-	{[]rune{0x270a}, "raised fist", 1, 3, []string{"clenched", "fist", "hand", "punch", "raised fist"}, true, 0},
-	{[]rune{0x1f44a}, "oncoming fist", 1, 3, []string{"clenched", "fist", "hand", "oncoming fist", "punch"}, true, 0},
-	{[]rune{0x1f91b}, "left-facing fist", 1, 3, []string{"fist", "left-facing fist", "leftwards"}, true, 0},
-	{[]rune{0x1f91c}, "right-facing fist", 1, 3, []string{"fist", "right-facing fist", "rightwards"}, true, 0},
-	{[]rune{0x1f44f}, "clapping hands", 1, 4, []string{"clap", "clapping hands", "hand"}, true, 0},
-	{[]rune{0x1f64c}, "raising hands", 1, 4, []string{"celebration", "gesture", "hand", "hooray", "raised", "raising hands"}, true, 0},
-	{[]rune{0x1f450}, "open hands", 1, 4, []string{"hand", "open", "open hands"}, true, 0},
-	{[]rune{0x1f932}, "palms up together", 1, 4, []string{"palms up together", "prayer"}, true, 0},
-	{[]rune{0x1f91d}, "handshake", 1, 4, []string{"agreement", "hand", "handshake", "meeting", "shake"}, false, 0},
-	{[]rune{0x1f64f}, "folded hands", 1, 4, []string{"ask", "folded hands", "hand", "high 5", "high five", "please", "pray", "thanks"}, true, 0},
-	{[]rune{0x270d, 0xfe0f}, "writing hand", 1, 5, []string{"hand", "write", "writing hand"}, true, 0},
-	{[]rune{0x1f485}, "nail polish", 1, 5, []string{"care", "cosmetics", "manicure", "nail", "polish"}, true, 0},
-	{[]rune{0x1f933}, "selfie", 1, 5, []string{"camera", "phone", "selfie"}, true, 0},
-	{[]rune{0x1f4aa}, "flexed biceps", 1, 6, []string{"biceps", "comic", "flex", "flexed biceps", "muscle"}, true, 0},
-	{[]rune{0x1f9be}, "mechanical arm", 1, 6, []string{"accessibility", "mechanical arm", "prosthetic"}, false, 0},
-	{[]rune{0x1f9bf}, "mechanical leg", 1, 6, []string{"accessibility", "mechanical leg", "prosthetic"}, false, 0},
-	{[]rune{0x1f9b5}, "leg", 1, 6, []string{"kick", "leg", "limb"}, true, 0},
-	{[]rune{0x1f9b6}, "foot", 1, 6, []string{"foot", "kick", "stomp"}, true, 0},
-	{[]rune{0x1f442}, "ear", 1, 6, []string{"body", "ear"}, true, 0},
-	{[]rune{0x1f9bb}, "ear with hearing aid", 1, 6, []string{"accessibility", "ear with hearing aid", "hard of hearing"}, true, 0},
-	{[]rune{0x1f443}, "nose", 1, 6, []string{"body", "nose"}, true, 0},
-	{[]rune{0x1f9e0}, "brain", 1, 6, []string{"brain", "intelligent"}, false, 0},
-	{[]rune{0x1fac0}, "anatomical heart", 1, 6, []string{"anatomical", "cardiology", "heart", "organ", "pulse"}, false, 0},
-	{[]rune{0x1fac1}, "lungs", 1, 6, []string{"breath", "exhalation", "inhalation", "lungs", "organ", "respiration"}, false, 0},
-	{[]rune{0x1f9b7}, "tooth", 1, 6, []string{"dentist", "tooth"}, false, 0},
-	{[]rune{0x1f9b4}, "bone", 1, 6, []string{"bone", "skeleton"}, false, 0},
-	{[]rune{0x1f440}, "eyes", 1, 6, []string{"eye", "eyes", "face"}, false, 0},
-	{[]rune{0x1f441, 0xfe0f}, "eye", 1, 6, []string{"body", "eye"}, false, 0},
-	{[]rune{0x1f445}, "tongue", 1, 6, []string{"body", "tongue"}, false, 0},
-	{[]rune{0x1f444}, "mouth", 1, 6, []string{"lips", "mouth"}, false, 0},
-	{[]rune{0x1f476}, "baby", 1, 7, []string{"baby", "young"}, true, 0},
-	{[]rune{0x1f9d2}, "child", 1, 7, []string{"child", "gender-neutral", "unspecified gender", "young"}, true, 0},
-	{[]rune{0x1f466}, "boy", 1, 7, []string{"boy", "young"}, true, 0},
-	{[]rune{0x1f467}, "girl", 1, 7, []string{"girl", "Virgo", "young", "zodiac"}, true, 0},
-	{[]rune{0x1f9d1}, "person", 1, 7, []string{"adult", "gender-neutral", "person", "unspecified gender"}, true, 0},
-	{[]rune{0x1f471}, "person: blond hair", 1, 7, []string{"blond", "blond-haired person", "hair", "person: blond hair"}, true, 1},
-	{[]rune{0x1f468}, "man", 1, 7, []string{"adult", "man"}, true, 0},
-	{[]rune{0x1f9d4}, "person: beard", 1, 7, []string{"beard", "person", "person: beard"}, true, 1},
-	{[]rune{0x1f468, 0x1f9b0}, "man: red hair", 1, 7, []string(nil), true, 0},
-	{[]rune{0x1f468, 0x1f9b1}, "man: curly hair", 1, 7, []string(nil), true, 0},
-	{[]rune{0x1f468, 0x1f9b3}, "man: white hair", 1, 7, []string(nil), true, 0},
-	{[]rune{0x1f468, 0x1f9b2}, "man: bald", 1, 7, []string(nil), true, 0},
-	{[]rune{0x1f469}, "woman", 1, 7, []string{"adult", "woman"}, true, 0},
-	{[]rune{0x1f469, 0x1f9b0}, "woman: red hair", 1, 7, []string(nil), true, 0},
-	{[]rune{0x1f9d1, 0x1f9b0}, "person: red hair", 1, 7, []string(nil), true, 0},
-	{[]rune{0x1f469, 0x1f9b1}, "woman: curly hair", 1, 7, []string(nil), true, 0},
-	{[]rune{0x1f9d1, 0x1f9b1}, "person: curly hair", 1, 7, []string(nil), true, 0},
-	{[]rune{0x1f469, 0x1f9b3}, "woman: white hair", 1, 7, []string(nil), true, 0},
-	{[]rune{0x1f9d1, 0x1f9b3}, "person: white hair", 1, 7, []string(nil), true, 0},
-	{[]rune{0x1f469, 0x1f9b2}, "woman: bald", 1, 7, []string(nil), true, 0},
-	{[]rune{0x1f9d1, 0x1f9b2}, "person: bald", 1, 7, []string(nil), true, 0},
-	{[]rune{0x1f9d3}, "older person", 1, 7, []string{"adult", "gender-neutral", "old", "older person", "unspecified gender"}, true, 0},
-	{[]rune{0x1f474}, "old man", 1, 7, []string{"adult", "man", "old"}, true, 0},
-	{[]rune{0x1f475}, "old woman", 1, 7, []string{"adult", "old", "woman"}, true, 0},
-	{[]rune{0x1f64d}, "person frowning", 1, 8, []string{"frown", "gesture", "person frowning"}, true, 1},
-	{[]rune{0x1f64e}, "person pouting", 1, 8, []string{"gesture", "person pouting", "pouting"}, true, 1},
-	{[]rune{0x1f645}, "person gesturing NO", 1, 8, []string{"forbidden", "gesture", "hand", "person gesturing NO", "prohibited"}, true, 1},
-	{[]rune{0x1f646}, "person gesturing OK", 1, 8, []string{"gesture", "hand", "OK", "person gesturing OK"}, true, 1},
-	{[]rune{0x1f481}, "person tipping hand", 1, 8, []string{"hand", "help", "information", "person tipping hand", "sassy", "tipping"}, true, 1},
-	{[]rune{0x1f64b}, "person raising hand", 1, 8, []string{"gesture", "hand", "happy", "person raising hand", "raised"}, true, 1},
-	{[]rune{0x1f9cf}, "deaf person", 1, 8, []string{"accessibility", "deaf", "deaf person", "ear", "hear"}, true, 1},
-	{[]rune{0x1f647}, "person bowing", 1, 8, []string{"apology", "bow", "gesture", "person bowing", "sorry"}, true, 1},
-	{[]rune{0x1f926}, "person facepalming", 1, 8, []string{"disbelief", "exasperation", "face", "palm", "person facepalming"}, true, 1},
-	{[]rune{0x1f937}, "person shrugging", 1, 8, []string{"doubt", "ignorance", "indifference", "person shrugging", "shrug"}, true, 1},
-	{[]rune{0x1f9d1, 0x2695, 0xfe0f}, "health worker", 1, 9, []string{"doctor", "health worker", "healthcare", "nurse", "therapist"}, true, 2},
-	{[]rune{0x1f9d1, 0x1f393}, "student", 1, 9, []string{"graduate", "student"}, true, 2},
-	{[]rune{0x1f9d1, 0x1f3eb}, "teacher", 1, 9, []string{"instructor", "professor", "teacher"}, true, 2},
-	{[]rune{0x1f9d1, 0x2696, 0xfe0f}, "judge", 1, 9, []string{"judge", "justice", "scales"}, true, 2},
-	{[]rune{0x1f9d1, 0x1f33e}, "farmer", 1, 9, []string{"farmer", "gardener", "rancher"}, true, 2},
-	{[]rune{0x1f9d1, 0x1f373}, "cook", 1, 9, []string{"chef", "cook"}, true, 2},
-	{[]rune{0x1f9d1, 0x1f527}, "mechanic", 1, 9, []string{"electrician", "mechanic", "plumber", "tradesperson"}, true, 2},
-	{[]rune{0x1f9d1, 0x1f3ed}, "factory worker", 1, 9, []string{"assembly", "factory", "industrial", "worker"}, true, 2},
-	{[]rune{0x1f9d1, 0x1f4bc}, "office worker", 1, 9, []string{"architect", "business", "manager", "office worker", "white-collar"}, true, 2},
-	{[]rune{0x1f9d1, 0x1f52c}, "scientist", 1, 9, []string{"biologist", "chemist", "engineer", "physicist", "scientist"}, true, 2},
-	{[]rune{0x1f9d1, 0x1f4bb}, "technologist", 1, 9, []string{"coder", "developer", "inventor", "software", "technologist"}, true, 2},
-	{[]rune{0x1f9d1, 0x1f3a4}, "singer", 1, 9, []string{"actor", "entertainer", "rock", "singer", "star"}, true, 2},
-	{[]rune{0x1f9d1, 0x1f3a8}, "artist", 1, 9, []string{"artist", "palette"}, true, 2},
-	{[]rune{0x1f9d1, 0x2708, 0xfe0f}, "pilot", 1, 9, []string{"pilot", "plane"}, true, 2},
-	{[]rune{0x1f9d1, 0x1f680}, "astronaut", 1, 9, []string{"astronaut", "rocket"}, true, 2},
-	{[]rune{0x1f9d1, 0x1f692}, "firefighter", 1, 9, []string{"firefighter", "firetruck"}, true, 2},
-	{[]rune{0x1f46e}, "police officer", 1, 9, []string{"cop", "officer", "police"}, true, 1},
-	{[]rune{0x1f575, 0xfe0f}, "detective", 1, 9, []string{"detective", "sleuth", "spy"}, true, 1},
-	{[]rune{0x1f482}, "guard", 1, 9, []string{"guard"}, true, 1},
-	{[]rune{0x1f977}, "ninja", 1, 9, []string{"fighter", "hidden", "ninja", "stealth"}, true, 0},
-	{[]rune{0x1f477}, "construction worker", 1, 9, []string{"construction", "hat", "worker"}, true, 1},
-	{[]rune{0x1f934}, "prince", 1, 9, []string{"prince"}, true, 0},
-	{[]rune{0x1f478}, "princess", 1, 9, []string{"fairy tale", "fantasy", "princess"}, true, 0},
-	{[]rune{0x1f473}, "person wearing turban", 1, 9, []string{"person wearing turban", "turban"}, true, 1},
-	{[]rune{0x1f472}, "person with skullcap", 1, 9, []string{"cap", "gua pi mao", "hat", "person", "person with skullcap", "skullcap"}, true, 0},
-	{[]rune{0x1f9d5}, "woman with headscarf", 1, 9, []string{"headscarf", "hijab", "mantilla", "tichel", "woman with headscarf"}, true, 0},
-	{[]rune{0x1f935}, "person in tuxedo", 1, 9, []string{"groom", "person", "person in tuxedo", "tuxedo"}, true, 1},
-	{[]rune{0x1f470}, "person with veil", 1, 9, []string{"bride", "person", "person with veil", "veil", "wedding"}, true, 1},
-	{[]rune{0x1f930}, "pregnant woman", 1, 9, []string{"pregnant", "woman"}, true, 0},
-	{[]rune{0x1f931}, "breast-feeding", 1, 9, []string{"baby", "breast", "breast-feeding", "nursing"}, true, 0},
-	{[]rune{0x1f469, 0x1f37c}, "woman feeding baby", 1, 9, []string{"baby", "feeding", "nursing", "woman"}, true, 0},
-	{[]rune{0x1f468, 0x1f37c}, "man feeding baby", 1, 9, []string{"baby", "feeding", "man", "nursing"}, true, 0},
-	{[]rune{0x1f9d1, 0x1f37c}, "person feeding baby", 1, 9, []string{"baby", "feeding", "nursing", "person"}, true, 0},
-	{[]rune{0x1f47c}, "baby angel", 1, 10, []string{"angel", "baby", "face", "fairy tale", "fantasy"}, true, 0},
-	{[]rune{0x1f385}, "Santa Claus", 1, 10, []string{"celebration", "Christmas", "claus", "father", "santa", "Santa Claus"}, true, 0},
-	{[]rune{0x1f936}, "Mrs. Claus", 1, 10, []string{"celebration", "Christmas", "claus", "mother", "Mrs.", "Mrs. Claus"}, true, 0},
-	{[]rune{0x1f9d1, 0x1f384}, "mx claus", 1, 10, []string{"Claus, christmas", "mx claus"}, true, 0},
-	{[]rune{0x1f9b8}, "superhero", 1, 10, []string{"good", "hero", "heroine", "superhero", "superpower"}, true, 1},
-	{[]rune{0x1f9b9}, "supervillain", 1, 10, []string{"criminal", "evil", "superpower", "supervillain", "villain"}, true, 1},
-	{[]rune{0x1f9d9}, "mage", 1, 10, []string{"mage", "sorcerer", "sorceress", "witch", "wizard"}, true, 1},
-	{[]rune{0x1f9da}, "fairy", 1, 10, []string{"fairy", "Oberon", "Puck", "Titania"}, true, 1},
-	{[]rune{0x1f9db}, "vampire", 1, 10, []string{"Dracula", "undead", "vampire"}, true, 1},
-	{[]rune{0x1f9dc}, "merperson", 1, 10, []string{"mermaid", "merman", "merperson", "merwoman"}, true, 1},
-	{[]rune{0x1f9dd}, "elf", 1, 10, []string{"elf", "magical"}, true, 1},
-	{[]rune{0x1f9de}, "genie", 1, 10, []string{"djinn", "genie"}, false, 1},
-	{[]rune{0x1f9df}, "zombie", 1, 10, []string{"undead", "walking dead", "zombie"}, false, 1},
-	{[]rune{0x1f486}, "person getting massage", 1, 11, []string{"face", "massage", "person getting massage", "salon"}, true, 1},
-	{[]rune{0x1f487}, "person getting haircut", 1, 11, []string{"barber", "beauty", "haircut", "parlor", "person getting haircut"}, true, 1},
-	{[]rune{0x1f6b6}, "person walking", 1, 11, []string{"hike", "person walking", "walk", "walking"}, true, 1},
-	{[]rune{0x1f9cd}, "person standing", 1, 11, []string{"person standing", "stand", "standing"}, true, 1},
-	{[]rune{0x1f9ce}, "person kneeling", 1, 11, []string{"kneel", "kneeling", "person kneeling"}, true, 1},
-	{[]rune{0x1f9d1, 0x1f9af}, "person with white cane", 1, 11, []string{"accessibility", "blind", "person with white cane"}, true, 2},
-	{[]rune{0x1f9d1, 0x1f9bc}, "person in motorized wheelchair", 1, 11, []string{"accessibility", "person in motorized wheelchair", "wheelchair"}, true, 2},
-	{[]rune{0x1f9d1, 0x1f9bd}, "person in manual wheelchair", 1, 11, []string{"accessibility", "person in manual wheelchair", "wheelchair"}, true, 2},
-	{[]rune{0x1f3c3}, "person running", 1, 11, []string{"marathon", "person running", "running"}, true, 1},
-	{[]rune{0x1f483}, "woman dancing", 1, 11, []string{"dance", "dancing", "woman"}, true, 0},
-	{[]rune{0x1f57a}, "man dancing", 1, 11, []string{"dance", "dancing", "man"}, true, 0},
-	{[]rune{0x1f574, 0xfe0f}, "person in suit levitating", 1, 11, []string{"business", "person", "person in suit levitating", "suit"}, true, 0},
-	{[]rune{0x1f46f}, "people with bunny ears", 1, 11, []string{"bunny ear", "dancer", "partying", "people with bunny ears"}, false, 1},
-	{[]rune{0x1f9d6}, "person in steamy room", 1, 11, []string{"person in steamy room", "sauna", "steam room"}, true, 1},
-	{[]rune{0x1f9d7}, "person climbing", 1, 11, []string{"climber", "person climbing"}, true, 1},
-	{[]rune{0x1f93a}, "person fencing", 1, 12, []string{"fencer", "fencing", "person fencing", "sword"}, false, 0},
-	{[]rune{0x1f3c7}, "horse racing", 1, 12, []string{"horse", "jockey", "racehorse", "racing"}, true, 0},
-	{[]rune{0x26f7, 0xfe0f}, "skier", 1, 12, []string{"ski", "skier", "snow"}, false, 0},
-	{[]rune{0x1f3c2}, "snowboarder", 1, 12, []string{"ski", "snow", "snowboard", "snowboarder"}, true, 0},
-	{[]rune{0x1f3cc, 0xfe0f}, "person golfing", 1, 12, []string{"ball", "golf", "person golfing"}, true, 1},
-	{[]rune{0x1f3c4}, "person surfing", 1, 12, []string{"person surfing", "surfing"}, true, 1},
-	{[]rune{0x1f6a3}, "person rowing boat", 1, 12, []string{"boat", "person rowing boat", "rowboat"}, true, 1},
-	{[]rune{0x1f3ca}, "person swimming", 1, 12, []string{"person swimming", "swim"}, true, 1},
-	{[]rune{0x26f9, 0xfe0f}, "person bouncing ball", 1, 12, []string{"ball", "person bouncing ball"}, true, 1},
-	{[]rune{0x1f3cb, 0xfe0f}, "person lifting weights", 1, 12, []string{"lifter", "person lifting weights", "weight"}, true, 1},
-	{[]rune{0x1f6b4}, "person biking", 1, 12, []string{"bicycle", "biking", "cyclist", "person biking"}, true, 1},
-	{[]rune{0x1f6b5}, "person mountain biking", 1, 12, []string{"bicycle", "bicyclist", "bike", "cyclist", "mountain", "person mountain biking"}, true, 1},
-	{[]rune{0x1f938}, "person cartwheeling", 1, 12, []string{"cartwheel", "gymnastics", "person cartwheeling"}, true, 1},
-	{[]rune{0x1f93c}, "people wrestling", 1, 12, []string{"people wrestling", "wrestle", "wrestler"}, false, 1},
-	{[]rune{0x1f93d}, "person playing water polo", 1, 12, []string{"person playing water polo", "polo", "water"}, true, 1},
-	{[]rune{0x1f93e}, "person playing handball", 1, 12, []string{"ball", "handball", "person playing handball"}, true, 1},
-	{[]rune{0x1f939}, "person juggling", 1, 12, []string{"balance", "juggle", "multitask", "person juggling", "skill"}, true, 1},
-	{[]rune{0x1f9d8}, "person in lotus position", 1, 13, []string{"meditation", "person in lotus position", "yoga"}, true, 1},
-	{[]rune{0x1f6c0}, "person taking bath", 1, 13, []string{"bath", "bathtub", "person taking bath"}, true, 0},
-	{[]rune{0x1f6cc}, "person in bed", 1, 13, []string{"hotel", "person in bed", "sleep"}, true, 0},
-	{[]rune{0x1f9d1, 0x1f91d, 0x1f9d1}, "people holding hands", 1, 14, []string{"couple", "hand", "hold", "holding hands", "people holding hands", "person"}, false, 0},
-	{[]rune{0x1f46d}, "women holding hands", 1, 14, []string{"couple", "hand", "holding hands", "women", "women holding hands"}, false, 0},
-	{[]rune{0x1f46b}, "woman and man holding hands", 1, 14, []string{"couple", "hand", "hold", "holding hands", "man", "woman", "woman and man holding hands"}, false, 0},
-	{[]rune{0x1f46c}, "men holding hands", 1, 14, []string{"couple", "Gemini", "holding hands", "man", "men", "men holding hands", "twins", "zodiac"}, false, 0},
-	{[]rune{0x1f48f}, "kiss", 1, 14, []string{"couple", "kiss"}, false, 0},
-	{[]rune{0x1f469, 0x2764, 0xfe0f, 0x1f48b, 0x1f468}, "kiss: woman, man", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x2764, 0xfe0f, 0x1f48b, 0x1f468}, "kiss: man, man", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f469, 0x2764, 0xfe0f, 0x1f48b, 0x1f469}, "kiss: woman, woman", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f491}, "couple with heart", 1, 14, []string{"couple", "couple with heart", "love"}, false, 0},
-	{[]rune{0x1f469, 0x2764, 0xfe0f, 0x1f468}, "couple with heart: woman, man", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x2764, 0xfe0f, 0x1f468}, "couple with heart: man, man", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f469, 0x2764, 0xfe0f, 0x1f469}, "couple with heart: woman, woman", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f46a}, "family", 1, 14, []string{"family"}, false, 0},
-	{[]rune{0x1f468, 0x1f469, 0x1f466}, "family: man, woman, boy", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x1f469, 0x1f467}, "family: man, woman, girl", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x1f469, 0x1f467, 0x1f466}, "family: man, woman, girl, boy", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x1f469, 0x1f466, 0x1f466}, "family: man, woman, boy, boy", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x1f469, 0x1f467, 0x1f467}, "family: man, woman, girl, girl", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x1f468, 0x1f466}, "family: man, man, boy", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x1f468, 0x1f467}, "family: man, man, girl", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x1f468, 0x1f467, 0x1f466}, "family: man, man, girl, boy", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x1f468, 0x1f466, 0x1f466}, "family: man, man, boy, boy", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x1f468, 0x1f467, 0x1f467}, "family: man, man, girl, girl", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f469, 0x1f469, 0x1f466}, "family: woman, woman, boy", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f469, 0x1f469, 0x1f467}, "family: woman, woman, girl", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f469, 0x1f469, 0x1f467, 0x1f466}, "family: woman, woman, girl, boy", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f469, 0x1f469, 0x1f466, 0x1f466}, "family: woman, woman, boy, boy", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f469, 0x1f469, 0x1f467, 0x1f467}, "family: woman, woman, girl, girl", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x1f466}, "family: man, boy", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x1f466, 0x1f466}, "family: man, boy, boy", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x1f467}, "family: man, girl", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x1f467, 0x1f466}, "family: man, girl, boy", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f468, 0x1f467, 0x1f467}, "family: man, girl, girl", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f469, 0x1f466}, "family: woman, boy", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f469, 0x1f466, 0x1f466}, "family: woman, boy, boy", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f469, 0x1f467}, "family: woman, girl", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f469, 0x1f467, 0x1f466}, "family: woman, girl, boy", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f469, 0x1f467, 0x1f467}, "family: woman, girl, girl", 1, 14, []string(nil), false, 0},
-	{[]rune{0x1f5e3, 0xfe0f}, "speaking head", 1, 15, []string{"face", "head", "silhouette", "speak", "speaking"}, false, 0},
-	{[]rune{0x1f464}, "bust in silhouette", 1, 15, []string{"bust", "bust in silhouette", "silhouette"}, false, 0},
-	{[]rune{0x1f465}, "busts in silhouette", 1, 15, []string{"bust", "busts in silhouette", "silhouette"}, false, 0},
-	{[]rune{0x1fac2}, "people hugging", 1, 15, []string{"goodbye", "hello", "hug", "people hugging", "thanks"}, false, 0},
-	{[]rune{0x1f463}, "footprints", 1, 15, []string{"clothing", "footprint", "footprints", "print"}, false, 0},
-	{[]rune{0x1f435}, "monkey face", 3, 0, []string{"face", "monkey"}, false, 0},
-	{[]rune{0x1f412}, "monkey", 3, 0, []string{"monkey"}, false, 0},
-	{[]rune{0x1f98d}, "gorilla", 3, 0, []string{"gorilla"}, false, 0},
-	{[]rune{0x1f9a7}, "orangutan", 3, 0, []string{"ape", "orangutan"}, false, 0},
-	{[]rune{0x1f436}, "dog face", 3, 0, []string{"dog", "face", "pet"}, false, 0},
-	{[]rune{0x1f415}, "dog", 3, 0, []string{"dog", "pet"}, false, 0},
-	{[]rune{0x1f9ae}, "guide dog", 3, 0, []string{"accessibility", "blind", "guide", "guide dog"}, false, 0},
-	{[]rune{0x1f415, 0x1f9ba}, "service dog", 3, 0, []string{"accessibility", "assistance", "dog", "service"}, false, 0},
-	{[]rune{0x1f429}, "poodle", 3, 0, []string{"dog", "poodle"}, false, 0},
-	{[]rune{0x1f43a}, "wolf", 3, 0, []string{"face", "wolf"}, false, 0},
-	{[]rune{0x1f98a}, "fox", 3, 0, []string{"face", "fox"}, false, 0},
-	{[]rune{0x1f99d}, "raccoon", 3, 0, []string{"curious", "raccoon", "sly"}, false, 0},
-	{[]rune{0x1f431}, "cat face", 3, 0, []string{"cat", "face", "pet"}, false, 0},
-	{[]rune{0x1f408}, "cat", 3, 0, []string{"cat", "pet"}, false, 0},
-	{[]rune{0x1f408, 0x2b1b}, "black cat", 3, 0, []string{"black", "cat", "unlucky"}, false, 0},
-	{[]rune{0x1f981}, "lion", 3, 0, []string{"face", "Leo", "lion", "zodiac"}, false, 0},
-	{[]rune{0x1f42f}, "tiger face", 3, 0, []string{"face", "tiger"}, false, 0},
-	{[]rune{0x1f405}, "tiger", 3, 0, []string{"tiger"}, false, 0},
-	{[]rune{0x1f406}, "leopard", 3, 0, []string{"leopard"}, false, 0},
-	{[]rune{0x1f434}, "horse face", 3, 0, []string{"face", "horse"}, false, 0},
-	{[]rune{0x1f40e}, "horse", 3, 0, []string{"equestrian", "horse", "racehorse", "racing"}, false, 0},
-	{[]rune{0x1f984}, "unicorn", 3, 0, []string{"face", "unicorn"}, false, 0},
-	{[]rune{0x1f993}, "zebra", 3, 0, []string{"stripe", "zebra"}, false, 0},
-	{[]rune{0x1f98c}, "deer", 3, 0, []string{"deer"}, false, 0},
-	{[]rune{0x1f9ac}, "bison", 3, 0, []string{"bison", "buffalo", "herd", "wisent"}, false, 0},
-	{[]rune{0x1f42e}, "cow face", 3, 0, []string{"cow", "face"}, false, 0},
-	{[]rune{0x1f402}, "ox", 3, 0, []string{"bull", "ox", "Taurus", "zodiac"}, false, 0},
-	{[]rune{0x1f403}, "water buffalo", 3, 0, []string{"buffalo", "water"}, false, 0},
-	{[]rune{0x1f404}, "cow", 3, 0, []string{"cow"}, false, 0},
-	{[]rune{0x1f437}, "pig face", 3, 0, []string{"face", "pig"}, false, 0},
-	{[]rune{0x1f416}, "pig", 3, 0, []string{"pig", "sow"}, false, 0},
-	{[]rune{0x1f417}, "boar", 3, 0, []string{"boar", "pig"}, false, 0},
-	{[]rune{0x1f43d}, "pig nose", 3, 0, []string{"face", "nose", "pig"}, false, 0},
-	{[]rune{0x1f40f}, "ram", 3, 0, []string{"Aries", "male", "ram", "sheep", "zodiac"}, false, 0},
-	{[]rune{0x1f411}, "ewe", 3, 0, []string{"ewe", "female", "sheep"}, false, 0},
-	{[]rune{0x1f410}, "goat", 3, 0, []string{"Capricorn", "goat", "zodiac"}, false, 0},
-	{[]rune{0x1f42a}, "camel", 3, 0, []string{"camel", "dromedary", "hump"}, false, 0},
-	{[]rune{0x1f42b}, "two-hump camel", 3, 0, []string{"bactrian", "camel", "hump", "two-hump camel"}, false, 0},
-	{[]rune{0x1f999}, "llama", 3, 0, []string{"alpaca", "guanaco", "llama", "vicuña", "wool"}, false, 0},
-	{[]rune{0x1f992}, "giraffe", 3, 0, []string{"giraffe", "spots"}, false, 0},
-	{[]rune{0x1f418}, "elephant", 3, 0, []string{"elephant"}, false, 0},
-	{[]rune{0x1f9a3}, "mammoth", 3, 0, []string{"extinction", "large", "mammoth", "tusk", "woolly"}, false, 0},
-	{[]rune{0x1f98f}, "rhinoceros", 3, 0, []string{"rhinoceros"}, false, 0},
-	{[]rune{0x1f99b}, "hippopotamus", 3, 0, []string{"hippo", "hippopotamus"}, false, 0},
-	{[]rune{0x1f42d}, "mouse face", 3, 0, []string{"face", "mouse"}, false, 0},
-	{[]rune{0x1f401}, "mouse", 3, 0, []string{"mouse"}, false, 0},
-	{[]rune{0x1f400}, "rat", 3, 0, []string{"rat"}, false, 0},
-	{[]rune{0x1f439}, "hamster", 3, 0, []string{"face", "hamster", "pet"}, false, 0},
-	{[]rune{0x1f430}, "rabbit face", 3, 0, []string{"bunny", "face", "pet", "rabbit"}, false, 0},
-	{[]rune{0x1f407}, "rabbit", 3, 0, []string{"bunny", "pet", "rabbit"}, false, 0},
-	{[]rune{0x1f43f, 0xfe0f}, "chipmunk", 3, 0, []string{"chipmunk", "squirrel"}, false, 0},
-	{[]rune{0x1f9ab}, "beaver", 3, 0, []string{"beaver", "dam"}, false, 0},
-	{[]rune{0x1f994}, "hedgehog", 3, 0, []string{"hedgehog", "spiny"}, false, 0},
-	{[]rune{0x1f987}, "bat", 3, 0, []string{"bat", "vampire"}, false, 0},
-	{[]rune{0x1f43b}, "bear", 3, 0, []string{"bear", "face"}, false, 0},
-	{[]rune{0x1f43b, 0x2744, 0xfe0f}, "polar bear", 3, 0, []string{"arctic", "bear", "polar bear", "white"}, false, 0},
-	{[]rune{0x1f428}, "koala", 3, 0, []string{"bear", "koala"}, false, 0},
-	{[]rune{0x1f43c}, "panda", 3, 0, []string{"face", "panda"}, false, 0},
-	{[]rune{0x1f9a5}, "sloth", 3, 0, []string{"lazy", "sloth", "slow"}, false, 0},
-	{[]rune{0x1f9a6}, "otter", 3, 0, []string{"fishing", "otter", "playful"}, false, 0},
-	{[]rune{0x1f9a8}, "skunk", 3, 0, []string{"skunk", "stink"}, false, 0},
-	{[]rune{0x1f998}, "kangaroo", 3, 0, []string{"Australia", "joey", "jump", "kangaroo", "marsupial"}, false, 0},
-	{[]rune{0x1f9a1}, "badger", 3, 0, []string{"badger", "honey badger", "pester"}, false, 0},
-	{[]rune{0x1f43e}, "paw prints", 3, 0, []string{"feet", "paw", "paw prints", "print"}, false, 0},
-	{[]rune{0x1f983}, "turkey", 3, 1, []string{"bird", "turkey"}, false, 0},
-	{[]rune{0x1f414}, "chicken", 3, 1, []string{"bird", "chicken"}, false, 0},
-	{[]rune{0x1f413}, "rooster", 3, 1, []string{"bird", "rooster"}, false, 0},
-	{[]rune{0x1f423}, "hatching chick", 3, 1, []string{"baby", "bird", "chick", "hatching"}, false, 0},
-	{[]rune{0x1f424}, "baby chick", 3, 1, []string{"baby", "bird", "chick"}, false, 0},
-	{[]rune{0x1f425}, "front-facing baby chick", 3, 1, []string{"baby", "bird", "chick", "front-facing baby chick"}, false, 0},
-	{[]rune{0x1f426}, "bird", 3, 1, []string{"bird"}, false, 0},
-	{[]rune{0x1f427}, "penguin", 3, 1, []string{"bird", "penguin"}, false, 0},
-	{[]rune{0x1f54a, 0xfe0f}, "dove", 3, 1, []string{"bird", "dove", "fly", "peace"}, false, 0},
-	{[]rune{0x1f985}, "eagle", 3, 1, []string{"bird", "eagle"}, false, 0},
-	{[]rune{0x1f986}, "duck", 3, 1, []string{"bird", "duck"}, false, 0},
-	{[]rune{0x1f9a2}, "swan", 3, 1, []string{"bird", "cygnet", "swan", "ugly duckling"}, false, 0},
-	{[]rune{0x1f989}, "owl", 3, 1, []string{"bird", "owl", "wise"}, false, 0},
-	{[]rune{0x1f9a4}, "dodo", 3, 1, []string{"dodo", "extinction", "large", "Mauritius"}, false, 0},
-	{[]rune{0x1fab6}, "feather", 3, 1, []string{"bird", "feather", "flight", "light", "plumage"}, false, 0},
-	{[]rune{0x1f9a9}, "flamingo", 3, 1, []string{"flamboyant", "flamingo", "tropical"}, false, 0},
-	{[]rune{0x1f99a}, "peacock", 3, 1, []string{"bird", "ostentatious", "peacock", "peahen", "proud"}, false, 0},
-	{[]rune{0x1f99c}, "parrot", 3, 1, []string{"bird", "parrot", "pirate", "talk"}, false, 0},
-	{[]rune{0x1f438}, "frog", 3, 2, []string{"face", "frog"}, false, 0},
-	{[]rune{0x1f40a}, "crocodile", 3, 3, []string{"crocodile"}, false, 0},
-	{[]rune{0x1f422}, "turtle", 3, 3, []string{"terrapin", "tortoise", "turtle"}, false, 0},
-	{[]rune{0x1f98e}, "lizard", 3, 3, []string{"lizard", "reptile"}, false, 0},
-	{[]rune{0x1f40d}, "snake", 3, 3, []string{"bearer", "Ophiuchus", "serpent", "snake", "zodiac"}, false, 0},
-	{[]rune{0x1f432}, "dragon face", 3, 3, []string{"dragon", "face", "fairy tale"}, false, 0},
-	{[]rune{0x1f409}, "dragon", 3, 3, []string{"dragon", "fairy tale"}, false, 0},
-	{[]rune{0x1f995}, "sauropod", 3, 3, []string{"brachiosaurus", "brontosaurus", "diplodocus", "sauropod"}, false, 0},
-	{[]rune{0x1f996}, "T-Rex", 3, 3, []string{"T-Rex", "Tyrannosaurus Rex"}, false, 0},
-	{[]rune{0x1f433}, "spouting whale", 3, 4, []string{"face", "spouting", "whale"}, false, 0},
-	{[]rune{0x1f40b}, "whale", 3, 4, []string{"whale"}, false, 0},
-	{[]rune{0x1f42c}, "dolphin", 3, 4, []string{"dolphin", "flipper"}, false, 0},
-	{[]rune{0x1f9ad}, "seal", 3, 4, []string{"sea Lion", "seal"}, false, 0},
-	{[]rune{0x1f41f}, "fish", 3, 4, []string{"fish", "Pisces", "zodiac"}, false, 0},
-	{[]rune{0x1f420}, "tropical fish", 3, 4, []string{"fish", "tropical"}, false, 0},
-	{[]rune{0x1f421}, "blowfish", 3, 4, []string{"blowfish", "fish"}, false, 0},
-	{[]rune{0x1f988}, "shark", 3, 4, []string{"fish", "shark"}, false, 0},
-	{[]rune{0x1f419}, "octopus", 3, 4, []string{"octopus"}, false, 0},
-	{[]rune{0x1f41a}, "spiral shell", 3, 4, []string{"shell", "spiral"}, false, 0},
-	{[]rune{0x1f40c}, "snail", 3, 5, []string{"snail"}, false, 0},
-	{[]rune{0x1f98b}, "butterfly", 3, 5, []string{"butterfly", "insect", "pretty"}, false, 0},
-	{[]rune{0x1f41b}, "bug", 3, 5, []string{"bug", "insect"}, false, 0},
-	{[]rune{0x1f41c}, "ant", 3, 5, []string{"ant", "insect"}, false, 0},
-	{[]rune{0x1f41d}, "honeybee", 3, 5, []string{"bee", "honeybee", "insect"}, false, 0},
-	{[]rune{0x1fab2}, "beetle", 3, 5, []string{"beetle", "bug", "insect"}, false, 0},
-	{[]rune{0x1f41e}, "lady beetle", 3, 5, []string{"beetle", "insect", "lady beetle", "ladybird", "ladybug"}, false, 0},
-	{[]rune{0x1f997}, "cricket", 3, 5, []string{"cricket", "grasshopper"}, false, 0},
-	{[]rune{0x1fab3}, "cockroach", 3, 5, []string{"cockroach", "insect", "pest", "roach"}, false, 0},
-	{[]rune{0x1f577, 0xfe0f}, "spider", 3, 5, []string{"insect", "spider"}, false, 0},
-	{[]rune{0x1f578, 0xfe0f}, "spider web", 3, 5, []string{"spider", "web"}, false, 0},
-	{[]rune{0x1f982}, "scorpion", 3, 5, []string{"scorpio", "Scorpio", "scorpion", "zodiac"}, false, 0},
-	{[]rune{0x1f99f}, "mosquito", 3, 5, []string{"disease", "fever", "malaria", "mosquito", "pest", "virus"}, false, 0},
-	{[]rune{0x1fab0}, "fly", 3, 5, []string{"disease", "fly", "maggot", "pest", "rotting"}, false, 0},
-	{[]rune{0x1fab1}, "worm", 3, 5, []string{"annelid", "earthworm", "parasite", "worm"}, false, 0},
-	{[]rune{0x1f9a0}, "microbe", 3, 5, []string{"amoeba", "bacteria", "microbe", "virus"}, false, 0},
-	{[]rune{0x1f490}, "bouquet", 3, 6, []string{"bouquet", "flower"}, false, 0},
-	{[]rune{0x1f338}, "cherry blossom", 3, 6, []string{"blossom", "cherry", "flower"}, false, 0},
-	{[]rune{0x1f4ae}, "white flower", 3, 6, []string{"flower", "white flower"}, false, 0},
-	{[]rune{0x1f3f5, 0xfe0f}, "rosette", 3, 6, []string{"plant", "rosette"}, false, 0},
-	{[]rune{0x1f339}, "rose", 3, 6, []string{"flower", "rose"}, false, 0},
-	{[]rune{0x1f940}, "wilted flower", 3, 6, []string{"flower", "wilted"}, false, 0},
-	{[]rune{0x1f33a}, "hibiscus", 3, 6, []string{"flower", "hibiscus"}, false, 0},
-	{[]rune{0x1f33b}, "sunflower", 3, 6, []string{"flower", "sun", "sunflower"}, false, 0},
-	{[]rune{0x1f33c}, "blossom", 3, 6, []string{"blossom", "flower"}, false, 0},
-	{[]rune{0x1f337}, "tulip", 3, 6, []string{"flower", "tulip"}, false, 0},
-	{[]rune{0x1f331}, "seedling", 3, 7, []string{"seedling", "young"}, false, 0},
-	{[]rune{0x1fab4}, "potted plant", 3, 7, []string{"boring", "grow", "house", "nurturing", "plant", "potted plant", "useless"}, false, 0},
-	{[]rune{0x1f332}, "evergreen tree", 3, 7, []string{"evergreen tree", "tree"}, false, 0},
-	{[]rune{0x1f333}, "deciduous tree", 3, 7, []string{"deciduous", "shedding", "tree"}, false, 0},
-	{[]rune{0x1f334}, "palm tree", 3, 7, []string{"palm", "tree"}, false, 0},
-	{[]rune{0x1f335}, "cactus", 3, 7, []string{"cactus", "plant"}, false, 0},
-	{[]rune{0x1f33e}, "sheaf of rice", 3, 7, []string{"ear", "grain", "rice", "sheaf of rice"}, false, 0},
-	{[]rune{0x1f33f}, "herb", 3, 7, []string{"herb", "leaf"}, false, 0},
-	{[]rune{0x2618, 0xfe0f}, "shamrock", 3, 7, []string{"plant", "shamrock"}, false, 0},
-	{[]rune{0x1f340}, "four leaf clover", 3, 7, []string{"4", "clover", "four", "four-leaf clover", "leaf"}, false, 0},
-	{[]rune{0x1f341}, "maple leaf", 3, 7, []string{"falling", "leaf", "maple"}, false, 0},
-	{[]rune{0x1f342}, "fallen leaf", 3, 7, []string{"fallen leaf", "falling", "leaf"}, false, 0},
-	{[]rune{0x1f343}, "leaf fluttering in wind", 3, 7, []string{"blow", "flutter", "leaf", "leaf fluttering in wind", "wind"}, false, 0},
-	{[]rune{0x1f347}, "grapes", 4, 0, []string{"fruit", "grape", "grapes"}, false, 0},
-	{[]rune{0x1f348}, "melon", 4, 0, []string{"fruit", "melon"}, false, 0},
-	{[]rune{0x1f349}, "watermelon", 4, 0, []string{"fruit", "watermelon"}, false, 0},
-	{[]rune{0x1f34a}, "tangerine", 4, 0, []string{"fruit", "orange", "tangerine"}, false, 0},
-	{[]rune{0x1f34b}, "lemon", 4, 0, []string{"citrus", "fruit", "lemon"}, false, 0},
-	{[]rune{0x1f34c}, "banana", 4, 0, []string{"banana", "fruit"}, false, 0},
-	{[]rune{0x1f34d}, "pineapple", 4, 0, []string{"fruit", "pineapple"}, false, 0},
-	{[]rune{0x1f96d}, "mango", 4, 0, []string{"fruit", "mango", "tropical"}, false, 0},
-	{[]rune{0x1f34e}, "red apple", 4, 0, []string{"apple", "fruit", "red"}, false, 0},
-	{[]rune{0x1f34f}, "green apple", 4, 0, []string{"apple", "fruit", "green"}, false, 0},
-	{[]rune{0x1f350}, "pear", 4, 0, []string{"fruit", "pear"}, false, 0},
-	{[]rune{0x1f351}, "peach", 4, 0, []string{"fruit", "peach"}, false, 0},
-	{[]rune{0x1f352}, "cherries", 4, 0, []string{"berries", "cherries", "cherry", "fruit", "red"}, false, 0},
-	{[]rune{0x1f353}, "strawberry", 4, 0, []string{"berry", "fruit", "strawberry"}, false, 0},
-	{[]rune{0x1fad0}, "blueberries", 4, 0, []string{"berry", "bilberry", "blue", "blueberries", "blueberry"}, false, 0},
-	{[]rune{0x1f95d}, "kiwi fruit", 4, 0, []string{"food", "fruit", "kiwi"}, false, 0},
-	{[]rune{0x1f345}, "tomato", 4, 0, []string{"fruit", "tomato", "vegetable"}, false, 0},
-	{[]rune{0x1fad2}, "olive", 4, 0, []string{"food", "olive"}, false, 0},
-	{[]rune{0x1f965}, "coconut", 4, 0, []string{"coconut", "palm", "piña colada"}, false, 0},
-	{[]rune{0x1f951}, "avocado", 4, 1, []string{"avocado", "food", "fruit"}, false, 0},
-	{[]rune{0x1f346}, "eggplant", 4, 1, []string{"aubergine", "eggplant", "vegetable"}, false, 0},
-	{[]rune{0x1f954}, "potato", 4, 1, []string{"food", "potato", "vegetable"}, false, 0},
-	{[]rune{0x1f955}, "carrot", 4, 1, []string{"carrot", "food", "vegetable"}, false, 0},
-	{[]rune{0x1f33d}, "ear of corn", 4, 1, []string{"corn", "ear", "ear of corn", "maize", "maze"}, false, 0},
-	{[]rune{0x1f336, 0xfe0f}, "hot pepper", 4, 1, []string{"hot", "pepper"}, false, 0},
-	{[]rune{0x1fad1}, "bell pepper", 4, 1, []string{"bell pepper", "capsicum", "pepper", "vegetable"}, false, 0},
-	{[]rune{0x1f952}, "cucumber", 4, 1, []string{"cucumber", "food", "pickle", "vegetable"}, false, 0},
-	{[]rune{0x1f96c}, "leafy green", 4, 1, []string{"bok choy", "cabbage", "kale", "leafy green", "lettuce"}, false, 0},
-	{[]rune{0x1f966}, "broccoli", 4, 1, []string{"broccoli", "wild cabbage"}, false, 0},
-	{[]rune{0x1f9c4}, "garlic", 4, 1, []string{"flavoring", "garlic"}, false, 0},
-	{[]rune{0x1f9c5}, "onion", 4, 1, []string{"flavoring", "onion"}, false, 0},
-	{[]rune{0x1f344}, "mushroom", 4, 1, []string{"mushroom", "toadstool"}, false, 0},
-	{[]rune{0x1f95c}, "peanuts", 4, 1, []string{"food", "nut", "peanut", "peanuts", "vegetable"}, false, 0},
-	{[]rune{0x1f330}, "chestnut", 4, 1, []string{"chestnut", "plant"}, false, 0},
-	{[]rune{0x1f35e}, "bread", 4, 2, []string{"bread", "loaf"}, false, 0},
-	{[]rune{0x1f950}, "croissant", 4, 2, []string{"bread", "breakfast", "croissant", "food", "french", "roll"}, false, 0},
-	{[]rune{0x1f956}, "baguette bread", 4, 2, []string{"baguette", "bread", "food", "french"}, false, 0},
-	{[]rune{0x1fad3}, "flatbread", 4, 2, []string{"arepa", "flatbread", "lavash", "naan", "pita"}, false, 0},
-	{[]rune{0x1f968}, "pretzel", 4, 2, []string{"pretzel", "twisted"}, false, 0},
-	{[]rune{0x1f96f}, "bagel", 4, 2, []string{"bagel", "bakery", "breakfast", "schmear"}, false, 0},
-	{[]rune{0x1f95e}, "pancakes", 4, 2, []string{"breakfast", "crêpe", "food", "hotcake", "pancake", "pancakes"}, false, 0},
-	{[]rune{0x1f9c7}, "waffle", 4, 2, []string{"breakfast", "indecisive", "iron", "waffle"}, false, 0},
-	{[]rune{0x1f9c0}, "cheese wedge", 4, 2, []string{"cheese", "cheese wedge"}, false, 0},
-	{[]rune{0x1f356}, "meat on bone", 4, 2, []string{"bone", "meat", "meat on bone"}, false, 0},
-	{[]rune{0x1f357}, "poultry leg", 4, 2, []string{"bone", "chicken", "drumstick", "leg", "poultry"}, false, 0},
-	{[]rune{0x1f969}, "cut of meat", 4, 2, []string{"chop", "cut of meat", "lambchop", "porkchop", "steak"}, false, 0},
-	{[]rune{0x1f953}, "bacon", 4, 2, []string{"bacon", "breakfast", "food", "meat"}, false, 0},
-	{[]rune{0x1f354}, "hamburger", 4, 2, []string{"burger", "hamburger"}, false, 0},
-	{[]rune{0x1f35f}, "french fries", 4, 2, []string{"french", "fries"}, false, 0},
-	{[]rune{0x1f355}, "pizza", 4, 2, []string{"cheese", "pizza", "slice"}, false, 0},
-	{[]rune{0x1f32d}, "hot dog", 4, 2, []string{"frankfurter", "hot dog", "hotdog", "sausage"}, false, 0},
-	{[]rune{0x1f96a}, "sandwich", 4, 2, []string{"bread", "sandwich"}, false, 0},
-	{[]rune{0x1f32e}, "taco", 4, 2, []string{"mexican", "taco"}, false, 0},
-	{[]rune{0x1f32f}, "burrito", 4, 2, []string{"burrito", "mexican", "wrap"}, false, 0},
-	{[]rune{0x1fad4}, "tamale", 4, 2, []string{"mexican", "tamale", "wrapped"}, false, 0},
-	{[]rune{0x1f959}, "stuffed flatbread", 4, 2, []string{"falafel", "flatbread", "food", "gyro", "kebab", "stuffed"}, false, 0},
-	{[]rune{0x1f9c6}, "falafel", 4, 2, []string{"chickpea", "falafel", "meatball"}, false, 0},
-	{[]rune{0x1f95a}, "egg", 4, 2, []string{"breakfast", "egg", "food"}, false, 0},
-	{[]rune{0x1f373}, "cooking", 4, 2, []string{"breakfast", "cooking", "egg", "frying", "pan"}, false, 0},
-	{[]rune{0x1f958}, "shallow pan of food", 4, 2, []string{"casserole", "food", "paella", "pan", "shallow", "shallow pan of food"}, false, 0},
-	{[]rune{0x1f372}, "pot of food", 4, 2, []string{"pot", "pot of food", "stew"}, false, 0},
-	{[]rune{0x1fad5}, "fondue", 4, 2, []string{"cheese", "chocolate", "fondue", "melted", "pot", "Swiss"}, false, 0},
-	{[]rune{0x1f963}, "bowl with spoon", 4, 2, []string{"bowl with spoon", "breakfast", "cereal", "congee"}, false, 0},
-	{[]rune{0x1f957}, "green salad", 4, 2, []string{"food", "green", "salad"}, false, 0},
-	{[]rune{0x1f37f}, "popcorn", 4, 2, []string{"popcorn"}, false, 0},
-	{[]rune{0x1f9c8}, "butter", 4, 2, []string{"butter", "dairy"}, false, 0},
-	{[]rune{0x1f9c2}, "salt", 4, 2, []string{"condiment", "salt", "shaker"}, false, 0},
-	{[]rune{0x1f96b}, "canned food", 4, 2, []string{"can", "canned food"}, false, 0},
-	{[]rune{0x1f371}, "bento box", 4, 3, []string{"bento", "box"}, false, 0},
-	{[]rune{0x1f358}, "rice cracker", 4, 3, []string{"cracker", "rice"}, false, 0},
-	{[]rune{0x1f359}, "rice ball", 4, 3, []string{"ball", "Japanese", "rice"}, false, 0},
-	{[]rune{0x1f35a}, "cooked rice", 4, 3, []string{"cooked", "rice"}, false, 0},
-	{[]rune{0x1f35b}, "curry rice", 4, 3, []string{"curry", "rice"}, false, 0},
-	{[]rune{0x1f35c}, "steaming bowl", 4, 3, []string{"bowl", "noodle", "ramen", "steaming"}, false, 0},
-	{[]rune{0x1f35d}, "spaghetti", 4, 3, []string{"pasta", "spaghetti"}, false, 0},
-	{[]rune{0x1f360}, "roasted sweet potato", 4, 3, []string{"potato", "roasted", "sweet"}, false, 0},
-	{[]rune{0x1f362}, "oden", 4, 3, []string{"kebab", "oden", "seafood", "skewer", "stick"}, false, 0},
-	{[]rune{0x1f363}, "sushi", 4, 3, []string{"sushi"}, false, 0},
-	{[]rune{0x1f364}, "fried shrimp", 4, 3, []string{"fried", "prawn", "shrimp", "tempura"}, false, 0},
-	{[]rune{0x1f365}, "fish cake with swirl", 4, 3, []string{"cake", "fish", "fish cake with swirl", "pastry", "swirl"}, false, 0},
-	{[]rune{0x1f96e}, "moon cake", 4, 3, []string{"autumn", "festival", "moon cake", "yuèbǐng"}, false, 0},
-	{[]rune{0x1f361}, "dango", 4, 3, []string{"dango", "dessert", "Japanese", "skewer", "stick", "sweet"}, false, 0},
-	{[]rune{0x1f95f}, "dumpling", 4, 3, []string{"dumpling", "empanada", "gyōza", "jiaozi", "pierogi", "potsticker"}, false, 0},
-	{[]rune{0x1f960}, "fortune cookie", 4, 3, []string{"fortune cookie", "prophecy"}, false, 0},
-	{[]rune{0x1f961}, "takeout box", 4, 3, []string{"oyster pail", "takeout box"}, false, 0},
-	{[]rune{0x1f980}, "crab", 4, 4, []string{"Cancer", "crab", "zodiac"}, false, 0},
-	{[]rune{0x1f99e}, "lobster", 4, 4, []string{"bisque", "claws", "lobster", "seafood"}, false, 0},
-	{[]rune{0x1f990}, "shrimp", 4, 4, []string{"food", "shellfish", "shrimp", "small"}, false, 0},
-	{[]rune{0x1f991}, "squid", 4, 4, []string{"food", "molusc", "squid"}, false, 0},
-	{[]rune{0x1f9aa}, "oyster", 4, 4, []string{"diving", "oyster", "pearl"}, false, 0},
-	{[]rune{0x1f366}, "soft ice cream", 4, 5, []string{"cream", "dessert", "ice", "icecream", "soft", "sweet"}, false, 0},
-	{[]rune{0x1f367}, "shaved ice", 4, 5, []string{"dessert", "ice", "shaved", "sweet"}, false, 0},
-	{[]rune{0x1f368}, "ice cream", 4, 5, []string{"cream", "dessert", "ice", "sweet"}, false, 0},
-	{[]rune{0x1f369}, "doughnut", 4, 5, []string{"breakfast", "dessert", "donut", "doughnut", "sweet"}, false, 0},
-	{[]rune{0x1f36a}, "cookie", 4, 5, []string{"cookie", "dessert", "sweet"}, false, 0},
-	{[]rune{0x1f382}, "birthday cake", 4, 5, []string{"birthday", "cake", "celebration", "dessert", "pastry", "sweet"}, false, 0},
-	{[]rune{0x1f370}, "shortcake", 4, 5, []string{"cake", "dessert", "pastry", "shortcake", "slice", "sweet"}, false, 0},
-	{[]rune{0x1f9c1}, "cupcake", 4, 5, []string{"bakery", "cupcake", "sweet"}, false, 0},
-	{[]rune{0x1f967}, "pie", 4, 5, []string{"filling", "pastry", "pie"}, false, 0},
-	{[]rune{0x1f36b}, "chocolate bar", 4, 5, []string{"bar", "chocolate", "dessert", "sweet"}, false, 0},
-	{[]rune{0x1f36c}, "candy", 4, 5, []string{"candy", "dessert", "sweet"}, false, 0},
-	{[]rune{0x1f36d}, "lollipop", 4, 5, []string{"candy", "dessert", "lollipop", "sweet"}, false, 0},
-	{[]rune{0x1f36e}, "custard", 4, 5, []string{"custard", "dessert", "pudding", "sweet"}, false, 0},
-	{[]rune{0x1f36f}, "honey pot", 4, 5, []string{"honey", "honeypot", "pot", "sweet"}, false, 0},
-	{[]rune{0x1f37c}, "baby bottle", 4, 6, []string{"baby", "bottle", "drink", "milk"}, false, 0},
-	{[]rune{0x1f95b}, "glass of milk", 4, 6, []string{"drink", "glass", "glass of milk", "milk"}, false, 0},
-	{[]rune{0x2615}, "hot beverage", 4, 6, []string{"beverage", "coffee", "drink", "hot", "steaming", "tea"}, false, 0},
-	{[]rune{0x1fad6}, "teapot", 4, 6, []string{"drink", "pot", "tea", "teapot"}, false, 0},
-	{[]rune{0x1f375}, "teacup without handle", 4, 6, []string{"beverage", "cup", "drink", "tea", "teacup", "teacup without handle"}, false, 0},
-	{[]rune{0x1f376}, "sake", 4, 6, []string{"bar", "beverage", "bottle", "cup", "drink", "sake"}, false, 0},
-	{[]rune{0x1f37e}, "bottle with popping cork", 4, 6, []string{"bar", "bottle", "bottle with popping cork", "cork", "drink", "popping"}, false, 0},
-	{[]rune{0x1f377}, "wine glass", 4, 6, []string{"bar", "beverage", "drink", "glass", "wine"}, false, 0},
-	{[]rune{0x1f378}, "cocktail glass", 4, 6, []string{"bar", "cocktail", "drink", "glass"}, false, 0},
-	{[]rune{0x1f379}, "tropical drink", 4, 6, []string{"bar", "drink", "tropical"}, false, 0},
-	{[]rune{0x1f37a}, "beer mug", 4, 6, []string{"bar", "beer", "drink", "mug"}, false, 0},
-	{[]rune{0x1f37b}, "clinking beer mugs", 4, 6, []string{"bar", "beer", "clink", "clinking beer mugs", "drink", "mug"}, false, 0},
-	{[]rune{0x1f942}, "clinking glasses", 4, 6, []string{"celebrate", "clink", "clinking glasses", "drink", "glass"}, false, 0},
-	{[]rune{0x1f943}, "tumbler glass", 4, 6, []string{"glass", "liquor", "shot", "tumbler", "whisky"}, false, 0},
-	{[]rune{0x1f964}, "cup with straw", 4, 6, []string{"cup with straw", "juice", "soda"}, false, 0},
-	{[]rune{0x1f9cb}, "bubble tea", 4, 6, []string{"bubble", "milk", "pearl", "tea"}, false, 0},
-	{[]rune{0x1f9c3}, "beverage box", 4, 6, []string{"beverage", "box", "juice", "straw", "sweet"}, false, 0},
-	{[]rune{0x1f9c9}, "mate", 4, 6, []string{"drink", "mate"}, false, 0},
-	{[]rune{0x1f9ca}, "ice", 4, 6, []string{"cold", "ice", "ice cube", "iceberg"}, false, 0},
-	{[]rune{0x1f962}, "chopsticks", 4, 7, []string{"chopsticks", "hashi"}, false, 0},
-	{[]rune{0x1f37d, 0xfe0f}, "fork and knife with plate", 4, 7, []string{"cooking", "fork", "fork and knife with plate", "knife", "plate"}, false, 0},
-	{[]rune{0x1f374}, "fork and knife", 4, 7, []string{"cooking", "cutlery", "fork", "fork and knife", "knife"}, false, 0},
-	{[]rune{0x1f944}, "spoon", 4, 7, []string{"spoon", "tableware"}, false, 0},
-	{[]rune{0x1f52a}, "kitchen knife", 4, 7, []string{"cooking", "hocho", "kitchen knife", "knife", "tool", "weapon"}, false, 0},
-	{[]rune{0x1f3fa}, "amphora", 4, 7, []string{"amphora", "Aquarius", "cooking", "drink", "jug", "zodiac"}, false, 0},
-	{[]rune{0x1f30d}, "globe showing Europe-Africa", 5, 0, []string{"Africa", "earth", "Europe", "globe", "globe showing Europe-Africa", "world"}, false, 0},
-	{[]rune{0x1f30e}, "globe showing Americas", 5, 0, []string{"Americas", "earth", "globe", "globe showing Americas", "world"}, false, 0},
-	{[]rune{0x1f30f}, "globe showing Asia-Australia", 5, 0, []string{"Asia", "Australia", "earth", "globe", "globe showing Asia-Australia", "world"}, false, 0},
-	{[]rune{0x1f310}, "globe with meridians", 5, 0, []string{"earth", "globe", "globe with meridians", "meridians", "world"}, false, 0},
-	{[]rune{0x1f5fa, 0xfe0f}, "world map", 5, 0, []string{"map", "world"}, false, 0},
-	{[]rune{0x1f5fe}, "map of Japan", 5, 0, []string{"Japan", "map", "map of Japan"}, false, 0},
-	{[]rune{0x1f9ed}, "compass", 5, 0, []string{"compass", "magnetic", "navigation", "orienteering"}, false, 0},
-	{[]rune{0x1f3d4, 0xfe0f}, "snow-capped mountain", 5, 1, []string{"cold", "mountain", "snow", "snow-capped mountain"}, false, 0},
-	{[]rune{0x26f0, 0xfe0f}, "mountain", 5, 1, []string{"mountain"}, false, 0},
-	{[]rune{0x1f30b}, "volcano", 5, 1, []string{"eruption", "mountain", "volcano"}, false, 0},
-	{[]rune{0x1f5fb}, "mount fuji", 5, 1, []string{"fuji", "mount fuji", "mountain"}, false, 0},
-	{[]rune{0x1f3d5, 0xfe0f}, "camping", 5, 1, []string{"camping"}, false, 0},
-	{[]rune{0x1f3d6, 0xfe0f}, "beach with umbrella", 5, 1, []string{"beach", "beach with umbrella", "umbrella"}, false, 0},
-	{[]rune{0x1f3dc, 0xfe0f}, "desert", 5, 1, []string{"desert"}, false, 0},
-	{[]rune{0x1f3dd, 0xfe0f}, "desert island", 5, 1, []string{"desert", "island"}, false, 0},
-	{[]rune{0x1f3de, 0xfe0f}, "national park", 5, 1, []string{"national park", "park"}, false, 0},
-	{[]rune{0x1f3df, 0xfe0f}, "stadium", 5, 2, []string{"stadium"}, false, 0},
-	{[]rune{0x1f3db, 0xfe0f}, "classical building", 5, 2, []string{"classical", "classical building"}, false, 0},
-	{[]rune{0x1f3d7, 0xfe0f}, "building construction", 5, 2, []string{"building construction", "construction"}, false, 0},
-	{[]rune{0x1f9f1}, "brick", 5, 2, []string{"brick", "bricks", "clay", "mortar", "wall"}, false, 0},
-	{[]rune{0x1faa8}, "rock", 5, 2, []string{"boulder", "heavy", "rock", "solid", "stone"}, false, 0},
-	{[]rune{0x1fab5}, "wood", 5, 2, []string{"log", "lumber", "timber", "wood"}, false, 0},
-	{[]rune{0x1f6d6}, "hut", 5, 2, []string{"house", "hut", "roundhouse", "yurt"}, false, 0},
-	{[]rune{0x1f3d8, 0xfe0f}, "houses", 5, 2, []string{"houses"}, false, 0},
-	{[]rune{0x1f3da, 0xfe0f}, "derelict house", 5, 2, []string{"derelict", "house"}, false, 0},
-	{[]rune{0x1f3e0}, "house", 5, 2, []string{"home", "house"}, false, 0},
-	{[]rune{0x1f3e1}, "house with garden", 5, 2, []string{"garden", "home", "house", "house with garden"}, false, 0},
-	{[]rune{0x1f3e2}, "office building", 5, 2, []string{"building", "office building"}, false, 0},
-	{[]rune{0x1f3e3}, "Japanese post office", 5, 2, []string{"Japanese", "Japanese post office", "post"}, false, 0},
-	{[]rune{0x1f3e4}, "post office", 5, 2, []string{"European", "post", "post office"}, false, 0},
-	{[]rune{0x1f3e5}, "hospital", 5, 2, []string{"doctor", "hospital", "medicine"}, false, 0},
-	{[]rune{0x1f3e6}, "bank", 5, 2, []string{"bank", "building"}, false, 0},
-	{[]rune{0x1f3e8}, "hotel", 5, 2, []string{"building", "hotel"}, false, 0},
-	{[]rune{0x1f3e9}, "love hotel", 5, 2, []string{"hotel", "love"}, false, 0},
-	{[]rune{0x1f3ea}, "convenience store", 5, 2, []string{"convenience", "store"}, false, 0},
-	{[]rune{0x1f3eb}, "school", 5, 2, []string{"building", "school"}, false, 0},
-	{[]rune{0x1f3ec}, "department store", 5, 2, []string{"department", "store"}, false, 0},
-	{[]rune{0x1f3ed}, "factory", 5, 2, []string{"building", "factory"}, false, 0},
-	{[]rune{0x1f3ef}, "Japanese castle", 5, 2, []string{"castle", "Japanese"}, false, 0},
-	{[]rune{0x1f3f0}, "castle", 5, 2, []string{"castle", "European"}, false, 0},
-	{[]rune{0x1f492}, "wedding", 5, 2, []string{"chapel", "romance", "wedding"}, false, 0},
-	{[]rune{0x1f5fc}, "Tokyo tower", 5, 2, []string{"Tokyo", "tower"}, false, 0},
-	{[]rune{0x1f5fd}, "Statue of Liberty", 5, 2, []string{"liberty", "statue", "Statue of Liberty"}, false, 0},
-	{[]rune{0x26ea}, "church", 5, 3, []string{"Christian", "church", "cross", "religion"}, false, 0},
-	{[]rune{0x1f54c}, "mosque", 5, 3, []string{"islam", "mosque", "Muslim", "religion"}, false, 0},
-	{[]rune{0x1f6d5}, "hindu temple", 5, 3, []string{"hindu", "temple"}, false, 0},
-	{[]rune{0x1f54d}, "synagogue", 5, 3, []string{"Jew", "Jewish", "religion", "synagogue", "temple"}, false, 0},
-	{[]rune{0x26e9, 0xfe0f}, "shinto shrine", 5, 3, []string{"religion", "shinto", "shrine"}, false, 0},
-	{[]rune{0x1f54b}, "kaaba", 5, 3, []string{"islam", "kaaba", "Muslim", "religion"}, false, 0},
-	{[]rune{0x26f2}, "fountain", 5, 4, []string{"fountain"}, false, 0},
-	{[]rune{0x26fa}, "tent", 5, 4, []string{"camping", "tent"}, false, 0},
-	{[]rune{0x1f301}, "foggy", 5, 4, []string{"fog", "foggy"}, false, 0},
-	{[]rune{0x1f303}, "night with stars", 5, 4, []string{"night", "night with stars", "star"}, false, 0},
-	{[]rune{0x1f3d9, 0xfe0f}, "cityscape", 5, 4, []string{"city", "cityscape"}, false, 0},
-	{[]rune{0x1f304}, "sunrise over mountains", 5, 4, []string{"morning", "mountain", "sun", "sunrise", "sunrise over mountains"}, false, 0},
-	{[]rune{0x1f305}, "sunrise", 5, 4, []string{"morning", "sun", "sunrise"}, false, 0},
-	{[]rune{0x1f306}, "cityscape at dusk", 5, 4, []string{"city", "cityscape at dusk", "dusk", "evening", "landscape", "sunset"}, false, 0},
-	{[]rune{0x1f307}, "sunset", 5, 4, []string{"dusk", "sun", "sunset"}, false, 0},
-	{[]rune{0x1f309}, "bridge at night", 5, 4, []string{"bridge", "bridge at night", "night"}, false, 0},
-	{[]rune{0x2668, 0xfe0f}, "hot springs", 5, 4, []string{"hot", "hotsprings", "springs", "steaming"}, false, 0},
-	{[]rune{0x1f3a0}, "carousel horse", 5, 4, []string{"carousel", "horse"}, false, 0},
-	{[]rune{0x1f3a1}, "ferris wheel", 5, 4, []string{"amusement park", "ferris", "wheel"}, false, 0},
-	{[]rune{0x1f3a2}, "roller coaster", 5, 4, []string{"amusement park", "coaster", "roller"}, false, 0},
-	{[]rune{0x1f488}, "barber pole", 5, 4, []string{"barber", "haircut", "pole"}, false, 0},
-	{[]rune{0x1f3aa}, "circus tent", 5, 4, []string{"circus", "tent"}, false, 0},
-	{[]rune{0x1f682}, "locomotive", 5, 5, []string{"engine", "locomotive", "railway", "steam", "train"}, false, 0},
-	{[]rune{0x1f683}, "railway car", 5, 5, []string{"car", "electric", "railway", "train", "tram", "trolleybus"}, false, 0},
-	{[]rune{0x1f684}, "high-speed train", 5, 5, []string{"high-speed train", "railway", "shinkansen", "speed", "train"}, false, 0},
-	{[]rune{0x1f685}, "bullet train", 5, 5, []string{"bullet", "railway", "shinkansen", "speed", "train"}, false, 0},
-	{[]rune{0x1f686}, "train", 5, 5, []string{"railway", "train"}, false, 0},
-	{[]rune{0x1f687}, "metro", 5, 5, []string{"metro", "subway"}, false, 0},
-	{[]rune{0x1f688}, "light rail", 5, 5, []string{"light rail", "railway"}, false, 0},
-	{[]rune{0x1f689}, "station", 5, 5, []string{"railway", "station", "train"}, false, 0},
-	{[]rune{0x1f68a}, "tram", 5, 5, []string{"tram", "trolleybus"}, false, 0},
-	{[]rune{0x1f69d}, "monorail", 5, 5, []string{"monorail", "vehicle"}, false, 0},
-	{[]rune{0x1f69e}, "mountain railway", 5, 5, []string{"car", "mountain", "railway"}, false, 0},
-	{[]rune{0x1f68b}, "tram car", 5, 5, []string{"car", "tram", "trolleybus"}, false, 0},
-	{[]rune{0x1f68c}, "bus", 5, 5, []string{"bus", "vehicle"}, false, 0},
-	{[]rune{0x1f68d}, "oncoming bus", 5, 5, []string{"bus", "oncoming"}, false, 0},
-	{[]rune{0x1f68e}, "trolleybus", 5, 5, []string{"bus", "tram", "trolley", "trolleybus"}, false, 0},
-	{[]rune{0x1f690}, "minibus", 5, 5, []string{"bus", "minibus"}, false, 0},
-	{[]rune{0x1f691}, "ambulance", 5, 5, []string{"ambulance", "vehicle"}, false, 0},
-	{[]rune{0x1f692}, "fire engine", 5, 5, []string{"engine", "fire", "truck"}, false, 0},
-	{[]rune{0x1f693}, "police car", 5, 5, []string{"car", "patrol", "police"}, false, 0},
-	{[]rune{0x1f694}, "oncoming police car", 5, 5, []string{"car", "oncoming", "police"}, false, 0},
-	{[]rune{0x1f695}, "taxi", 5, 5, []string{"taxi", "vehicle"}, false, 0},
-	{[]rune{0x1f696}, "oncoming taxi", 5, 5, []string{"oncoming", "taxi"}, false, 0},
-	{[]rune{0x1f697}, "automobile", 5, 5, []string{"automobile", "car"}, false, 0},
-	{[]rune{0x1f698}, "oncoming automobile", 5, 5, []string{"automobile", "car", "oncoming"}, false, 0},
-	{[]rune{0x1f699}, "sport utility vehicle", 5, 5, []string{"recreational", "sport utility", "sport utility vehicle"}, false, 0},
-	{[]rune{0x1f6fb}, "pickup truck", 5, 5, []string{"pick-up", "pickup", "truck"}, false, 0},
-	{[]rune{0x1f69a}, "delivery truck", 5, 5, []string{"delivery", "truck"}, false, 0},
-	{[]rune{0x1f69b}, "articulated lorry", 5, 5, []string{"articulated lorry", "lorry", "semi", "truck"}, false, 0},
-	{[]rune{0x1f69c}, "tractor", 5, 5, []string{"tractor", "vehicle"}, false, 0},
-	{[]rune{0x1f3ce, 0xfe0f}, "racing car", 5, 5, []string{"car", "racing"}, false, 0},
-	{[]rune{0x1f3cd, 0xfe0f}, "motorcycle", 5, 5, []string{"motorcycle", "racing"}, false, 0},
-	{[]rune{0x1f6f5}, "motor scooter", 5, 5, []string{"motor", "scooter"}, false, 0},
-	{[]rune{0x1f9bd}, "manual wheelchair", 5, 5, []string{"accessibility", "manual wheelchair"}, false, 0},
-	{[]rune{0x1f9bc}, "motorized wheelchair", 5, 5, []string{"accessibility", "motorized wheelchair"}, false, 0},
-	{[]rune{0x1f6fa}, "auto rickshaw", 5, 5, []string{"auto rickshaw", "tuk tuk"}, false, 0},
-	{[]rune{0x1f6b2}, "bicycle", 5, 5, []string{"bicycle", "bike"}, false, 0},
-	{[]rune{0x1f6f4}, "kick scooter", 5, 5, []string{"kick", "scooter"}, false, 0},
-	{[]rune{0x1f6f9}, "skateboard", 5, 5, []string{"board", "skateboard"}, false, 0},
-	{[]rune{0x1f6fc}, "roller skate", 5, 5, []string{"roller", "skate"}, false, 0},
-	{[]rune{0x1f68f}, "bus stop", 5, 5, []string{"bus", "busstop", "stop"}, false, 0},
-	{[]rune{0x1f6e3, 0xfe0f}, "motorway", 5, 5, []string{"highway", "motorway", "road"}, false, 0},
-	{[]rune{0x1f6e4, 0xfe0f}, "railway track", 5, 5, []string{"railway", "railway track", "train"}, false, 0},
-	{[]rune{0x1f6e2, 0xfe0f}, "oil drum", 5, 5, []string{"drum", "oil"}, false, 0},
-	{[]rune{0x26fd}, "fuel pump", 5, 5, []string{"diesel", "fuel", "fuelpump", "gas", "pump", "station"}, false, 0},
-	{[]rune{0x1f6a8}, "police car light", 5, 5, []string{"beacon", "car", "light", "police", "revolving"}, false, 0},
-	{[]rune{0x1f6a5}, "horizontal traffic light", 5, 5, []string{"horizontal traffic light", "light", "signal", "traffic"}, false, 0},
-	{[]rune{0x1f6a6}, "vertical traffic light", 5, 5, []string{"light", "signal", "traffic", "vertical traffic light"}, false, 0},
-	{[]rune{0x1f6d1}, "stop sign", 5, 5, []string{"octagonal", "sign", "stop"}, false, 0},
-	{[]rune{0x1f6a7}, "construction", 5, 5, []string{"barrier", "construction"}, false, 0},
-	{[]rune{0x2693}, "anchor", 5, 6, []string{"anchor", "ship", "tool"}, false, 0},
-	{[]rune{0x26f5}, "sailboat", 5, 6, []string{"boat", "resort", "sailboat", "sea", "yacht"}, false, 0},
-	{[]rune{0x1f6f6}, "canoe", 5, 6, []string{"boat", "canoe"}, false, 0},
-	{[]rune{0x1f6a4}, "speedboat", 5, 6, []string{"boat", "speedboat"}, false, 0},
-	{[]rune{0x1f6f3, 0xfe0f}, "passenger ship", 5, 6, []string{"passenger", "ship"}, false, 0},
-	{[]rune{0x26f4, 0xfe0f}, "ferry", 5, 6, []string{"boat", "ferry", "passenger"}, false, 0},
-	{[]rune{0x1f6e5, 0xfe0f}, "motor boat", 5, 6, []string{"boat", "motor boat", "motorboat"}, false, 0},
-	{[]rune{0x1f6a2}, "ship", 5, 6, []string{"boat", "passenger", "ship"}, false, 0},
-	{[]rune{0x2708, 0xfe0f}, "airplane", 5, 7, []string{"aeroplane", "airplane"}, false, 0},
-	{[]rune{0x1f6e9, 0xfe0f}, "small airplane", 5, 7, []string{"aeroplane", "airplane", "small airplane"}, false, 0},
-	{[]rune{0x1f6eb}, "airplane departure", 5, 7, []string{"aeroplane", "airplane", "check-in", "departure", "departures"}, false, 0},
-	{[]rune{0x1f6ec}, "airplane arrival", 5, 7, []string{"aeroplane", "airplane", "airplane arrival", "arrivals", "arriving", "landing"}, false, 0},
-	{[]rune{0x1fa82}, "parachute", 5, 7, []string{"hang-glide", "parachute", "parasail", "skydive"}, false, 0},
-	{[]rune{0x1f4ba}, "seat", 5, 7, []string{"chair", "seat"}, false, 0},
-	{[]rune{0x1f681}, "helicopter", 5, 7, []string{"helicopter", "vehicle"}, false, 0},
-	{[]rune{0x1f69f}, "suspension railway", 5, 7, []string{"railway", "suspension"}, false, 0},
-	{[]rune{0x1f6a0}, "mountain cableway", 5, 7, []string{"cable", "gondola", "mountain", "mountain cableway"}, false, 0},
-	{[]rune{0x1f6a1}, "aerial tramway", 5, 7, []string{"aerial", "cable", "car", "gondola", "tramway"}, false, 0},
-	{[]rune{0x1f6f0, 0xfe0f}, "satellite", 5, 7, []string{"satellite", "space"}, false, 0},
-	{[]rune{0x1f680}, "rocket", 5, 7, []string{"rocket", "space"}, false, 0},
-	{[]rune{0x1f6f8}, "flying saucer", 5, 7, []string{"flying saucer", "UFO"}, false, 0},
-	{[]rune{0x1f6ce, 0xfe0f}, "bellhop bell", 5, 8, []string{"bell", "bellhop", "hotel"}, false, 0},
-	{[]rune{0x1f9f3}, "luggage", 5, 8, []string{"luggage", "packing", "travel"}, false, 0},
-	{[]rune{0x231b}, "hourglass done", 5, 9, []string{"hourglass done", "sand", "timer"}, false, 0},
-	{[]rune{0x23f3}, "hourglass not done", 5, 9, []string{"hourglass", "hourglass not done", "sand", "timer"}, false, 0},
-	{[]rune{0x231a}, "watch", 5, 9, []string{"clock", "watch"}, false, 0},
-	{[]rune{0x23f0}, "alarm clock", 5, 9, []string{"alarm", "clock"}, false, 0},
-	{[]rune{0x23f1, 0xfe0f}, "stopwatch", 5, 9, []string{"clock", "stopwatch"}, false, 0},
-	{[]rune{0x23f2, 0xfe0f}, "timer clock", 5, 9, []string{"clock", "timer"}, false, 0},
-	{[]rune{0x1f570, 0xfe0f}, "mantelpiece clock", 5, 9, []string{"clock", "mantelpiece clock"}, false, 0},
-	{[]rune{0x1f55b}, "twelve o’clock", 5, 9, []string{"00", "12", "12:00", "clock", "o’clock", "twelve"}, false, 0},
-	{[]rune{0x1f567}, "twelve-thirty", 5, 9, []string{"12", "12:30", "clock", "thirty", "twelve", "twelve-thirty"}, false, 0},
-	{[]rune{0x1f550}, "one o’clock", 5, 9, []string{"00", "1", "1:00", "clock", "o’clock", "one"}, false, 0},
-	{[]rune{0x1f55c}, "one-thirty", 5, 9, []string{"1", "1:30", "clock", "one", "one-thirty", "thirty"}, false, 0},
-	{[]rune{0x1f551}, "two o’clock", 5, 9, []string{"00", "2", "2:00", "clock", "o’clock", "two"}, false, 0},
-	{[]rune{0x1f55d}, "two-thirty", 5, 9, []string{"2", "2:30", "clock", "thirty", "two", "two-thirty"}, false, 0},
-	{[]rune{0x1f552}, "three o’clock", 5, 9, []string{"00", "3", "3:00", "clock", "o’clock", "three"}, false, 0},
-	{[]rune{0x1f55e}, "three-thirty", 5, 9, []string{"3", "3:30", "clock", "thirty", "three", "three-thirty"}, false, 0},
-	{[]rune{0x1f553}, "four o’clock", 5, 9, []string{"00", "4", "4:00", "clock", "four", "o’clock"}, false, 0},
-	{[]rune{0x1f55f}, "four-thirty", 5, 9, []string{"4", "4:30", "clock", "four", "four-thirty", "thirty"}, false, 0},
-	{[]rune{0x1f554}, "five o’clock", 5, 9, []string{"00", "5", "5:00", "clock", "five", "o’clock"}, false, 0},
-	{[]rune{0x1f560}, "five-thirty", 5, 9, []string{"5", "5:30", "clock", "five", "five-thirty", "thirty"}, false, 0},
-	{[]rune{0x1f555}, "six o’clock", 5, 9, []string{"00", "6", "6:00", "clock", "o’clock", "six"}, false, 0},
-	{[]rune{0x1f561}, "six-thirty", 5, 9, []string{"6", "6:30", "clock", "six", "six-thirty", "thirty"}, false, 0},
-	{[]rune{0x1f556}, "seven o’clock", 5, 9, []string{"00", "7", "7:00", "clock", "o’clock", "seven"}, false, 0},
-	{[]rune{0x1f562}, "seven-thirty", 5, 9, []string{"7", "7:30", "clock", "seven", "seven-thirty", "thirty"}, false, 0},
-	{[]rune{0x1f557}, "eight o’clock", 5, 9, []string{"00", "8", "8:00", "clock", "eight", "o’clock"}, false, 0},
-	{[]rune{0x1f563}, "eight-thirty", 5, 9, []string{"8", "8:30", "clock", "eight", "eight-thirty", "thirty"}, false, 0},
-	{[]rune{0x1f558}, "nine o’clock", 5, 9, []string{"00", "9", "9:00", "clock", "nine", "o’clock"}, false, 0},
-	{[]rune{0x1f564}, "nine-thirty", 5, 9, []string{"9", "9:30", "clock", "nine", "nine-thirty", "thirty"}, false, 0},
-	{[]rune{0x1f559}, "ten o’clock", 5, 9, []string{"00", "10", "10:00", "clock", "o’clock", "ten"}, false, 0},
-	{[]rune{0x1f565}, "ten-thirty", 5, 9, []string{"10", "10:30", "clock", "ten", "ten-thirty", "thirty"}, false, 0},
-	{[]rune{0x1f55a}, "eleven o’clock", 5, 9, []string{"00", "11", "11:00", "clock", "eleven", "o’clock"}, false, 0},
-	{[]rune{0x1f566}, "eleven-thirty", 5, 9, []string{"11", "11:30", "clock", "eleven", "eleven-thirty", "thirty"}, false, 0},
-	{[]rune{0x1f311}, "new moon", 5, 10, []string{"dark", "moon", "new moon"}, false, 0},
-	{[]rune{0x1f312}, "waxing crescent moon", 5, 10, []string{"crescent", "moon", "waxing"}, false, 0},
-	{[]rune{0x1f313}, "first quarter moon", 5, 10, []string{"first quarter moon", "moon", "quarter"}, false, 0},
-	{[]rune{0x1f314}, "waxing gibbous moon", 5, 10, []string{"gibbous", "moon", "waxing"}, false, 0},
-	{[]rune{0x1f315}, "full moon", 5, 10, []string{"full", "moon"}, false, 0},
-	{[]rune{0x1f316}, "waning gibbous moon", 5, 10, []string{"gibbous", "moon", "waning"}, false, 0},
-	{[]rune{0x1f317}, "last quarter moon", 5, 10, []string{"last quarter moon", "moon", "quarter"}, false, 0},
-	{[]rune{0x1f318}, "waning crescent moon", 5, 10, []string{"crescent", "moon", "waning"}, false, 0},
-	{[]rune{0x1f319}, "crescent moon", 5, 10, []string{"crescent", "moon"}, false, 0},
-	{[]rune{0x1f31a}, "new moon face", 5, 10, []string{"face", "moon", "new moon face"}, false, 0},
-	{[]rune{0x1f31b}, "first quarter moon face", 5, 10, []string{"face", "first quarter moon face", "moon", "quarter"}, false, 0},
-	{[]rune{0x1f31c}, "last quarter moon face", 5, 10, []string{"face", "last quarter moon face", "moon", "quarter"}, false, 0},
-	{[]rune{0x1f321, 0xfe0f}, "thermometer", 5, 10, []string{"thermometer", "weather"}, false, 0},
-	{[]rune{0x2600, 0xfe0f}, "sun", 5, 10, []string{"bright", "rays", "sun", "sunny"}, false, 0},
-	{[]rune{0x1f31d}, "full moon face", 5, 10, []string{"bright", "face", "full", "moon"}, false, 0},
-	{[]rune{0x1f31e}, "sun with face", 5, 10, []string{"bright", "face", "sun", "sun with face"}, false, 0},
-	{[]rune{0x1fa90}, "ringed planet", 5, 10, []string{"ringed planet", "saturn", "saturnine"}, false, 0},
-	{[]rune{0x2b50}, "star", 5, 10, []string{"star"}, false, 0},
-	{[]rune{0x1f31f}, "glowing star", 5, 10, []string{"glittery", "glow", "glowing star", "shining", "sparkle", "star"}, false, 0},
-	{[]rune{0x1f320}, "shooting star", 5, 10, []string{"falling", "shooting", "star"}, false, 0},
-	{[]rune{0x1f30c}, "milky way", 5, 10, []string{"milky way", "space"}, false, 0},
-	{[]rune{0x2601, 0xfe0f}, "cloud", 5, 10, []string{"cloud", "weather"}, false, 0},
-	{[]rune{0x26c5}, "sun behind cloud", 5, 10, []string{"cloud", "sun", "sun behind cloud"}, false, 0},
-	{[]rune{0x26c8, 0xfe0f}, "cloud with lightning and rain", 5, 10, []string{"cloud", "cloud with lightning and rain", "rain", "thunder"}, false, 0},
-	{[]rune{0x1f324, 0xfe0f}, "sun behind small cloud", 5, 10, []string{"cloud", "sun", "sun behind small cloud"}, false, 0},
-	{[]rune{0x1f325, 0xfe0f}, "sun behind large cloud", 5, 10, []string{"cloud", "sun", "sun behind large cloud"}, false, 0},
-	{[]rune{0x1f326, 0xfe0f}, "sun behind rain cloud", 5, 10, []string{"cloud", "rain", "sun", "sun behind rain cloud"}, false, 0},
-	{[]rune{0x1f327, 0xfe0f}, "cloud with rain", 5, 10, []string{"cloud", "cloud with rain", "rain"}, false, 0},
-	{[]rune{0x1f328, 0xfe0f}, "cloud with snow", 5, 10, []string{"cloud", "cloud with snow", "cold", "snow"}, false, 0},
-	{[]rune{0x1f329, 0xfe0f}, "cloud with lightning", 5, 10, []string{"cloud", "cloud with lightning", "lightning"}, false, 0},
-	{[]rune{0x1f32a, 0xfe0f}, "tornado", 5, 10, []string{"cloud", "tornado", "whirlwind"}, false, 0},
-	{[]rune{0x1f32b, 0xfe0f}, "fog", 5, 10, []string{"cloud", "fog"}, false, 0},
-	{[]rune{0x1f32c, 0xfe0f}, "wind face", 5, 10, []string{"blow", "cloud", "face", "wind"}, false, 0},
-	{[]rune{0x1f300}, "cyclone", 5, 10, []string{"cyclone", "dizzy", "hurricane", "twister", "typhoon"}, false, 0},
-	{[]rune{0x1f308}, "rainbow", 5, 10, []string{"rain", "rainbow"}, false, 0},
-	{[]rune{0x1f302}, "closed umbrella", 5, 10, []string{"closed umbrella", "clothing", "rain", "umbrella"}, false, 0},
-	{[]rune{0x2602, 0xfe0f}, "umbrella", 5, 10, []string{"clothing", "rain", "umbrella"}, false, 0},
-	{[]rune{0x2614}, "umbrella with rain drops", 5, 10, []string{"clothing", "drop", "rain", "umbrella", "umbrella with rain drops"}, false, 0},
-	{[]rune{0x26f1, 0xfe0f}, "umbrella on ground", 5, 10, []string{"rain", "sun", "umbrella", "umbrella on ground"}, false, 0},
-	{[]rune{0x26a1}, "high voltage", 5, 10, []string{"danger", "electric", "high voltage", "lightning", "voltage", "zap"}, false, 0},
-	{[]rune{0x2744, 0xfe0f}, "snowflake", 5, 10, []string{"cold", "snow", "snowflake"}, false, 0},
-	{[]rune{0x2603, 0xfe0f}, "snowman", 5, 10, []string{"cold", "snow", "snowman"}, false, 0},
-	{[]rune{0x26c4}, "snowman without snow", 5, 10, []string{"cold", "snow", "snowman", "snowman without snow"}, false, 0},
-	{[]rune{0x2604, 0xfe0f}, "comet", 5, 10, []string{"comet", "space"}, false, 0},
-	{[]rune{0x1f525}, "fire", 5, 10, []string{"fire", "flame", "tool"}, false, 0},
-	{[]rune{0x1f4a7}, "droplet", 5, 10, []string{"cold", "comic", "drop", "droplet", "sweat"}, false, 0},
-	{[]rune{0x1f30a}, "water wave", 5, 10, []string{"ocean", "water", "wave"}, false, 0},
-	{[]rune{0x1f383}, "jack-o-lantern", 6, 0, []string{"celebration", "halloween", "jack", "jack-o-lantern", "lantern"}, false, 0},
-	{[]rune{0x1f384}, "Christmas tree", 6, 0, []string{"celebration", "Christmas", "tree"}, false, 0},
-	{[]rune{0x1f386}, "fireworks", 6, 0, []string{"celebration", "fireworks"}, false, 0},
-	{[]rune{0x1f387}, "sparkler", 6, 0, []string{"celebration", "fireworks", "sparkle", "sparkler"}, false, 0},
-	{[]rune{0x1f9e8}, "firecracker", 6, 0, []string{"dynamite", "explosive", "firecracker", "fireworks"}, false, 0},
-	{[]rune{0x2728}, "sparkles", 6, 0, []string{"*", "sparkle", "sparkles", "star"}, false, 0},
-	{[]rune{0x1f388}, "balloon", 6, 0, []string{"balloon", "celebration"}, false, 0},
-	{[]rune{0x1f389}, "party popper", 6, 0, []string{"celebration", "party", "popper", "tada"}, false, 0},
-	{[]rune{0x1f38a}, "confetti ball", 6, 0, []string{"ball", "celebration", "confetti"}, false, 0},
-	{[]rune{0x1f38b}, "tanabata tree", 6, 0, []string{"banner", "celebration", "Japanese", "tanabata tree", "tree"}, false, 0},
-	{[]rune{0x1f38d}, "pine decoration", 6, 0, []string{"bamboo", "celebration", "Japanese", "pine", "pine decoration"}, false, 0},
-	{[]rune{0x1f38e}, "Japanese dolls", 6, 0, []string{"celebration", "doll", "festival", "Japanese", "Japanese dolls"}, false, 0},
-	{[]rune{0x1f38f}, "carp streamer", 6, 0, []string{"carp", "celebration", "streamer"}, false, 0},
-	{[]rune{0x1f390}, "wind chime", 6, 0, []string{"bell", "celebration", "chime", "wind"}, false, 0},
-	{[]rune{0x1f391}, "moon viewing ceremony", 6, 0, []string{"celebration", "ceremony", "moon", "moon viewing ceremony"}, false, 0},
-	{[]rune{0x1f9e7}, "red envelope", 6, 0, []string{"gift", "good luck", "hóngbāo", "lai see", "money", "red envelope"}, false, 0},
-	{[]rune{0x1f380}, "ribbon", 6, 0, []string{"celebration", "ribbon"}, false, 0},
-	{[]rune{0x1f381}, "wrapped gift", 6, 0, []string{"box", "celebration", "gift", "present", "wrapped"}, false, 0},
-	{[]rune{0x1f397, 0xfe0f}, "reminder ribbon", 6, 0, []string{"celebration", "reminder", "ribbon"}, false, 0},
-	{[]rune{0x1f39f, 0xfe0f}, "admission tickets", 6, 0, []string{"admission", "admission tickets", "ticket"}, false, 0},
-	{[]rune{0x1f3ab}, "ticket", 6, 0, []string{"admission", "ticket"}, false, 0},
-	{[]rune{0x1f396, 0xfe0f}, "military medal", 6, 1, []string{"celebration", "medal", "military"}, false, 0},
-	{[]rune{0x1f3c6}, "trophy", 6, 1, []string{"prize", "trophy"}, false, 0},
-	{[]rune{0x1f3c5}, "sports medal", 6, 1, []string{"medal", "sports medal"}, false, 0},
-	{[]rune{0x1f947}, "1st place medal", 6, 1, []string{"1st place medal", "first", "gold", "medal"}, false, 0},
-	{[]rune{0x1f948}, "2nd place medal", 6, 1, []string{"2nd place medal", "medal", "second", "silver"}, false, 0},
-	{[]rune{0x1f949}, "3rd place medal", 6, 1, []string{"3rd place medal", "bronze", "medal", "third"}, false, 0},
-	{[]rune{0x26bd}, "soccer ball", 6, 2, []string{"ball", "football", "soccer"}, false, 0},
-	{[]rune{0x26be}, "baseball", 6, 2, []string{"ball", "baseball"}, false, 0},
-	{[]rune{0x1f94e}, "softball", 6, 2, []string{"ball", "glove", "softball", "underarm"}, false, 0},
-	{[]rune{0x1f3c0}, "basketball", 6, 2, []string{"ball", "basketball", "hoop"}, false, 0},
-	{[]rune{0x1f3d0}, "volleyball", 6, 2, []string{"ball", "game", "volleyball"}, false, 0},
-	{[]rune{0x1f3c8}, "american football", 6, 2, []string{"american", "ball", "football"}, false, 0},
-	{[]rune{0x1f3c9}, "rugby football", 6, 2, []string{"ball", "football", "rugby"}, false, 0},
-	{[]rune{0x1f3be}, "tennis", 6, 2, []string{"ball", "racquet", "tennis"}, false, 0},
-	{[]rune{0x1f94f}, "flying disc", 6, 2, []string{"flying disc", "ultimate"}, false, 0},
-	{[]rune{0x1f3b3}, "bowling", 6, 2, []string{"ball", "bowling", "game"}, false, 0},
-	{[]rune{0x1f3cf}, "cricket game", 6, 2, []string{"ball", "bat", "cricket game", "game"}, false, 0},
-	{[]rune{0x1f3d1}, "field hockey", 6, 2, []string{"ball", "field", "game", "hockey", "stick"}, false, 0},
-	{[]rune{0x1f3d2}, "ice hockey", 6, 2, []string{"game", "hockey", "ice", "puck", "stick"}, false, 0},
-	{[]rune{0x1f94d}, "lacrosse", 6, 2, []string{"ball", "goal", "lacrosse", "stick"}, false, 0},
-	{[]rune{0x1f3d3}, "ping pong", 6, 2, []string{"ball", "bat", "game", "paddle", "ping pong", "table tennis"}, false, 0},
-	{[]rune{0x1f3f8}, "badminton", 6, 2, []string{"badminton", "birdie", "game", "racquet", "shuttlecock"}, false, 0},
-	{[]rune{0x1f94a}, "boxing glove", 6, 2, []string{"boxing", "glove"}, false, 0},
-	{[]rune{0x1f94b}, "martial arts uniform", 6, 2, []string{"judo", "karate", "martial arts", "martial arts uniform", "taekwondo", "uniform"}, false, 0},
-	{[]rune{0x1f945}, "goal net", 6, 2, []string{"goal", "net"}, false, 0},
-	{[]rune{0x26f3}, "flag in hole", 6, 2, []string{"flag in hole", "golf", "hole"}, false, 0},
-	{[]rune{0x26f8, 0xfe0f}, "ice skate", 6, 2, []string{"ice", "skate"}, false, 0},
-	{[]rune{0x1f3a3}, "fishing pole", 6, 2, []string{"fish", "fishing pole", "pole"}, false, 0},
-	{[]rune{0x1f93f}, "diving mask", 6, 2, []string{"diving", "diving mask", "scuba", "snorkeling"}, false, 0},
-	{[]rune{0x1f3bd}, "running shirt", 6, 2, []string{"athletics", "running", "sash", "shirt"}, false, 0},
-	{[]rune{0x1f3bf}, "skis", 6, 2, []string{"ski", "skis", "snow"}, false, 0},
-	{[]rune{0x1f6f7}, "sled", 6, 2, []string{"sled", "sledge", "sleigh"}, false, 0},
-	{[]rune{0x1f94c}, "curling stone", 6, 2, []string{"curling stone", "game", "rock"}, false, 0},
-	{[]rune{0x1f3af}, "bullseye", 6, 3, []string{"bullseye", "dart", "direct hit", "game", "hit", "target"}, false, 0},
-	{[]rune{0x1fa80}, "yo-yo", 6, 3, []string{"fluctuate", "toy", "yo-yo"}, false, 0},
-	{[]rune{0x1fa81}, "kite", 6, 3, []string{"fly", "kite", "soar"}, false, 0},
-	{[]rune{0x1f3b1}, "pool 8 ball", 6, 3, []string{"8", "ball", "billiard", "eight", "game", "pool 8 ball"}, false, 0},
-	{[]rune{0x1f52e}, "crystal ball", 6, 3, []string{"ball", "crystal", "fairy tale", "fantasy", "fortune", "tool"}, false, 0},
-	{[]rune{0x1fa84}, "magic wand", 6, 3, []string{"magic", "magic wand", "witch", "wizard"}, false, 0},
-	{[]rune{0x1f9ff}, "nazar amulet", 6, 3, []string{"bead", "charm", "evil-eye", "nazar", "nazar amulet", "talisman"}, false, 0},
-	{[]rune{0x1f3ae}, "video game", 6, 3, []string{"controller", "game", "video game"}, false, 0},
-	{[]rune{0x1f579, 0xfe0f}, "joystick", 6, 3, []string{"game", "joystick", "video game"}, false, 0},
-	{[]rune{0x1f3b0}, "slot machine", 6, 3, []string{"game", "slot", "slot machine"}, false, 0},
-	{[]rune{0x1f3b2}, "game die", 6, 3, []string{"dice", "die", "game"}, false, 0},
-	{[]rune{0x1f9e9}, "puzzle piece", 6, 3, []string{"clue", "interlocking", "jigsaw", "piece", "puzzle"}, false, 0},
-	{[]rune{0x1f9f8}, "teddy bear", 6, 3, []string{"plaything", "plush", "stuffed", "teddy bear", "toy"}, false, 0},
-	{[]rune{0x1fa85}, "piñata", 6, 3, []string{"celebration", "party", "piñata"}, false, 0},
-	{[]rune{0x1fa86}, "nesting dolls", 6, 3, []string{"doll", "nesting", "nesting dolls", "russia"}, false, 0},
-	{[]rune{0x2660, 0xfe0f}, "spade suit", 6, 3, []string{"card", "game", "spade suit"}, false, 0},
-	{[]rune{0x2665, 0xfe0f}, "heart suit", 6, 3, []string{"card", "game", "heart suit"}, false, 0},
-	{[]rune{0x2666, 0xfe0f}, "diamond suit", 6, 3, []string{"card", "diamond suit", "game"}, false, 0},
-	{[]rune{0x2663, 0xfe0f}, "club suit", 6, 3, []string{"card", "club suit", "game"}, false, 0},
-	{[]rune{0x265f, 0xfe0f}, "chess pawn", 6, 3, []string{"chess", "chess pawn", "dupe", "expendable"}, false, 0},
-	{[]rune{0x1f0cf}, "joker", 6, 3, []string{"card", "game", "joker", "wildcard"}, false, 0},
-	{[]rune{0x1f004}, "mahjong red dragon", 6, 3, []string{"game", "mahjong", "mahjong red dragon", "red"}, false, 0},
-	{[]rune{0x1f3b4}, "flower playing cards", 6, 3, []string{"card", "flower", "flower playing cards", "game", "Japanese", "playing"}, false, 0},
-	{[]rune{0x1f3ad}, "performing arts", 6, 4, []string{"art", "mask", "performing", "performing arts", "theater", "theatre"}, false, 0},
-	{[]rune{0x1f5bc, 0xfe0f}, "framed picture", 6, 4, []string{"art", "frame", "framed picture", "museum", "painting", "picture"}, false, 0},
-	{[]rune{0x1f3a8}, "artist palette", 6, 4, []string{"art", "artist palette", "museum", "painting", "palette"}, false, 0},
-	{[]rune{0x1f9f5}, "thread", 6, 4, []string{"needle", "sewing", "spool", "string", "thread"}, false, 0},
-	{[]rune{0x1faa1}, "sewing needle", 6, 4, []string{"embroidery", "needle", "sewing", "stitches", "sutures", "tailoring"}, false, 0},
-	{[]rune{0x1f9f6}, "yarn", 6, 4, []string{"ball", "crochet", "knit", "yarn"}, false, 0},
-	{[]rune{0x1faa2}, "knot", 6, 4, []string{"knot", "rope", "tangled", "tie", "twine", "twist"}, false, 0},
-	{[]rune{0x1f453}, "glasses", 7, 0, []string{"clothing", "eye", "eyeglasses", "eyewear", "glasses"}, false, 0},
-	{[]rune{0x1f576, 0xfe0f}, "sunglasses", 7, 0, []string{"dark", "eye", "eyewear", "glasses", "sunglasses"}, false, 0},
-	{[]rune{0x1f97d}, "goggles", 7, 0, []string{"eye protection", "goggles", "swimming", "welding"}, false, 0},
-	{[]rune{0x1f97c}, "lab coat", 7, 0, []string{"doctor", "experiment", "lab coat", "scientist"}, false, 0},
-	{[]rune{0x1f9ba}, "safety vest", 7, 0, []string{"emergency", "safety", "vest"}, false, 0},
-	{[]rune{0x1f454}, "necktie", 7, 0, []string{"clothing", "necktie", "tie"}, false, 0},
-	{[]rune{0x1f455}, "t-shirt", 7, 0, []string{"clothing", "shirt", "t-shirt", "tshirt"}, false, 0},
-	{[]rune{0x1f456}, "jeans", 7, 0, []string{"clothing", "jeans", "pants", "trousers"}, false, 0},
-	{[]rune{0x1f9e3}, "scarf", 7, 0, []string{"neck", "scarf"}, false, 0},
-	{[]rune{0x1f9e4}, "gloves", 7, 0, []string{"gloves", "hand"}, false, 0},
-	{[]rune{0x1f9e5}, "coat", 7, 0, []string{"coat", "jacket"}, false, 0},
-	{[]rune{0x1f9e6}, "socks", 7, 0, []string{"socks", "stocking"}, false, 0},
-	{[]rune{0x1f457}, "dress", 7, 0, []string{"clothing", "dress"}, false, 0},
-	{[]rune{0x1f458}, "kimono", 7, 0, []string{"clothing", "kimono"}, false, 0},
-	{[]rune{0x1f97b}, "sari", 7, 0, []string{"clothing", "dress", "sari"}, false, 0},
-	{[]rune{0x1fa71}, "one-piece swimsuit", 7, 0, []string{"bathing suit", "one-piece swimsuit"}, false, 0},
-	{[]rune{0x1fa72}, "briefs", 7, 0, []string{"bathing suit", "briefs", "one-piece", "swimsuit", "underwear"}, false, 0},
-	{[]rune{0x1fa73}, "shorts", 7, 0, []string{"bathing suit", "pants", "shorts", "underwear"}, false, 0},
-	{[]rune{0x1f459}, "bikini", 7, 0, []string{"bikini", "clothing", "swim"}, false, 0},
-	{[]rune{0x1f45a}, "woman’s clothes", 7, 0, []string{"clothing", "woman", "woman’s clothes"}, false, 0},
-	{[]rune{0x1f45b}, "purse", 7, 0, []string{"clothing", "coin", "purse"}, false, 0},
-	{[]rune{0x1f45c}, "handbag", 7, 0, []string{"bag", "clothing", "handbag", "purse"}, false, 0},
-	{[]rune{0x1f45d}, "clutch bag", 7, 0, []string{"bag", "clothing", "clutch bag", "pouch"}, false, 0},
-	{[]rune{0x1f6cd, 0xfe0f}, "shopping bags", 7, 0, []string{"bag", "hotel", "shopping", "shopping bags"}, false, 0},
-	{[]rune{0x1f392}, "backpack", 7, 0, []string{"backpack", "bag", "rucksack", "satchel", "school"}, false, 0},
-	{[]rune{0x1fa74}, "thong sandal", 7, 0, []string{"beach sandals", "sandals", "thong sandal", "thong sandals", "thongs", "zōri"}, false, 0},
-	{[]rune{0x1f45e}, "man’s shoe", 7, 0, []string{"clothing", "man", "man’s shoe", "shoe"}, false, 0},
-	{[]rune{0x1f45f}, "running shoe", 7, 0, []string{"athletic", "clothing", "running shoe", "shoe", "sneaker"}, false, 0},
-	{[]rune{0x1f97e}, "hiking boot", 7, 0, []string{"backpacking", "boot", "camping", "hiking"}, false, 0},
-	{[]rune{0x1f97f}, "flat shoe", 7, 0, []string{"ballet flat", "flat shoe", "slip-on", "slipper"}, false, 0},
-	{[]rune{0x1f460}, "high-heeled shoe", 7, 0, []string{"clothing", "heel", "high-heeled shoe", "shoe", "woman"}, false, 0},
-	{[]rune{0x1f461}, "woman’s sandal", 7, 0, []string{"clothing", "sandal", "shoe", "woman", "woman’s sandal"}, false, 0},
-	{[]rune{0x1fa70}, "ballet shoes", 7, 0, []string{"ballet", "ballet shoes", "dance"}, false, 0},
-	{[]rune{0x1f462}, "woman’s boot", 7, 0, []string{"boot", "clothing", "shoe", "woman", "woman’s boot"}, false, 0},
-	{[]rune{0x1f451}, "crown", 7, 0, []string{"clothing", "crown", "king", "queen"}, false, 0},
-	{[]rune{0x1f452}, "woman’s hat", 7, 0, []string{"clothing", "hat", "woman", "woman’s hat"}, false, 0},
-	{[]rune{0x1f3a9}, "top hat", 7, 0, []string{"clothing", "hat", "top", "tophat"}, false, 0},
-	{[]rune{0x1f393}, "graduation cap", 7, 0, []string{"cap", "celebration", "clothing", "graduation", "hat"}, false, 0},
-	{[]rune{0x1f9e2}, "billed cap", 7, 0, []string{"baseball cap", "billed cap"}, false, 0},
-	{[]rune{0x1fa96}, "military helmet", 7, 0, []string{"army", "helmet", "military", "soldier", "warrior"}, false, 0},
-	{[]rune{0x26d1, 0xfe0f}, "rescue worker’s helmet", 7, 0, []string{"aid", "cross", "face", "hat", "helmet", "rescue worker’s helmet"}, false, 0},
-	{[]rune{0x1f4ff}, "prayer beads", 7, 0, []string{"beads", "clothing", "necklace", "prayer", "religion"}, false, 0},
-	{[]rune{0x1f484}, "lipstick", 7, 0, []string{"cosmetics", "lipstick", "makeup"}, false, 0},
-	{[]rune{0x1f48d}, "ring", 7, 0, []string{"diamond", "ring"}, false, 0},
-	{[]rune{0x1f48e}, "gem stone", 7, 0, []string{"diamond", "gem", "gem stone", "jewel"}, false, 0},
-	{[]rune{0x1f507}, "muted speaker", 7, 1, []string{"mute", "muted speaker", "quiet", "silent", "speaker"}, false, 0},
-	{[]rune{0x1f508}, "speaker low volume", 7, 1, []string{"soft", "speaker low volume"}, false, 0},
-	{[]rune{0x1f509}, "speaker medium volume", 7, 1, []string{"medium", "speaker medium volume"}, false, 0},
-	{[]rune{0x1f50a}, "speaker high volume", 7, 1, []string{"loud", "speaker high volume"}, false, 0},
-	{[]rune{0x1f4e2}, "loudspeaker", 7, 1, []string{"loud", "loudspeaker", "public address"}, false, 0},
-	{[]rune{0x1f4e3}, "megaphone", 7, 1, []string{"cheering", "megaphone"}, false, 0},
-	{[]rune{0x1f4ef}, "postal horn", 7, 1, []string{"horn", "post", "postal"}, false, 0},
-	{[]rune{0x1f514}, "bell", 7, 1, []string{"bell"}, false, 0},
-	{[]rune{0x1f515}, "bell with slash", 7, 1, []string{"bell", "bell with slash", "forbidden", "mute", "quiet", "silent"}, false, 0},
-	{[]rune{0x1f3bc}, "musical score", 7, 2, []string{"music", "musical score", "score"}, false, 0},
-	{[]rune{0x1f3b5}, "musical note", 7, 2, []string{"music", "musical note", "note"}, false, 0},
-	{[]rune{0x1f3b6}, "musical notes", 7, 2, []string{"music", "musical notes", "note", "notes"}, false, 0},
-	{[]rune{0x1f399, 0xfe0f}, "studio microphone", 7, 2, []string{"mic", "microphone", "music", "studio"}, false, 0},
-	{[]rune{0x1f39a, 0xfe0f}, "level slider", 7, 2, []string{"level", "music", "slider"}, false, 0},
-	{[]rune{0x1f39b, 0xfe0f}, "control knobs", 7, 2, []string{"control", "knobs", "music"}, false, 0},
-	{[]rune{0x1f3a4}, "microphone", 7, 2, []string{"karaoke", "mic", "microphone"}, false, 0},
-	{[]rune{0x1f3a7}, "headphone", 7, 2, []string{"earbud", "headphone"}, false, 0},
-	{[]rune{0x1f4fb}, "radio", 7, 2, []string{"radio", "video"}, false, 0},
-	{[]rune{0x1f3b7}, "saxophone", 7, 3, []string{"instrument", "music", "sax", "saxophone"}, false, 0},
-	{[]rune{0x1fa97}, "accordion", 7, 3, []string{"accordian", "accordion", "concertina", "squeeze box"}, false, 0},
-	{[]rune{0x1f3b8}, "guitar", 7, 3, []string{"guitar", "instrument", "music"}, false, 0},
-	{[]rune{0x1f3b9}, "musical keyboard", 7, 3, []string{"instrument", "keyboard", "music", "musical keyboard", "piano"}, false, 0},
-	{[]rune{0x1f3ba}, "trumpet", 7, 3, []string{"instrument", "music", "trumpet"}, false, 0},
-	{[]rune{0x1f3bb}, "violin", 7, 3, []string{"instrument", "music", "violin"}, false, 0},
-	{[]rune{0x1fa95}, "banjo", 7, 3, []string{"banjo", "music", "stringed"}, false, 0},
-	{[]rune{0x1f941}, "drum", 7, 3, []string{"drum", "drumsticks", "music"}, false, 0},
-	{[]rune{0x1fa98}, "long drum", 7, 3, []string{"beat", "conga", "drum", "long drum", "rhythm"}, false, 0},
-	{[]rune{0x1f4f1}, "mobile phone", 7, 4, []string{"cell", "mobile", "phone", "telephone"}, false, 0},
-	{[]rune{0x1f4f2}, "mobile phone with arrow", 7, 4, []string{"arrow", "cell", "mobile", "mobile phone with arrow", "phone", "receive"}, false, 0},
-	{[]rune{0x260e, 0xfe0f}, "telephone", 7, 4, []string{"phone", "telephone"}, false, 0},
-	{[]rune{0x1f4de}, "telephone receiver", 7, 4, []string{"phone", "receiver", "telephone"}, false, 0},
-	{[]rune{0x1f4df}, "pager", 7, 4, []string{"pager"}, false, 0},
-	{[]rune{0x1f4e0}, "fax machine", 7, 4, []string{"fax", "fax machine"}, false, 0},
-	{[]rune{0x1f50b}, "battery", 7, 5, []string{"battery"}, false, 0},
-	{[]rune{0x1f50c}, "electric plug", 7, 5, []string{"electric", "electricity", "plug"}, false, 0},
-	{[]rune{0x1f4bb}, "laptop", 7, 5, []string{"computer", "laptop", "pc", "personal"}, false, 0},
-	{[]rune{0x1f5a5, 0xfe0f}, "desktop computer", 7, 5, []string{"computer", "desktop"}, false, 0},
-	{[]rune{0x1f5a8, 0xfe0f}, "printer", 7, 5, []string{"computer", "printer"}, false, 0},
-	{[]rune{0x2328, 0xfe0f}, "keyboard", 7, 5, []string{"computer", "keyboard"}, false, 0},
-	{[]rune{0x1f5b1, 0xfe0f}, "computer mouse", 7, 5, []string{"computer", "computer mouse"}, false, 0},
-	{[]rune{0x1f5b2, 0xfe0f}, "trackball", 7, 5, []string{"computer", "trackball"}, false, 0},
-	{[]rune{0x1f4bd}, "computer disk", 7, 5, []string{"computer", "disk", "minidisk", "optical"}, false, 0},
-	{[]rune{0x1f4be}, "floppy disk", 7, 5, []string{"computer", "disk", "floppy"}, false, 0},
-	{[]rune{0x1f4bf}, "optical disk", 7, 5, []string{"cd", "computer", "disk", "optical"}, false, 0},
-	{[]rune{0x1f4c0}, "dvd", 7, 5, []string{"blu-ray", "computer", "disk", "dvd", "optical"}, false, 0},
-	{[]rune{0x1f9ee}, "abacus", 7, 5, []string{"abacus", "calculation"}, false, 0},
-	{[]rune{0x1f3a5}, "movie camera", 7, 6, []string{"camera", "cinema", "movie"}, false, 0},
-	{[]rune{0x1f39e, 0xfe0f}, "film frames", 7, 6, []string{"cinema", "film", "frames", "movie"}, false, 0},
-	{[]rune{0x1f4fd, 0xfe0f}, "film projector", 7, 6, []string{"cinema", "film", "movie", "projector", "video"}, false, 0},
-	{[]rune{0x1f3ac}, "clapper board", 7, 6, []string{"clapper", "clapper board", "movie"}, false, 0},
-	{[]rune{0x1f4fa}, "television", 7, 6, []string{"television", "tv", "video"}, false, 0},
-	{[]rune{0x1f4f7}, "camera", 7, 6, []string{"camera", "video"}, false, 0},
-	{[]rune{0x1f4f8}, "camera with flash", 7, 6, []string{"camera", "camera with flash", "flash", "video"}, false, 0},
-	{[]rune{0x1f4f9}, "video camera", 7, 6, []string{"camera", "video"}, false, 0},
-	{[]rune{0x1f4fc}, "videocassette", 7, 6, []string{"tape", "vhs", "video", "videocassette"}, false, 0},
-	{[]rune{0x1f50d}, "magnifying glass tilted left", 7, 6, []string{"glass", "magnifying", "magnifying glass tilted left", "search", "tool"}, false, 0},
-	{[]rune{0x1f50e}, "magnifying glass tilted right", 7, 6, []string{"glass", "magnifying", "magnifying glass tilted right", "search", "tool"}, false, 0},
-	{[]rune{0x1f56f, 0xfe0f}, "candle", 7, 6, []string{"candle", "light"}, false, 0},
-	{[]rune{0x1f4a1}, "light bulb", 7, 6, []string{"bulb", "comic", "electric", "idea", "light"}, false, 0},
-	{[]rune{0x1f526}, "flashlight", 7, 6, []string{"electric", "flashlight", "light", "tool", "torch"}, false, 0},
-	{[]rune{0x1f3ee}, "red paper lantern", 7, 6, []string{"bar", "lantern", "light", "red", "red paper lantern"}, false, 0},
-	{[]rune{0x1fa94}, "diya lamp", 7, 6, []string{"diya", "lamp", "oil"}, false, 0},
-	{[]rune{0x1f4d4}, "notebook with decorative cover", 7, 7, []string{"book", "cover", "decorated", "notebook", "notebook with decorative cover"}, false, 0},
-	{[]rune{0x1f4d5}, "closed book", 7, 7, []string{"book", "closed"}, false, 0},
-	{[]rune{0x1f4d6}, "open book", 7, 7, []string{"book", "open"}, false, 0},
-	{[]rune{0x1f4d7}, "green book", 7, 7, []string{"book", "green"}, false, 0},
-	{[]rune{0x1f4d8}, "blue book", 7, 7, []string{"blue", "book"}, false, 0},
-	{[]rune{0x1f4d9}, "orange book", 7, 7, []string{"book", "orange"}, false, 0},
-	{[]rune{0x1f4da}, "books", 7, 7, []string{"book", "books"}, false, 0},
-	{[]rune{0x1f4d3}, "notebook", 7, 7, []string{"notebook"}, false, 0},
-	{[]rune{0x1f4d2}, "ledger", 7, 7, []string{"ledger", "notebook"}, false, 0},
-	{[]rune{0x1f4c3}, "page with curl", 7, 7, []string{"curl", "document", "page", "page with curl"}, false, 0},
-	{[]rune{0x1f4dc}, "scroll", 7, 7, []string{"paper", "scroll"}, false, 0},
-	{[]rune{0x1f4c4}, "page facing up", 7, 7, []string{"document", "page", "page facing up"}, false, 0},
-	{[]rune{0x1f4f0}, "newspaper", 7, 7, []string{"news", "newspaper", "paper"}, false, 0},
-	{[]rune{0x1f5de, 0xfe0f}, "rolled-up newspaper", 7, 7, []string{"news", "newspaper", "paper", "rolled", "rolled-up newspaper"}, false, 0},
-	{[]rune{0x1f4d1}, "bookmark tabs", 7, 7, []string{"bookmark", "mark", "marker", "tabs"}, false, 0},
-	{[]rune{0x1f516}, "bookmark", 7, 7, []string{"bookmark", "mark"}, false, 0},
-	{[]rune{0x1f3f7, 0xfe0f}, "label", 7, 7, []string{"label"}, false, 0},
-	{[]rune{0x1f4b0}, "money bag", 7, 8, []string{"bag", "dollar", "money", "moneybag"}, false, 0},
-	{[]rune{0x1fa99}, "coin", 7, 8, []string{"coin", "gold", "metal", "money", "silver", "treasure"}, false, 0},
-	{[]rune{0x1f4b4}, "yen banknote", 7, 8, []string{"banknote", "bill", "currency", "money", "note", "yen"}, false, 0},
-	{[]rune{0x1f4b5}, "dollar banknote", 7, 8, []string{"banknote", "bill", "currency", "dollar", "money", "note"}, false, 0},
-	{[]rune{0x1f4b6}, "euro banknote", 7, 8, []string{"banknote", "bill", "currency", "euro", "money", "note"}, false, 0},
-	{[]rune{0x1f4b7}, "pound banknote", 7, 8, []string{"banknote", "bill", "currency", "money", "note", "pound"}, false, 0},
-	{[]rune{0x1f4b8}, "money with wings", 7, 8, []string{"banknote", "bill", "fly", "money", "money with wings", "wings"}, false, 0},
-	{[]rune{0x1f4b3}, "credit card", 7, 8, []string{"card", "credit", "money"}, false, 0},
-	{[]rune{0x1f9fe}, "receipt", 7, 8, []string{"accounting", "bookkeeping", "evidence", "proof", "receipt"}, false, 0},
-	{[]rune{0x1f4b9}, "chart increasing with yen", 7, 8, []string{"chart", "chart increasing with yen", "graph", "growth", "money", "yen"}, false, 0},
-	{[]rune{0x2709, 0xfe0f}, "envelope", 7, 9, []string{"email", "envelope", "letter"}, false, 0},
-	{[]rune{0x1f4e7}, "e-mail", 7, 9, []string{"e-mail", "email", "letter", "mail"}, false, 0},
-	{[]rune{0x1f4e8}, "incoming envelope", 7, 9, []string{"e-mail", "email", "envelope", "incoming", "letter", "receive"}, false, 0},
-	{[]rune{0x1f4e9}, "envelope with arrow", 7, 9, []string{"arrow", "e-mail", "email", "envelope", "envelope with arrow", "outgoing"}, false, 0},
-	{[]rune{0x1f4e4}, "outbox tray", 7, 9, []string{"box", "letter", "mail", "outbox", "sent", "tray"}, false, 0},
-	{[]rune{0x1f4e5}, "inbox tray", 7, 9, []string{"box", "inbox", "letter", "mail", "receive", "tray"}, false, 0},
-	{[]rune{0x1f4e6}, "package", 7, 9, []string{"box", "package", "parcel"}, false, 0},
-	{[]rune{0x1f4eb}, "closed mailbox with raised flag", 7, 9, []string{"closed", "closed mailbox with raised flag", "mail", "mailbox", "postbox"}, false, 0},
-	{[]rune{0x1f4ea}, "closed mailbox with lowered flag", 7, 9, []string{"closed", "closed mailbox with lowered flag", "lowered", "mail", "mailbox", "postbox"}, false, 0},
-	{[]rune{0x1f4ec}, "open mailbox with raised flag", 7, 9, []string{"mail", "mailbox", "open", "open mailbox with raised flag", "postbox"}, false, 0},
-	{[]rune{0x1f4ed}, "open mailbox with lowered flag", 7, 9, []string{"lowered", "mail", "mailbox", "open", "open mailbox with lowered flag", "postbox"}, false, 0},
-	{[]rune{0x1f4ee}, "postbox", 7, 9, []string{"mail", "mailbox", "postbox"}, false, 0},
-	{[]rune{0x1f5f3, 0xfe0f}, "ballot box with ballot", 7, 9, []string{"ballot", "ballot box with ballot", "box"}, false, 0},
-	{[]rune{0x270f, 0xfe0f}, "pencil", 7, 10, []string{"pencil"}, false, 0},
-	{[]rune{0x2712, 0xfe0f}, "black nib", 7, 10, []string{"black nib", "nib", "pen"}, false, 0},
-	{[]rune{0x1f58b, 0xfe0f}, "fountain pen", 7, 10, []string{"fountain", "pen"}, false, 0},
-	{[]rune{0x1f58a, 0xfe0f}, "pen", 7, 10, []string{"ballpoint", "pen"}, false, 0},
-	{[]rune{0x1f58c, 0xfe0f}, "paintbrush", 7, 10, []string{"paintbrush", "painting"}, false, 0},
-	{[]rune{0x1f58d, 0xfe0f}, "crayon", 7, 10, []string{"crayon"}, false, 0},
-	{[]rune{0x1f4dd}, "memo", 7, 10, []string{"memo", "pencil"}, false, 0},
-	{[]rune{0x1f4bc}, "briefcase", 7, 11, []string{"briefcase"}, false, 0},
-	{[]rune{0x1f4c1}, "file folder", 7, 11, []string{"file", "folder"}, false, 0},
-	{[]rune{0x1f4c2}, "open file folder", 7, 11, []string{"file", "folder", "open"}, false, 0},
-	{[]rune{0x1f5c2, 0xfe0f}, "card index dividers", 7, 11, []string{"card", "dividers", "index"}, false, 0},
-	{[]rune{0x1f4c5}, "calendar", 7, 11, []string{"calendar", "date"}, false, 0},
-	{[]rune{0x1f4c6}, "tear-off calendar", 7, 11, []string{"calendar", "tear-off calendar"}, false, 0},
-	{[]rune{0x1f5d2, 0xfe0f}, "spiral notepad", 7, 11, []string{"note", "pad", "spiral", "spiral notepad"}, false, 0},
-	{[]rune{0x1f5d3, 0xfe0f}, "spiral calendar", 7, 11, []string{"calendar", "pad", "spiral"}, false, 0},
-	{[]rune{0x1f4c7}, "card index", 7, 11, []string{"card", "index", "rolodex"}, false, 0},
-	{[]rune{0x1f4c8}, "chart increasing", 7, 11, []string{"chart", "chart increasing", "graph", "growth", "trend", "upward"}, false, 0},
-	{[]rune{0x1f4c9}, "chart decreasing", 7, 11, []string{"chart", "chart decreasing", "down", "graph", "trend"}, false, 0},
-	{[]rune{0x1f4ca}, "bar chart", 7, 11, []string{"bar", "chart", "graph"}, false, 0},
-	{[]rune{0x1f4cb}, "clipboard", 7, 11, []string{"clipboard"}, false, 0},
-	{[]rune{0x1f4cc}, "pushpin", 7, 11, []string{"pin", "pushpin"}, false, 0},
-	{[]rune{0x1f4cd}, "round pushpin", 7, 11, []string{"pin", "pushpin", "round pushpin"}, false, 0},
-	{[]rune{0x1f4ce}, "paperclip", 7, 11, []string{"paperclip"}, false, 0},
-	{[]rune{0x1f587, 0xfe0f}, "linked paperclips", 7, 11, []string{"link", "linked paperclips", "paperclip"}, false, 0},
-	{[]rune{0x1f4cf}, "straight ruler", 7, 11, []string{"ruler", "straight edge", "straight ruler"}, false, 0},
-	{[]rune{0x1f4d0}, "triangular ruler", 7, 11, []string{"ruler", "set", "triangle", "triangular ruler"}, false, 0},
-	{[]rune{0x2702, 0xfe0f}, "scissors", 7, 11, []string{"cutting", "scissors", "tool"}, false, 0},
-	{[]rune{0x1f5c3, 0xfe0f}, "card file box", 7, 11, []string{"box", "card", "file"}, false, 0},
-	{[]rune{0x1f5c4, 0xfe0f}, "file cabinet", 7, 11, []string{"cabinet", "file", "filing"}, false, 0},
-	{[]rune{0x1f5d1, 0xfe0f}, "wastebasket", 7, 11, []string{"wastebasket"}, false, 0},
-	{[]rune{0x1f512}, "locked", 7, 12, []string{"closed", "locked"}, false, 0},
-	{[]rune{0x1f513}, "unlocked", 7, 12, []string{"lock", "open", "unlock", "unlocked"}, false, 0},
-	{[]rune{0x1f50f}, "locked with pen", 7, 12, []string{"ink", "lock", "locked with pen", "nib", "pen", "privacy"}, false, 0},
-	{[]rune{0x1f510}, "locked with key", 7, 12, []string{"closed", "key", "lock", "locked with key", "secure"}, false, 0},
-	{[]rune{0x1f511}, "key", 7, 12, []string{"key", "lock", "password"}, false, 0},
-	{[]rune{0x1f5dd, 0xfe0f}, "old key", 7, 12, []string{"clue", "key", "lock", "old"}, false, 0},
-	{[]rune{0x1f528}, "hammer", 7, 13, []string{"hammer", "tool"}, false, 0},
-	{[]rune{0x1fa93}, "axe", 7, 13, []string{"axe", "chop", "hatchet", "split", "wood"}, false, 0},
-	{[]rune{0x26cf, 0xfe0f}, "pick", 7, 13, []string{"mining", "pick", "tool"}, false, 0},
-	{[]rune{0x2692, 0xfe0f}, "hammer and pick", 7, 13, []string{"hammer", "hammer and pick", "pick", "tool"}, false, 0},
-	{[]rune{0x1f6e0, 0xfe0f}, "hammer and wrench", 7, 13, []string{"hammer", "hammer and wrench", "spanner", "tool", "wrench"}, false, 0},
-	{[]rune{0x1f5e1, 0xfe0f}, "dagger", 7, 13, []string{"dagger", "knife", "weapon"}, false, 0},
-	{[]rune{0x2694, 0xfe0f}, "crossed swords", 7, 13, []string{"crossed", "swords", "weapon"}, false, 0},
-	{[]rune{0x1f52b}, "water pistol", 7, 13, []string{"gun", "handgun", "pistol", "revolver", "tool", "water", "weapon"}, false, 0},
-	{[]rune{0x1fa83}, "boomerang", 7, 13, []string{"australia", "boomerang", "rebound", "repercussion"}, false, 0},
-	{[]rune{0x1f3f9}, "bow and arrow", 7, 13, []string{"archer", "arrow", "bow", "bow and arrow", "Sagittarius", "zodiac"}, false, 0},
-	{[]rune{0x1f6e1, 0xfe0f}, "shield", 7, 13, []string{"shield", "weapon"}, false, 0},
-	{[]rune{0x1fa9a}, "carpentry saw", 7, 13, []string{"carpenter", "carpentry saw", "lumber", "saw", "tool"}, false, 0},
-	{[]rune{0x1f527}, "wrench", 7, 13, []string{"spanner", "tool", "wrench"}, false, 0},
-	{[]rune{0x1fa9b}, "screwdriver", 7, 13, []string{"screw", "screwdriver", "tool"}, false, 0},
-	{[]rune{0x1f529}, "nut and bolt", 7, 13, []string{"bolt", "nut", "nut and bolt", "tool"}, false, 0},
-	{[]rune{0x2699, 0xfe0f}, "gear", 7, 13, []string{"cog", "cogwheel", "gear", "tool"}, false, 0},
-	{[]rune{0x1f5dc, 0xfe0f}, "clamp", 7, 13, []string{"clamp", "compress", "tool", "vice"}, false, 0},
-	{[]rune{0x2696, 0xfe0f}, "balance scale", 7, 13, []string{"balance", "justice", "Libra", "scale", "zodiac"}, false, 0},
-	{[]rune{0x1f9af}, "white cane", 7, 13, []string{"accessibility", "blind", "white cane"}, false, 0},
-	{[]rune{0x1f517}, "link", 7, 13, []string{"link"}, false, 0},
-	{[]rune{0x26d3, 0xfe0f}, "chains", 7, 13, []string{"chain", "chains"}, false, 0},
-	{[]rune{0x1fa9d}, "hook", 7, 13, []string{"catch", "crook", "curve", "ensnare", "hook", "selling point"}, false, 0},
-	{[]rune{0x1f9f0}, "toolbox", 7, 13, []string{"chest", "mechanic", "tool", "toolbox"}, false, 0},
-	{[]rune{0x1f9f2}, "magnet", 7, 13, []string{"attraction", "horseshoe", "magnet", "magnetic"}, false, 0},
-	{[]rune{0x1fa9c}, "ladder", 7, 13, []string{"climb", "ladder", "rung", "step"}, false, 0},
-	{[]rune{0x2697, 0xfe0f}, "alembic", 7, 14, []string{"alembic", "chemistry", "tool"}, false, 0},
-	{[]rune{0x1f9ea}, "test tube", 7, 14, []string{"chemist", "chemistry", "experiment", "lab", "science", "test tube"}, false, 0},
-	{[]rune{0x1f9eb}, "petri dish", 7, 14, []string{"bacteria", "biologist", "biology", "culture", "lab", "petri dish"}, false, 0},
-	{[]rune{0x1f9ec}, "dna", 7, 14, []string{"biologist", "dna", "evolution", "gene", "genetics", "life"}, false, 0},
-	{[]rune{0x1f52c}, "microscope", 7, 14, []string{"microscope", "science", "tool"}, false, 0},
-	{[]rune{0x1f52d}, "telescope", 7, 14, []string{"science", "telescope", "tool"}, false, 0},
-	{[]rune{0x1f4e1}, "satellite antenna", 7, 14, []string{"antenna", "dish", "satellite"}, false, 0},
-	{[]rune{0x1f489}, "syringe", 7, 15, []string{"medicine", "needle", "shot", "sick", "syringe"}, false, 0},
-	{[]rune{0x1fa78}, "drop of blood", 7, 15, []string{"bleed", "blood donation", "drop of blood", "injury", "medicine", "menstruation"}, false, 0},
-	{[]rune{0x1f48a}, "pill", 7, 15, []string{"doctor", "medicine", "pill", "sick"}, false, 0},
-	{[]rune{0x1fa79}, "adhesive bandage", 7, 15, []string{"adhesive bandage", "bandage"}, false, 0},
-	{[]rune{0x1fa7a}, "stethoscope", 7, 15, []string{"doctor", "heart", "medicine", "stethoscope"}, false, 0},
-	{[]rune{0x1f6aa}, "door", 7, 16, []string{"door"}, false, 0},
-	{[]rune{0x1f6d7}, "elevator", 7, 16, []string{"accessibility", "elevator", "hoist", "lift"}, false, 0},
-	{[]rune{0x1fa9e}, "mirror", 7, 16, []string{"mirror", "reflection", "reflector", "speculum"}, false, 0},
-	{[]rune{0x1fa9f}, "window", 7, 16, []string{"frame", "fresh air", "opening", "transparent", "view", "window"}, false, 0},
-	{[]rune{0x1f6cf, 0xfe0f}, "bed", 7, 16, []string{"bed", "hotel", "sleep"}, false, 0},
-	{[]rune{0x1f6cb, 0xfe0f}, "couch and lamp", 7, 16, []string{"couch", "couch and lamp", "hotel", "lamp"}, false, 0},
-	{[]rune{0x1fa91}, "chair", 7, 16, []string{"chair", "seat", "sit"}, false, 0},
-	{[]rune{0x1f6bd}, "toilet", 7, 16, []string{"toilet"}, false, 0},
-	{[]rune{0x1faa0}, "plunger", 7, 16, []string{"force cup", "plumber", "plunger", "suction", "toilet"}, false, 0},
-	{[]rune{0x1f6bf}, "shower", 7, 16, []string{"shower", "water"}, false, 0},
-	{[]rune{0x1f6c1}, "bathtub", 7, 16, []string{"bath", "bathtub"}, false, 0},
-	{[]rune{0x1faa4}, "mouse trap", 7, 16, []string{"bait", "mouse trap", "mousetrap", "snare", "trap"}, false, 0},
-	{[]rune{0x1fa92}, "razor", 7, 16, []string{"razor", "sharp", "shave"}, false, 0},
-	{[]rune{0x1f9f4}, "lotion bottle", 7, 16, []string{"lotion", "lotion bottle", "moisturizer", "shampoo", "sunscreen"}, false, 0},
-	{[]rune{0x1f9f7}, "safety pin", 7, 16, []string{"diaper", "punk rock", "safety pin"}, false, 0},
-	{[]rune{0x1f9f9}, "broom", 7, 16, []string{"broom", "cleaning", "sweeping", "witch"}, false, 0},
-	{[]rune{0x1f9fa}, "basket", 7, 16, []string{"basket", "farming", "laundry", "picnic"}, false, 0},
-	{[]rune{0x1f9fb}, "roll of paper", 7, 16, []string{"paper towels", "roll of paper", "toilet paper"}, false, 0},
-	{[]rune{0x1faa3}, "bucket", 7, 16, []string{"bucket", "cask", "pail", "vat"}, false, 0},
-	{[]rune{0x1f9fc}, "soap", 7, 16, []string{"bar", "bathing", "cleaning", "lather", "soap", "soapdish"}, false, 0},
-	{[]rune{0x1faa5}, "toothbrush", 7, 16, []string{"bathroom", "brush", "clean", "dental", "hygiene", "teeth", "toothbrush"}, false, 0},
-	{[]rune{0x1f9fd}, "sponge", 7, 16, []string{"absorbing", "cleaning", "porous", "sponge"}, false, 0},
-	{[]rune{0x1f9ef}, "fire extinguisher", 7, 16, []string{"extinguish", "fire", "fire extinguisher", "quench"}, false, 0},
-	{[]rune{0x1f6d2}, "shopping cart", 7, 16, []string{"cart", "shopping", "trolley"}, false, 0},
-	{[]rune{0x1f6ac}, "cigarette", 7, 17, []string{"cigarette", "smoking"}, false, 0},
-	{[]rune{0x26b0, 0xfe0f}, "coffin", 7, 17, []string{"coffin", "death"}, false, 0},
-	{[]rune{0x1faa6}, "headstone", 7, 17, []string{"cemetery", "grave", "graveyard", "headstone", "tombstone"}, false, 0},
-	{[]rune{0x26b1, 0xfe0f}, "funeral urn", 7, 17, []string{"ashes", "death", "funeral", "urn"}, false, 0},
-	{[]rune{0x1f5ff}, "moai", 7, 17, []string{"face", "moai", "moyai", "statue"}, false, 0},
-	{[]rune{0x1faa7}, "placard", 7, 17, []string{"demonstration", "picket", "placard", "protest", "sign"}, false, 0},
-	{[]rune{0x1f3e7}, "ATM sign", 8, 0, []string{"atm", "ATM sign", "automated", "bank", "teller"}, false, 0},
-	{[]rune{0x1f6ae}, "litter in bin sign", 8, 0, []string{"litter", "litter bin", "litter in bin sign"}, false, 0},
-	{[]rune{0x1f6b0}, "potable water", 8, 0, []string{"drinking", "potable", "water"}, false, 0},
-	{[]rune{0x267f}, "wheelchair symbol", 8, 0, []string{"access", "wheelchair symbol"}, false, 0},
-	{[]rune{0x1f6b9}, "men’s room", 8, 0, []string{"lavatory", "man", "men’s room", "restroom", "wc"}, false, 0},
-	{[]rune{0x1f6ba}, "women’s room", 8, 0, []string{"lavatory", "restroom", "wc", "woman", "women’s room"}, false, 0},
-	{[]rune{0x1f6bb}, "restroom", 8, 0, []string{"lavatory", "restroom", "WC"}, false, 0},
-	{[]rune{0x1f6bc}, "baby symbol", 8, 0, []string{"baby", "baby symbol", "changing"}, false, 0},
-	{[]rune{0x1f6be}, "water closet", 8, 0, []string{"closet", "lavatory", "restroom", "water", "wc"}, false, 0},
-	{[]rune{0x1f6c2}, "passport control", 8, 0, []string{"control", "passport"}, false, 0},
-	{[]rune{0x1f6c3}, "customs", 8, 0, []string{"customs"}, false, 0},
-	{[]rune{0x1f6c4}, "baggage claim", 8, 0, []string{"baggage", "claim"}, false, 0},
-	{[]rune{0x1f6c5}, "left luggage", 8, 0, []string{"baggage", "left luggage", "locker", "luggage"}, false, 0},
-	{[]rune{0x26a0, 0xfe0f}, "warning", 8, 1, []string{"warning"}, false, 0},
-	{[]rune{0x1f6b8}, "children crossing", 8, 1, []string{"child", "children crossing", "crossing", "pedestrian", "traffic"}, false, 0},
-	{[]rune{0x26d4}, "no entry", 8, 1, []string{"entry", "forbidden", "no", "not", "prohibited", "traffic"}, false, 0},
-	{[]rune{0x1f6ab}, "prohibited", 8, 1, []string{"entry", "forbidden", "no", "not", "prohibited"}, false, 0},
-	{[]rune{0x1f6b3}, "no bicycles", 8, 1, []string{"bicycle", "bike", "forbidden", "no", "no bicycles", "prohibited"}, false, 0},
-	{[]rune{0x1f6ad}, "no smoking", 8, 1, []string{"forbidden", "no", "not", "prohibited", "smoking"}, false, 0},
-	{[]rune{0x1f6af}, "no littering", 8, 1, []string{"forbidden", "litter", "no", "no littering", "not", "prohibited"}, false, 0},
-	{[]rune{0x1f6b1}, "non-potable water", 8, 1, []string{"non-drinking", "non-potable", "water"}, false, 0},
-	{[]rune{0x1f6b7}, "no pedestrians", 8, 1, []string{"forbidden", "no", "no pedestrians", "not", "pedestrian", "prohibited"}, false, 0},
-	{[]rune{0x1f4f5}, "no mobile phones", 8, 1, []string{"cell", "forbidden", "mobile", "no", "no mobile phones", "phone"}, false, 0},
-	{[]rune{0x1f51e}, "no one under eighteen", 8, 1, []string{"18", "age restriction", "eighteen", "no one under eighteen", "prohibited", "underage"}, false, 0},
-	{[]rune{0x2622, 0xfe0f}, "radioactive", 8, 1, []string{"radioactive", "sign"}, false, 0},
-	{[]rune{0x2623, 0xfe0f}, "biohazard", 8, 1, []string{"biohazard", "sign"}, false, 0},
-	{[]rune{0x2b06, 0xfe0f}, "up arrow", 8, 2, []string{"arrow", "cardinal", "direction", "north", "up arrow"}, false, 0},
-	{[]rune{0x2197, 0xfe0f}, "up-right arrow", 8, 2, []string{"arrow", "direction", "intercardinal", "northeast", "up-right arrow"}, false, 0},
-	{[]rune{0x27a1, 0xfe0f}, "right arrow", 8, 2, []string{"arrow", "cardinal", "direction", "east", "right arrow"}, false, 0},
-	{[]rune{0x2198, 0xfe0f}, "down-right arrow", 8, 2, []string{"arrow", "direction", "down-right arrow", "intercardinal", "southeast"}, false, 0},
-	{[]rune{0x2b07, 0xfe0f}, "down arrow", 8, 2, []string{"arrow", "cardinal", "direction", "down", "south"}, false, 0},
-	{[]rune{0x2199, 0xfe0f}, "down-left arrow", 8, 2, []string{"arrow", "direction", "down-left arrow", "intercardinal", "southwest"}, false, 0},
-	{[]rune{0x2b05, 0xfe0f}, "left arrow", 8, 2, []string{"arrow", "cardinal", "direction", "left arrow", "west"}, false, 0},
-	{[]rune{0x2196, 0xfe0f}, "up-left arrow", 8, 2, []string{"arrow", "direction", "intercardinal", "northwest", "up-left arrow"}, false, 0},
-	{[]rune{0x2195, 0xfe0f}, "up-down arrow", 8, 2, []string{"arrow", "up-down arrow"}, false, 0},
-	{[]rune{0x2194, 0xfe0f}, "left-right arrow", 8, 2, []string{"arrow", "left-right arrow"}, false, 0},
-	{[]rune{0x21a9, 0xfe0f}, "right arrow curving left", 8, 2, []string{"arrow", "right arrow curving left"}, false, 0},
-	{[]rune{0x21aa, 0xfe0f}, "left arrow curving right", 8, 2, []string{"arrow", "left arrow curving right"}, false, 0},
-	{[]rune{0x2934, 0xfe0f}, "right arrow curving up", 8, 2, []string{"arrow", "right arrow curving up"}, false, 0},
-	{[]rune{0x2935, 0xfe0f}, "right arrow curving down", 8, 2, []string{"arrow", "down", "right arrow curving down"}, false, 0},
-	{[]rune{0x1f503}, "clockwise vertical arrows", 8, 2, []string{"arrow", "clockwise", "clockwise vertical arrows", "reload"}, false, 0},
-	{[]rune{0x1f504}, "counterclockwise arrows button", 8, 2, []string{"anticlockwise", "arrow", "counterclockwise", "counterclockwise arrows button", "withershins"}, false, 0},
-	{[]rune{0x1f519}, "BACK arrow", 8, 2, []string{"arrow", "back", "BACK arrow"}, false, 0},
-	{[]rune{0x1f51a}, "END arrow", 8, 2, []string{"arrow", "end", "END arrow"}, false, 0},
-	{[]rune{0x1f51b}, "ON! arrow", 8, 2, []string{"arrow", "mark", "on", "ON! arrow"}, false, 0},
-	{[]rune{0x1f51c}, "SOON arrow", 8, 2, []string{"arrow", "soon", "SOON arrow"}, false, 0},
-	{[]rune{0x1f51d}, "TOP arrow", 8, 2, []string{"arrow", "top", "TOP arrow", "up"}, false, 0},
-	{[]rune{0x1f6d0}, "place of worship", 8, 3, []string{"place of worship", "religion", "worship"}, false, 0},
-	{[]rune{0x269b, 0xfe0f}, "atom symbol", 8, 3, []string{"atheist", "atom", "atom symbol"}, false, 0},
-	{[]rune{0x1f549, 0xfe0f}, "om", 8, 3, []string{"Hindu", "om", "religion"}, false, 0},
-	{[]rune{0x2721, 0xfe0f}, "star of David", 8, 3, []string{"David", "Jew", "Jewish", "religion", "star", "star of David"}, false, 0},
-	{[]rune{0x2638, 0xfe0f}, "wheel of dharma", 8, 3, []string{"Buddhist", "dharma", "religion", "wheel", "wheel of dharma"}, false, 0},
-	{[]rune{0x262f, 0xfe0f}, "yin yang", 8, 3, []string{"religion", "tao", "taoist", "yang", "yin"}, false, 0},
-	{[]rune{0x271d, 0xfe0f}, "latin cross", 8, 3, []string{"Christian", "cross", "latin cross", "religion"}, false, 0},
-	{[]rune{0x2626, 0xfe0f}, "orthodox cross", 8, 3, []string{"Christian", "cross", "orthodox cross", "religion"}, false, 0},
-	{[]rune{0x262a, 0xfe0f}, "star and crescent", 8, 3, []string{"islam", "Muslim", "religion", "star and crescent"}, false, 0},
-	{[]rune{0x262e, 0xfe0f}, "peace symbol", 8, 3, []string{"peace", "peace symbol"}, false, 0},
-	{[]rune{0x1f54e}, "menorah", 8, 3, []string{"candelabrum", "candlestick", "menorah", "religion"}, false, 0},
-	{[]rune{0x1f52f}, "dotted six-pointed star", 8, 3, []string{"dotted six-pointed star", "fortune", "star"}, false, 0},
-	{[]rune{0x2648}, "Aries", 8, 4, []string{"Aries", "ram", "zodiac"}, false, 0},
-	{[]rune{0x2649}, "Taurus", 8, 4, []string{"bull", "ox", "Taurus", "zodiac"}, false, 0},
-	{[]rune{0x264a}, "Gemini", 8, 4, []string{"Gemini", "twins", "zodiac"}, false, 0},
-	{[]rune{0x264b}, "Cancer", 8, 4, []string{"Cancer", "crab", "zodiac"}, false, 0},
-	{[]rune{0x264c}, "Leo", 8, 4, []string{"Leo", "lion", "zodiac"}, false, 0},
-	{[]rune{0x264d}, "Virgo", 8, 4, []string{"Virgo", "zodiac"}, false, 0},
-	{[]rune{0x264e}, "Libra", 8, 4, []string{"balance", "justice", "Libra", "scales", "zodiac"}, false, 0},
-	{[]rune{0x264f}, "Scorpio", 8, 4, []string{"Scorpio", "scorpion", "scorpius", "zodiac"}, false, 0},
-	{[]rune{0x2650}, "Sagittarius", 8, 4, []string{"archer", "Sagittarius", "zodiac"}, false, 0},
-	{[]rune{0x2651}, "Capricorn", 8, 4, []string{"Capricorn", "goat", "zodiac"}, false, 0},
-	{[]rune{0x2652}, "Aquarius", 8, 4, []string{"Aquarius", "bearer", "water", "zodiac"}, false, 0},
-	{[]rune{0x2653}, "Pisces", 8, 4, []string{"fish", "Pisces", "zodiac"}, false, 0},
-	{[]rune{0x26ce}, "Ophiuchus", 8, 4, []string{"bearer", "Ophiuchus", "serpent", "snake", "zodiac"}, false, 0},
-	{[]rune{0x1f500}, "shuffle tracks button", 8, 5, []string{"arrow", "crossed", "shuffle tracks button"}, false, 0},
-	{[]rune{0x1f501}, "repeat button", 8, 5, []string{"arrow", "clockwise", "repeat", "repeat button"}, false, 0},
-	{[]rune{0x1f502}, "repeat single button", 8, 5, []string{"arrow", "clockwise", "once", "repeat single button"}, false, 0},
-	{[]rune{0x25b6, 0xfe0f}, "play button", 8, 5, []string{"arrow", "play", "play button", "right", "triangle"}, false, 0},
-	{[]rune{0x23e9}, "fast-forward button", 8, 5, []string{"arrow", "double", "fast", "fast-forward button", "forward"}, false, 0},
-	{[]rune{0x23ed, 0xfe0f}, "next track button", 8, 5, []string{"arrow", "next scene", "next track", "next track button", "triangle"}, false, 0},
-	{[]rune{0x23ef, 0xfe0f}, "play or pause button", 8, 5, []string{"arrow", "pause", "play", "play or pause button", "right", "triangle"}, false, 0},
-	{[]rune{0x25c0, 0xfe0f}, "reverse button", 8, 5, []string{"arrow", "left", "reverse", "reverse button", "triangle"}, false, 0},
-	{[]rune{0x23ea}, "fast reverse button", 8, 5, []string{"arrow", "double", "fast reverse button", "rewind"}, false, 0},
-	{[]rune{0x23ee, 0xfe0f}, "last track button", 8, 5, []string{"arrow", "last track button", "previous scene", "previous track", "triangle"}, false, 0},
-	{[]rune{0x1f53c}, "upwards button", 8, 5, []string{"arrow", "button", "red", "upwards button"}, false, 0},
-	{[]rune{0x23eb}, "fast up button", 8, 5, []string{"arrow", "double", "fast up button"}, false, 0},
-	{[]rune{0x1f53d}, "downwards button", 8, 5, []string{"arrow", "button", "down", "downwards button", "red"}, false, 0},
-	{[]rune{0x23ec}, "fast down button", 8, 5, []string{"arrow", "double", "down", "fast down button"}, false, 0},
-	{[]rune{0x23f8, 0xfe0f}, "pause button", 8, 5, []string{"bar", "double", "pause", "pause button", "vertical"}, false, 0},
-	{[]rune{0x23f9, 0xfe0f}, "stop button", 8, 5, []string{"square", "stop", "stop button"}, false, 0},
-	{[]rune{0x23fa, 0xfe0f}, "record button", 8, 5, []string{"circle", "record", "record button"}, false, 0},
-	{[]rune{0x23cf, 0xfe0f}, "eject button", 8, 5, []string{"eject", "eject button"}, false, 0},
-	{[]rune{0x1f3a6}, "cinema", 8, 5, []string{"camera", "cinema", "film", "movie"}, false, 0},
-	{[]rune{0x1f505}, "dim button", 8, 5, []string{"brightness", "dim", "dim button", "low"}, false, 0},
-	{[]rune{0x1f506}, "bright button", 8, 5, []string{"bright", "bright button", "brightness"}, false, 0},
-	{[]rune{0x1f4f6}, "antenna bars", 8, 5, []string{"antenna", "antenna bars", "bar", "cell", "mobile", "phone"}, false, 0},
-	{[]rune{0x1f4f3}, "vibration mode", 8, 5, []string{"cell", "mobile", "mode", "phone", "telephone", "vibration"}, false, 0},
-	{[]rune{0x1f4f4}, "mobile phone off", 8, 5, []string{"cell", "mobile", "off", "phone", "telephone"}, false, 0},
-	{[]rune{0x2640, 0xfe0f}, "female sign", 8, 6, []string{"female sign", "woman"}, false, 0},
-	{[]rune{0x2642, 0xfe0f}, "male sign", 8, 6, []string{"male sign", "man"}, false, 0},
-	{[]rune{0x26a7, 0xfe0f}, "transgender symbol", 8, 6, []string{"transgender", "transgender symbol"}, false, 0},
-	{[]rune{0x2716, 0xfe0f}, "multiply", 8, 7, []string{"×", "cancel", "multiplication", "multiply", "sign", "x"}, false, 0},
-	{[]rune{0x2795}, "plus", 8, 7, []string{"+", "math", "plus", "sign"}, false, 0},
-	{[]rune{0x2796}, "minus", 8, 7, []string{"-", "−", "math", "minus", "sign"}, false, 0},
-	{[]rune{0x2797}, "divide", 8, 7, []string{"÷", "divide", "division", "math", "sign"}, false, 0},
-	{[]rune{0x267e, 0xfe0f}, "infinity", 8, 7, []string{"forever", "infinity", "unbounded", "universal"}, false, 0},
-	{[]rune{0x203c, 0xfe0f}, "double exclamation mark", 8, 8, []string{"!", "!!", "bangbang", "double exclamation mark", "exclamation", "mark"}, false, 0},
-	{[]rune{0x2049, 0xfe0f}, "exclamation question mark", 8, 8, []string{"!", "!?", "?", "exclamation", "interrobang", "mark", "punctuation", "question"}, false, 0},
-	{[]rune{0x2753}, "red question mark", 8, 8, []string{"?", "mark", "punctuation", "question", "red question mark"}, false, 0},
-	{[]rune{0x2754}, "white question mark", 8, 8, []string{"?", "mark", "outlined", "punctuation", "question", "white question mark"}, false, 0},
-	{[]rune{0x2755}, "white exclamation mark", 8, 8, []string{"!", "exclamation", "mark", "outlined", "punctuation", "white exclamation mark"}, false, 0},
-	{[]rune{0x2757}, "red exclamation mark", 8, 8, []string{"!", "exclamation", "mark", "punctuation", "red exclamation mark"}, false, 0},
-	{[]rune{0x3030, 0xfe0f}, "wavy dash", 8, 8, []string{"dash", "punctuation", "wavy"}, false, 0},
-	{[]rune{0x1f4b1}, "currency exchange", 8, 9, []string{"bank", "currency", "exchange", "money"}, false, 0},
-	{[]rune{0x1f4b2}, "heavy dollar sign", 8, 9, []string{"currency", "dollar", "heavy dollar sign", "money"}, false, 0},
-	{[]rune{0x2695, 0xfe0f}, "medical symbol", 8, 10, []string{"aesculapius", "medical symbol", "medicine", "staff"}, false, 0},
-	{[]rune{0x267b, 0xfe0f}, "recycling symbol", 8, 10, []string{"recycle", "recycling symbol"}, false, 0},
-	{[]rune{0x269c, 0xfe0f}, "fleur-de-lis", 8, 10, []string{"fleur-de-lis"}, false, 0},
-	{[]rune{0x1f531}, "trident emblem", 8, 10, []string{"anchor", "emblem", "ship", "tool", "trident"}, false, 0},
-	{[]rune{0x1f4db}, "name badge", 8, 10, []string{"badge", "name"}, false, 0},
-	{[]rune{0x1f530}, "Japanese symbol for beginner", 8, 10, []string{"beginner", "chevron", "Japanese", "Japanese symbol for beginner", "leaf"}, false, 0},
-	{[]rune{0x2b55}, "hollow red circle", 8, 10, []string{"circle", "hollow red circle", "large", "o", "red"}, false, 0},
-	{[]rune{0x2705}, "check mark button", 8, 10, []string{"✓", "button", "check", "mark"}, false, 0},
-	{[]rune{0x2611, 0xfe0f}, "check box with check", 8, 10, []string{"✓", "box", "check", "check box with check"}, false, 0},
-	{[]rune{0x2714, 0xfe0f}, "check mark", 8, 10, []string{"✓", "check", "mark"}, false, 0},
-	{[]rune{0x274c}, "cross mark", 8, 10, []string{"×", "cancel", "cross", "mark", "multiplication", "multiply", "x"}, false, 0},
-	{[]rune{0x274e}, "cross mark button", 8, 10, []string{"×", "cross mark button", "mark", "square", "x"}, false, 0},
-	{[]rune{0x27b0}, "curly loop", 8, 10, []string{"curl", "curly loop", "loop"}, false, 0},
-	{[]rune{0x27bf}, "double curly loop", 8, 10, []string{"curl", "double", "double curly loop", "loop"}, false, 0},
-	{[]rune{0x303d, 0xfe0f}, "part alternation mark", 8, 10, []string{"mark", "part", "part alternation mark"}, false, 0},
-	{[]rune{0x2733, 0xfe0f}, "eight-spoked asterisk", 8, 10, []string{"*", "asterisk", "eight-spoked asterisk"}, false, 0},
-	{[]rune{0x2734, 0xfe0f}, "eight-pointed star", 8, 10, []string{"*", "eight-pointed star", "star"}, false, 0},
-	{[]rune{0x2747, 0xfe0f}, "sparkle", 8, 10, []string{"*", "sparkle"}, false, 0},
-	{[]rune{0xa9, 0xfe0f}, "copyright", 8, 10, []string{"c", "copyright"}, false, 0},
-	{[]rune{0xae, 0xfe0f}, "registered", 8, 10, []string{"r", "registered"}, false, 0},
-	{[]rune{0x2122, 0xfe0f}, "trade mark", 8, 10, []string{"mark", "tm", "trade mark", "trademark"}, false, 0},
-	{[]rune{0x23, 0xfe0f, 0x20e3}, "keycap: #", 8, 11, []string(nil), false, 0},
-	{[]rune{0x2a, 0xfe0f, 0x20e3}, "keycap: *", 8, 11, []string(nil), false, 0},
-	{[]rune{0x30, 0xfe0f, 0x20e3}, "keycap: 0", 8, 11, []string(nil), false, 0},
-	{[]rune{0x31, 0xfe0f, 0x20e3}, "keycap: 1", 8, 11, []string(nil), false, 0},
-	{[]rune{0x32, 0xfe0f, 0x20e3}, "keycap: 2", 8, 11, []string(nil), false, 0},
-	{[]rune{0x33, 0xfe0f, 0x20e3}, "keycap: 3", 8, 11, []string(nil), false, 0},
-	{[]rune{0x34, 0xfe0f, 0x20e3}, "keycap: 4", 8, 11, []string(nil), false, 0},
-	{[]rune{0x35, 0xfe0f, 0x20e3}, "keycap: 5", 8, 11, []string(nil), false, 0},
-	{[]rune{0x36, 0xfe0f, 0x20e3}, "keycap: 6", 8, 11, []string(nil), false, 0},
-	{[]rune{0x37, 0xfe0f, 0x20e3}, "keycap: 7", 8, 11, []string(nil), false, 0},
-	{[]rune{0x38, 0xfe0f, 0x20e3}, "keycap: 8", 8, 11, []string(nil), false, 0},
-	{[]rune{0x39, 0xfe0f, 0x20e3}, "keycap: 9", 8, 11, []string(nil), false, 0},
-	{[]rune{0x1f51f}, "keycap: 10", 8, 11, []string(nil), false, 0},
-	{[]rune{0x1f520}, "input latin uppercase", 8, 12, []string{"ABCD", "input", "latin", "letters", "uppercase"}, false, 0},
-	{[]rune{0x1f521}, "input latin lowercase", 8, 12, []string{"abcd", "input", "latin", "letters", "lowercase"}, false, 0},
-	{[]rune{0x1f522}, "input numbers", 8, 12, []string{"1234", "input", "numbers"}, false, 0},
-	{[]rune{0x1f523}, "input symbols", 8, 12, []string{"〒♪&amp;%", "input", "input symbols"}, false, 0},
-	{[]rune{0x1f524}, "input latin letters", 8, 12, []string{"abc", "alphabet", "input", "latin", "letters"}, false, 0},
-	{[]rune{0x1f170, 0xfe0f}, "A button (blood type)", 8, 12, []string{"a", "A button (blood type)", "blood type"}, false, 0},
-	{[]rune{0x1f18e}, "AB button (blood type)", 8, 12, []string{"ab", "AB button (blood type)", "blood type"}, false, 0},
-	{[]rune{0x1f171, 0xfe0f}, "B button (blood type)", 8, 12, []string{"b", "B button (blood type)", "blood type"}, false, 0},
-	{[]rune{0x1f191}, "CL button", 8, 12, []string{"cl", "CL button"}, false, 0},
-	{[]rune{0x1f192}, "COOL button", 8, 12, []string{"cool", "COOL button"}, false, 0},
-	{[]rune{0x1f193}, "FREE button", 8, 12, []string{"free", "FREE button"}, false, 0},
-	{[]rune{0x2139, 0xfe0f}, "information", 8, 12, []string{"i", "information"}, false, 0},
-	{[]rune{0x1f194}, "ID button", 8, 12, []string{"id", "ID button", "identity"}, false, 0},
-	{[]rune{0x24c2, 0xfe0f}, "circled M", 8, 12, []string{"circle", "circled M", "m"}, false, 0},
-	{[]rune{0x1f195}, "NEW button", 8, 12, []string{"new", "NEW button"}, false, 0},
-	{[]rune{0x1f196}, "NG button", 8, 12, []string{"ng", "NG button"}, false, 0},
-	{[]rune{0x1f17e, 0xfe0f}, "O button (blood type)", 8, 12, []string{"blood type", "o", "O button (blood type)"}, false, 0},
-	{[]rune{0x1f197}, "OK button", 8, 12, []string{"OK", "OK button"}, false, 0},
-	{[]rune{0x1f17f, 0xfe0f}, "P button", 8, 12, []string{"P button", "parking"}, false, 0},
-	{[]rune{0x1f198}, "SOS button", 8, 12, []string{"help", "sos", "SOS button"}, false, 0},
-	{[]rune{0x1f199}, "UP! button", 8, 12, []string{"mark", "up", "UP! button"}, false, 0},
-	{[]rune{0x1f19a}, "VS button", 8, 12, []string{"versus", "vs", "VS button"}, false, 0},
-	{[]rune{0x1f201}, "Japanese “here” button", 8, 12, []string{"“here”", "Japanese", "Japanese “here” button", "katakana", "ココ"}, false, 0},
-	{[]rune{0x1f202, 0xfe0f}, "Japanese “service charge” button", 8, 12, []string{"“service charge”", "Japanese", "Japanese “service charge” button", "katakana", "サ"}, false, 0},
-	{[]rune{0x1f237, 0xfe0f}, "Japanese “monthly amount” button", 8, 12, []string{"“monthly amount”", "ideograph", "Japanese", "Japanese “monthly amount” button", "月"}, false, 0},
-	{[]rune{0x1f236}, "Japanese “not free of charge” button", 8, 12, []string{"“not free of charge”", "ideograph", "Japanese", "Japanese “not free of charge” button", "有"}, false, 0},
-	{[]rune{0x1f22f}, "Japanese “reserved” button", 8, 12, []string{"“reserved”", "ideograph", "Japanese", "Japanese “reserved” button", "指"}, false, 0},
-	{[]rune{0x1f250}, "Japanese “bargain” button", 8, 12, []string{"“bargain”", "ideograph", "Japanese", "Japanese “bargain” button", "得"}, false, 0},
-	{[]rune{0x1f239}, "Japanese “discount” button", 8, 12, []string{"“discount”", "ideograph", "Japanese", "Japanese “discount” button", "割"}, false, 0},
-	{[]rune{0x1f21a}, "Japanese “free of charge” button", 8, 12, []string{"“free of charge”", "ideograph", "Japanese", "Japanese “free of charge” button", "無"}, false, 0},
-	{[]rune{0x1f232}, "Japanese “prohibited” button", 8, 12, []string{"“prohibited”", "ideograph", "Japanese", "Japanese “prohibited” button", "禁"}, false, 0},
-	{[]rune{0x1f251}, "Japanese “acceptable” button", 8, 12, []string{"“acceptable”", "ideograph", "Japanese", "Japanese “acceptable” button", "可"}, false, 0},
-	{[]rune{0x1f238}, "Japanese “application” button", 8, 12, []string{"“application”", "ideograph", "Japanese", "Japanese “application” button", "申"}, false, 0},
-	{[]rune{0x1f234}, "Japanese “passing grade” button", 8, 12, []string{"“passing grade”", "ideograph", "Japanese", "Japanese “passing grade” button", "合"}, false, 0},
-	{[]rune{0x1f233}, "Japanese “vacancy” button", 8, 12, []string{"“vacancy”", "ideograph", "Japanese", "Japanese “vacancy” button", "空"}, false, 0},
-	{[]rune{0x3297, 0xfe0f}, "Japanese “congratulations” button", 8, 12, []string{"“congratulations”", "ideograph", "Japanese", "Japanese “congratulations” button", "祝"}, false, 0},
-	{[]rune{0x3299, 0xfe0f}, "Japanese “secret” button", 8, 12, []string{"“secret”", "ideograph", "Japanese", "Japanese “secret” button", "秘"}, false, 0},
-	{[]rune{0x1f23a}, "Japanese “open for business” button", 8, 12, []string{"“open for business”", "ideograph", "Japanese", "Japanese “open for business” button", "営"}, false, 0},
-	{[]rune{0x1f235}, "Japanese “no vacancy” button", 8, 12, []string{"“no vacancy”", "ideograph", "Japanese", "Japanese “no vacancy” button", "満"}, false, 0},
-	{[]rune{0x1f534}, "red circle", 8, 13, []string{"circle", "geometric", "red"}, false, 0},
-	{[]rune{0x1f7e0}, "orange circle", 8, 13, []string{"circle", "orange"}, false, 0},
-	{[]rune{0x1f7e1}, "yellow circle", 8, 13, []string{"circle", "yellow"}, false, 0},
-	{[]rune{0x1f7e2}, "green circle", 8, 13, []string{"circle", "green"}, false, 0},
-	{[]rune{0x1f535}, "blue circle", 8, 13, []string{"blue", "circle", "geometric"}, false, 0},
-	{[]rune{0x1f7e3}, "purple circle", 8, 13, []string{"circle", "purple"}, false, 0},
-	{[]rune{0x1f7e4}, "brown circle", 8, 13, []string{"brown", "circle"}, false, 0},
-	{[]rune{0x26ab}, "black circle", 8, 13, []string{"black circle", "circle", "geometric"}, false, 0},
-	{[]rune{0x26aa}, "white circle", 8, 13, []string{"circle", "geometric", "white circle"}, false, 0},
-	{[]rune{0x1f7e5}, "red square", 8, 13, []string{"red", "square"}, false, 0},
-	{[]rune{0x1f7e7}, "orange square", 8, 13, []string{"orange", "square"}, false, 0},
-	{[]rune{0x1f7e8}, "yellow square", 8, 13, []string{"square", "yellow"}, false, 0},
-	{[]rune{0x1f7e9}, "green square", 8, 13, []string{"green", "square"}, false, 0},
-	{[]rune{0x1f7e6}, "blue square", 8, 13, []string{"blue", "square"}, false, 0},
-	{[]rune{0x1f7ea}, "purple square", 8, 13, []string{"purple", "square"}, false, 0},
-	{[]rune{0x1f7eb}, "brown square", 8, 13, []string{"brown", "square"}, false, 0},
-	{[]rune{0x2b1b}, "black large square", 8, 13, []string{"black large square", "geometric", "square"}, false, 0},
-	{[]rune{0x2b1c}, "white large square", 8, 13, []string{"geometric", "square", "white large square"}, false, 0},
-	{[]rune{0x25fc, 0xfe0f}, "black medium square", 8, 13, []string{"black medium square", "geometric", "square"}, false, 0},
-	{[]rune{0x25fb, 0xfe0f}, "white medium square", 8, 13, []string{"geometric", "square", "white medium square"}, false, 0},
-	{[]rune{0x25fe}, "black medium-small square", 8, 13, []string{"black medium-small square", "geometric", "square"}, false, 0},
-	{[]rune{0x25fd}, "white medium-small square", 8, 13, []string{"geometric", "square", "white medium-small square"}, false, 0},
-	{[]rune{0x25aa, 0xfe0f}, "black small square", 8, 13, []string{"black small square", "geometric", "square"}, false, 0},
-	{[]rune{0x25ab, 0xfe0f}, "white small square", 8, 13, []string{"geometric", "square", "white small square"}, false, 0},
-	{[]rune{0x1f536}, "large orange diamond", 8, 13, []string{"diamond", "geometric", "large orange diamond", "orange"}, false, 0},
-	{[]rune{0x1f537}, "large blue diamond", 8, 13, []string{"blue", "diamond", "geometric", "large blue diamond"}, false, 0},
-	{[]rune{0x1f538}, "small orange diamond", 8, 13, []string{"diamond", "geometric", "orange", "small orange diamond"}, false, 0},
-	{[]rune{0x1f539}, "small blue diamond", 8, 13, []string{"blue", "diamond", "geometric", "small blue diamond"}, false, 0},
-	{[]rune{0x1f53a}, "red triangle pointed up", 8, 13, []string{"geometric", "red", "red triangle pointed up"}, false, 0},
-	{[]rune{0x1f53b}, "red triangle pointed down", 8, 13, []string{"down", "geometric", "red", "red triangle pointed down"}, false, 0},
-	{[]rune{0x1f4a0}, "diamond with a dot", 8, 13, []string{"comic", "diamond", "diamond with a dot", "geometric", "inside"}, false, 0},
-	{[]rune{0x1f518}, "radio button", 8, 13, []string{"button", "geometric", "radio"}, false, 0},
-	{[]rune{0x1f533}, "white square button", 8, 13, []string{"button", "geometric", "outlined", "square", "white square button"}, false, 0},
-	{[]rune{0x1f532}, "black square button", 8, 13, []string{"black square button", "button", "geometric", "square"}, false, 0},
-	{[]rune{0x1f3c1}, "chequered flag", 9, 0, []string{"checkered", "chequered", "chequered flag", "racing"}, false, 0},
-	{[]rune{0x1f6a9}, "triangular flag", 9, 0, []string{"post", "triangular flag"}, false, 0},
-	{[]rune{0x1f38c}, "crossed flags", 9, 0, []string{"celebration", "cross", "crossed", "crossed flags", "Japanese"}, false, 0},
-	{[]rune{0x1f3f4}, "black flag", 9, 0, []string{"black flag", "waving"}, false, 0},
-	{[]rune{0x1f3f3, 0xfe0f}, "white flag", 9, 0, []string{"waving", "white flag"}, false, 0},
-	{[]rune{0x1f3f3, 0xfe0f, 0x1f308}, "rainbow flag", 9, 0, []string{"pride", "rainbow", "rainbow flag"}, false, 0},
-	{[]rune{0x1f3f3, 0xfe0f, 0x26a7, 0xfe0f}, "transgender flag", 9, 0, []string{"flag", "light blue", "pink", "transgender", "white"}, false, 0},
-	{[]rune{0x1f3f4, 0x2620, 0xfe0f}, "pirate flag", 9, 0, []string{"Jolly Roger", "pirate", "pirate flag", "plunder", "treasure"}, false, 0},
-	{[]rune{0x1f1e6, 0x1f1e8}, "flag: Ascension Island", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1e9}, "flag: Andorra", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1ea}, "flag: United Arab Emirates", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1eb}, "flag: Afghanistan", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1ec}, "flag: Antigua & Barbuda", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1ee}, "flag: Anguilla", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1f1}, "flag: Albania", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1f2}, "flag: Armenia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1f4}, "flag: Angola", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1f6}, "flag: Antarctica", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1f7}, "flag: Argentina", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1f8}, "flag: American Samoa", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1f9}, "flag: Austria", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1fa}, "flag: Australia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1fc}, "flag: Aruba", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1fd}, "flag: Åland Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e6, 0x1f1ff}, "flag: Azerbaijan", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1e6}, "flag: Bosnia & Herzegovina", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1e7}, "flag: Barbados", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1e9}, "flag: Bangladesh", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1ea}, "flag: Belgium", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1eb}, "flag: Burkina Faso", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1ec}, "flag: Bulgaria", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1ed}, "flag: Bahrain", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1ee}, "flag: Burundi", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1ef}, "flag: Benin", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1f1}, "flag: St. Barthélemy", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1f2}, "flag: Bermuda", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1f3}, "flag: Brunei", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1f4}, "flag: Bolivia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1f6}, "flag: Caribbean Netherlands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1f7}, "flag: Brazil", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1f8}, "flag: Bahamas", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1f9}, "flag: Bhutan", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1fb}, "flag: Bouvet Island", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1fc}, "flag: Botswana", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1fe}, "flag: Belarus", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e7, 0x1f1ff}, "flag: Belize", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1e6}, "flag: Canada", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1e8}, "flag: Cocos (Keeling) Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1e9}, "flag: Congo - Kinshasa", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1eb}, "flag: Central African Republic", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1ec}, "flag: Congo - Brazzaville", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1ed}, "flag: Switzerland", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1ee}, "flag: Côte d’Ivoire", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1f0}, "flag: Cook Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1f1}, "flag: Chile", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1f2}, "flag: Cameroon", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1f3}, "flag: China", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1f4}, "flag: Colombia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1f5}, "flag: Clipperton Island", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1f7}, "flag: Costa Rica", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1fa}, "flag: Cuba", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1fb}, "flag: Cape Verde", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1fc}, "flag: Curaçao", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1fd}, "flag: Christmas Island", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1fe}, "flag: Cyprus", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e8, 0x1f1ff}, "flag: Czechia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e9, 0x1f1ea}, "flag: Germany", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e9, 0x1f1ec}, "flag: Diego Garcia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e9, 0x1f1ef}, "flag: Djibouti", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e9, 0x1f1f0}, "flag: Denmark", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e9, 0x1f1f2}, "flag: Dominica", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e9, 0x1f1f4}, "flag: Dominican Republic", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1e9, 0x1f1ff}, "flag: Algeria", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ea, 0x1f1e6}, "flag: Ceuta & Melilla", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ea, 0x1f1e8}, "flag: Ecuador", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ea, 0x1f1ea}, "flag: Estonia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ea, 0x1f1ec}, "flag: Egypt", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ea, 0x1f1ed}, "flag: Western Sahara", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ea, 0x1f1f7}, "flag: Eritrea", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ea, 0x1f1f8}, "flag: Spain", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ea, 0x1f1f9}, "flag: Ethiopia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ea, 0x1f1fa}, "flag: European Union", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1eb, 0x1f1ee}, "flag: Finland", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1eb, 0x1f1ef}, "flag: Fiji", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1eb, 0x1f1f0}, "flag: Falkland Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1eb, 0x1f1f2}, "flag: Micronesia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1eb, 0x1f1f4}, "flag: Faroe Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1eb, 0x1f1f7}, "flag: France", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1e6}, "flag: Gabon", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1e7}, "flag: United Kingdom", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1e9}, "flag: Grenada", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1ea}, "flag: Georgia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1eb}, "flag: French Guiana", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1ec}, "flag: Guernsey", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1ed}, "flag: Ghana", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1ee}, "flag: Gibraltar", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1f1}, "flag: Greenland", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1f2}, "flag: Gambia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1f3}, "flag: Guinea", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1f5}, "flag: Guadeloupe", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1f6}, "flag: Equatorial Guinea", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1f7}, "flag: Greece", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1f8}, "flag: South Georgia & South Sandwich Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1f9}, "flag: Guatemala", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1fa}, "flag: Guam", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1fc}, "flag: Guinea-Bissau", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ec, 0x1f1fe}, "flag: Guyana", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ed, 0x1f1f0}, "flag: Hong Kong SAR China", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ed, 0x1f1f2}, "flag: Heard & McDonald Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ed, 0x1f1f3}, "flag: Honduras", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ed, 0x1f1f7}, "flag: Croatia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ed, 0x1f1f9}, "flag: Haiti", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ed, 0x1f1fa}, "flag: Hungary", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ee, 0x1f1e8}, "flag: Canary Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ee, 0x1f1e9}, "flag: Indonesia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ee, 0x1f1ea}, "flag: Ireland", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ee, 0x1f1f1}, "flag: Israel", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ee, 0x1f1f2}, "flag: Isle of Man", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ee, 0x1f1f3}, "flag: India", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ee, 0x1f1f4}, "flag: British Indian Ocean Territory", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ee, 0x1f1f6}, "flag: Iraq", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ee, 0x1f1f7}, "flag: Iran", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ee, 0x1f1f8}, "flag: Iceland", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ee, 0x1f1f9}, "flag: Italy", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ef, 0x1f1ea}, "flag: Jersey", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ef, 0x1f1f2}, "flag: Jamaica", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ef, 0x1f1f4}, "flag: Jordan", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ef, 0x1f1f5}, "flag: Japan", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f0, 0x1f1ea}, "flag: Kenya", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f0, 0x1f1ec}, "flag: Kyrgyzstan", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f0, 0x1f1ed}, "flag: Cambodia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f0, 0x1f1ee}, "flag: Kiribati", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f0, 0x1f1f2}, "flag: Comoros", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f0, 0x1f1f3}, "flag: St. Kitts & Nevis", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f0, 0x1f1f5}, "flag: North Korea", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f0, 0x1f1f7}, "flag: South Korea", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f0, 0x1f1fc}, "flag: Kuwait", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f0, 0x1f1fe}, "flag: Cayman Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f0, 0x1f1ff}, "flag: Kazakhstan", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f1, 0x1f1e6}, "flag: Laos", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f1, 0x1f1e7}, "flag: Lebanon", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f1, 0x1f1e8}, "flag: St. Lucia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f1, 0x1f1ee}, "flag: Liechtenstein", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f1, 0x1f1f0}, "flag: Sri Lanka", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f1, 0x1f1f7}, "flag: Liberia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f1, 0x1f1f8}, "flag: Lesotho", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f1, 0x1f1f9}, "flag: Lithuania", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f1, 0x1f1fa}, "flag: Luxembourg", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f1, 0x1f1fb}, "flag: Latvia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f1, 0x1f1fe}, "flag: Libya", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1e6}, "flag: Morocco", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1e8}, "flag: Monaco", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1e9}, "flag: Moldova", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1ea}, "flag: Montenegro", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1eb}, "flag: St. Martin", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1ec}, "flag: Madagascar", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1ed}, "flag: Marshall Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1f0}, "flag: North Macedonia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1f1}, "flag: Mali", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1f2}, "flag: Myanmar (Burma)", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1f3}, "flag: Mongolia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1f4}, "flag: Macao SAR China", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1f5}, "flag: Northern Mariana Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1f6}, "flag: Martinique", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1f7}, "flag: Mauritania", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1f8}, "flag: Montserrat", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1f9}, "flag: Malta", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1fa}, "flag: Mauritius", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1fb}, "flag: Maldives", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1fc}, "flag: Malawi", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1fd}, "flag: Mexico", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1fe}, "flag: Malaysia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f2, 0x1f1ff}, "flag: Mozambique", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f3, 0x1f1e6}, "flag: Namibia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f3, 0x1f1e8}, "flag: New Caledonia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f3, 0x1f1ea}, "flag: Niger", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f3, 0x1f1eb}, "flag: Norfolk Island", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f3, 0x1f1ec}, "flag: Nigeria", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f3, 0x1f1ee}, "flag: Nicaragua", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f3, 0x1f1f1}, "flag: Netherlands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f3, 0x1f1f4}, "flag: Norway", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f3, 0x1f1f5}, "flag: Nepal", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f3, 0x1f1f7}, "flag: Nauru", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f3, 0x1f1fa}, "flag: Niue", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f3, 0x1f1ff}, "flag: New Zealand", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f4, 0x1f1f2}, "flag: Oman", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f5, 0x1f1e6}, "flag: Panama", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f5, 0x1f1ea}, "flag: Peru", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f5, 0x1f1eb}, "flag: French Polynesia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f5, 0x1f1ec}, "flag: Papua New Guinea", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f5, 0x1f1ed}, "flag: Philippines", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f5, 0x1f1f0}, "flag: Pakistan", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f5, 0x1f1f1}, "flag: Poland", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f5, 0x1f1f2}, "flag: St. Pierre & Miquelon", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f5, 0x1f1f3}, "flag: Pitcairn Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f5, 0x1f1f7}, "flag: Puerto Rico", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f5, 0x1f1f8}, "flag: Palestinian Territories", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f5, 0x1f1f9}, "flag: Portugal", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f5, 0x1f1fc}, "flag: Palau", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f5, 0x1f1fe}, "flag: Paraguay", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f6, 0x1f1e6}, "flag: Qatar", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f7, 0x1f1ea}, "flag: Réunion", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f7, 0x1f1f4}, "flag: Romania", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f7, 0x1f1f8}, "flag: Serbia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f7, 0x1f1fa}, "flag: Russia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f7, 0x1f1fc}, "flag: Rwanda", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1e6}, "flag: Saudi Arabia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1e7}, "flag: Solomon Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1e8}, "flag: Seychelles", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1e9}, "flag: Sudan", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1ea}, "flag: Sweden", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1ec}, "flag: Singapore", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1ed}, "flag: St. Helena", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1ee}, "flag: Slovenia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1ef}, "flag: Svalbard & Jan Mayen", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1f0}, "flag: Slovakia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1f1}, "flag: Sierra Leone", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1f2}, "flag: San Marino", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1f3}, "flag: Senegal", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1f4}, "flag: Somalia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1f7}, "flag: Suriname", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1f8}, "flag: South Sudan", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1f9}, "flag: São Tomé & Príncipe", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1fb}, "flag: El Salvador", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1fd}, "flag: Sint Maarten", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1fe}, "flag: Syria", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f8, 0x1f1ff}, "flag: Eswatini", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1e6}, "flag: Tristan da Cunha", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1e8}, "flag: Turks & Caicos Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1e9}, "flag: Chad", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1eb}, "flag: French Southern Territories", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1ec}, "flag: Togo", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1ed}, "flag: Thailand", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1ef}, "flag: Tajikistan", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1f0}, "flag: Tokelau", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1f1}, "flag: Timor-Leste", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1f2}, "flag: Turkmenistan", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1f3}, "flag: Tunisia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1f4}, "flag: Tonga", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1f7}, "flag: Turkey", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1f9}, "flag: Trinidad & Tobago", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1fb}, "flag: Tuvalu", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1fc}, "flag: Taiwan", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1f9, 0x1f1ff}, "flag: Tanzania", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fa, 0x1f1e6}, "flag: Ukraine", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fa, 0x1f1ec}, "flag: Uganda", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fa, 0x1f1f2}, "flag: U.S. Outlying Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fa, 0x1f1f3}, "flag: United Nations", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fa, 0x1f1f8}, "flag: United States", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fa, 0x1f1fe}, "flag: Uruguay", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fa, 0x1f1ff}, "flag: Uzbekistan", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fb, 0x1f1e6}, "flag: Vatican City", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fb, 0x1f1e8}, "flag: St. Vincent & Grenadines", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fb, 0x1f1ea}, "flag: Venezuela", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fb, 0x1f1ec}, "flag: British Virgin Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fb, 0x1f1ee}, "flag: U.S. Virgin Islands", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fb, 0x1f1f3}, "flag: Vietnam", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fb, 0x1f1fa}, "flag: Vanuatu", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fc, 0x1f1eb}, "flag: Wallis & Futuna", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fc, 0x1f1f8}, "flag: Samoa", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fd, 0x1f1f0}, "flag: Kosovo", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fe, 0x1f1ea}, "flag: Yemen", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1fe, 0x1f1f9}, "flag: Mayotte", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ff, 0x1f1e6}, "flag: South Africa", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ff, 0x1f1f2}, "flag: Zambia", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f1ff, 0x1f1fc}, "flag: Zimbabwe", 9, 1, []string(nil), false, 0},
-	{[]rune{0x1f3f4, 0xe0067, 0xe0062, 0xe0065, 0xe006e, 0xe0067, 0xe007f}, "flag: England", 9, 2, []string(nil), false, 0},
-	{[]rune{0x1f3f4, 0xe0067, 0xe0062, 0xe0073, 0xe0063, 0xe0074, 0xe007f}, "flag: Scotland", 9, 2, []string(nil), false, 0},
-	{[]rune{0x1f3f4, 0xe0067, 0xe0062, 0xe0077, 0xe006c, 0xe0073, 0xe007f}, "flag: Wales", 9, 2, []string(nil), false, 0},
+	{[]rune{0x1f600}, "grinning face", 0, 0, []string{"face", "grin", "grinning face"}, false, 0, "1.0", nil},
+	{[]rune{0x1f603}, "grinning face with big eyes", 0, 0, []string{"face", "grinning face with big eyes", "mouth", "open", "smile"}, false, 0, "0.6", nil},
+	{[]rune{0x1f604}, "grinning face with smiling eyes", 0, 0, []string{"eye", "face", "grinning face with smiling eyes", "mouth", "open", "smile"}, false, 0, "0.6", nil},
+	{[]rune{0x1f601}, "beaming face with smiling eyes", 0, 0, []string{"beaming face with smiling eyes", "eye", "face", "grin", "smile"}, false, 0, "0.6", nil},
+	{[]rune{0x1f606}, "grinning squinting face", 0, 0, []string{"face", "grinning squinting face", "laugh", "mouth", "satisfied", "smile"}, false, 0, "0.6", nil},
+	{[]rune{0x1f605}, "grinning face with sweat", 0, 0, []string{"cold", "face", "grinning face with sweat", "open", "smile", "sweat"}, false, 0, "0.6", nil},
+	{[]rune{0x1f923}, "rolling on the floor laughing", 0, 0, []string{"face", "floor", "laugh", "rofl", "rolling", "rolling on the floor laughing", "rotfl"}, false, 0, "3.0", nil},
+	{[]rune{0x1f602}, "face with tears of joy", 0, 0, []string{"face", "face with tears of joy", "joy", "laugh", "tear"}, false, 0, "0.6", nil},
+	{[]rune{0x1f642}, "slightly smiling face", 0, 0, []string{"face", "slightly smiling face", "smile"}, false, 0, "1.0", nil},
+	{[]rune{0x1f643}, "upside-down face", 0, 0, []string{"face", "upside-down"}, false, 0, "1.0", nil},
+	{[]rune{0x1fae0}, "melting face", 0, 0, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f609}, "winking face", 0, 0, []string{"face", "wink", "winking face"}, false, 0, "0.6", nil},
+	{[]rune{0x1f60a}, "smiling face with smiling eyes", 0, 0, []string{"blush", "eye", "face", "smile", "smiling face with smiling eyes"}, false, 0, "0.6", nil},
+	{[]rune{0x1f607}, "smiling face with halo", 0, 0, []string{"angel", "face", "fantasy", "halo", "innocent", "smiling face with halo"}, false, 0, "1.0", nil},
+	{[]rune{0x1f970}, "smiling face with hearts", 0, 1, []string{"adore", "crush", "hearts", "in love", "smiling face with hearts"}, false, 0, "11.0", nil},
+	{[]rune{0x1f60d}, "smiling face with heart-eyes", 0, 1, []string{"eye", "face", "love", "smile", "smiling face with heart-eyes"}, false, 0, "0.6", nil},
+	{[]rune{0x1f929}, "star-struck", 0, 1, []string{"eyes", "face", "grinning", "star", "star-struck"}, false, 0, "5.0", nil},
+	{[]rune{0x1f618}, "face blowing a kiss", 0, 1, []string{"face", "face blowing a kiss", "kiss"}, false, 0, "0.6", nil},
+	{[]rune{0x1f617}, "kissing face", 0, 1, []string{"face", "kiss", "kissing face"}, false, 0, "1.0", nil},
+	{[]rune{0x263a, 0xfe0f}, "smiling face", 0, 1, []string{"face", "outlined", "relaxed", "smile", "smiling face"}, false, 0, "0.6", nil},
+	{[]rune{0x1f61a}, "kissing face with closed eyes", 0, 1, []string{"closed", "eye", "face", "kiss", "kissing face with closed eyes"}, false, 0, "0.6", nil},
+	{[]rune{0x1f619}, "kissing face with smiling eyes", 0, 1, []string{"eye", "face", "kiss", "kissing face with smiling eyes", "smile"}, false, 0, "1.0", nil},
+	{[]rune{0x1f972}, "smiling face with tear", 0, 1, []string{"grateful", "proud", "relieved", "smiling", "smiling face with tear", "tear", "touched"}, false, 0, "13.0", nil},
+	{[]rune{0x1f60b}, "face savoring food", 0, 2, []string{"delicious", "face", "face savoring food", "savouring", "smile", "yum"}, false, 0, "0.6", nil},
+	{[]rune{0x1f61b}, "face with tongue", 0, 2, []string{"face", "face with tongue", "tongue"}, false, 0, "1.0", nil},
+	{[]rune{0x1f61c}, "winking face with tongue", 0, 2, []string{"eye", "face", "joke", "tongue", "wink", "winking face with tongue"}, false, 0, "0.6", nil},
+	{[]rune{0x1f92a}, "zany face", 0, 2, []string{"eye", "goofy", "large", "small", "zany face"}, false, 0, "5.0", nil},
+	{[]rune{0x1f61d}, "squinting face with tongue", 0, 2, []string{"eye", "face", "horrible", "squinting face with tongue", "taste", "tongue"}, false, 0, "0.6", nil},
+	{[]rune{0x1f911}, "money-mouth face", 0, 2, []string{"face", "money", "money-mouth face", "mouth"}, false, 0, "1.0", nil},
+	{[]rune{0x1f917}, "smiling face with open hands", 0, 3, []string{"face", "hug", "hugging"}, false, 0, "1.0", nil},
+	{[]rune{0x1f92d}, "face with hand over mouth", 0, 3, []string{"face with hand over mouth", "whoops"}, false, 0, "5.0", nil},
+	{[]rune{0x1fae2}, "face with open eyes and hand over mouth", 0, 3, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1fae3}, "face with peeking eye", 0, 3, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f92b}, "shushing face", 0, 3, []string{"quiet", "shush", "shushing face"}, false, 0, "5.0", nil},
+	{[]rune{0x1f914}, "thinking face", 0, 3, []string{"face", "thinking"}, false, 0, "1.0", nil},
+	{[]rune{0x1fae1}, "saluting face", 0, 3, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f910}, "zipper-mouth face", 0, 4, []string{"face", "mouth", "zipper", "zipper-mouth face"}, false, 0, "1.0", nil},
+	{[]rune{0x1f928}, "face with raised eyebrow", 0, 4, []string{"distrust", "face with raised eyebrow", "skeptic"}, false, 0, "5.0", nil},
+	{[]rune{0x1f610}, "neutral face", 0, 4, []string{"deadpan", "face", "meh", "neutral"}, false, 0, "0.7", nil},
+	{[]rune{0x1f611}, "expressionless face", 0, 4, []string{"expressionless", "face", "inexpressive", "meh", "unexpressive"}, false, 0, "1.0", nil},
+	{[]rune{0x1f636}, "face without mouth", 0, 4, []string{"face", "face without mouth", "mouth", "quiet", "silent"}, false, 0, "1.0", nil},
+	{[]rune{0x1fae5}, "dotted line face", 0, 4, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f636, 0x1f32b, 0xfe0f}, "face in clouds", 0, 4, []string{"absentminded", "face in clouds", "face in the fog", "head in clouds"}, false, 0, "13.1", nil},
+	{[]rune{0x1f60f}, "smirking face", 0, 4, []string{"face", "smirk", "smirking face"}, false, 0, "0.6", nil},
+	{[]rune{0x1f612}, "unamused face", 0, 4, []string{"face", "unamused", "unhappy"}, false, 0, "0.6", nil},
+	{[]rune{0x1f644}, "face with rolling eyes", 0, 4, []string{"eyeroll", "eyes", "face", "face with rolling eyes", "rolling"}, false, 0, "1.0", nil},
+	{[]rune{0x1f62c}, "grimacing face", 0, 4, []string{"face", "grimace", "grimacing face"}, false, 0, "1.0", nil},
+	{[]rune{0x1f62e, 0x1f4a8}, "face exhaling", 0, 4, []string{"exhale", "face exhaling", "gasp", "groan", "relief", "whisper", "whistle"}, false, 0, "13.1", nil},
+	{[]rune{0x1f925}, "lying face", 0, 4, []string{"face", "lie", "lying face", "pinocchio"}, false, 0, "3.0", nil},
+	{[]rune{0x1f60c}, "relieved face", 0, 5, []string{"face", "relieved"}, false, 0, "0.6", nil},
+	{[]rune{0x1f614}, "pensive face", 0, 5, []string{"dejected", "face", "pensive"}, false, 0, "0.6", nil},
+	{[]rune{0x1f62a}, "sleepy face", 0, 5, []string{"face", "sleep", "sleepy face"}, false, 0, "0.6", nil},
+	{[]rune{0x1f924}, "drooling face", 0, 5, []string{"drooling", "face"}, false, 0, "3.0", nil},
+	{[]rune{0x1f634}, "sleeping face", 0, 5, []string{"face", "sleep", "sleeping face", "zzz"}, false, 0, "1.0", nil},
+	{[]rune{0x1f637}, "face with medical mask", 0, 6, []string{"cold", "doctor", "face", "face with medical mask", "mask", "sick"}, false, 0, "0.6", nil},
+	{[]rune{0x1f912}, "face with thermometer", 0, 6, []string{"face", "face with thermometer", "ill", "sick", "thermometer"}, false, 0, "1.0", nil},
+	{[]rune{0x1f915}, "face with head-bandage", 0, 6, []string{"bandage", "face", "face with head-bandage", "hurt", "injury"}, false, 0, "1.0", nil},
+	{[]rune{0x1f922}, "nauseated face", 0, 6, []string{"face", "nauseated", "vomit"}, false, 0, "3.0", nil},
+	{[]rune{0x1f92e}, "face vomiting", 0, 6, []string{"face vomiting", "sick", "vomit", "puke"}, false, 0, "5.0", nil},
+	{[]rune{0x1f927}, "sneezing face", 0, 6, []string{"face", "gesundheit", "sneeze", "sneezing face"}, false, 0, "3.0", nil},
+	{[]rune{0x1f975}, "hot face", 0, 6, []string{"feverish", "heat stroke", "hot", "hot face", "red-faced", "sweating"}, false, 0, "11.0", nil},
+	{[]rune{0x1f976}, "cold face", 0, 6, []string{"blue-faced", "cold", "cold face", "freezing", "frostbite", "icicles"}, false, 0, "11.0", nil},
+	{[]rune{0x1f974}, "woozy face", 0, 6, []string{"dizzy", "intoxicated", "tipsy", "uneven eyes", "wavy mouth", "woozy face"}, false, 0, "11.0", nil},
+	{[]rune{0x1f635}, "face with crossed-out eyes", 0, 6, []string{"dead", "face", "knocked out", "knocked-out face"}, false, 0, "0.6", nil},
+	{[]rune{0x1f635, 0x1f4ab}, "face with spiral eyes", 0, 6, []string{"dizzy", "face with spiral eyes", "hypnotized", "spiral", "trouble", "whoa"}, false, 0, "13.1", nil},
+	{[]rune{0x1f92f}, "exploding head", 0, 6, []string{"exploding head", "mind blown", "shocked"}, false, 0, "5.0", nil},
+	{[]rune{0x1f920}, "cowboy hat face", 0, 7, []string{"cowboy", "cowgirl", "face", "hat"}, false, 0, "3.0", nil},
+	{[]rune{0x1f973}, "partying face", 0, 7, []string{"celebration", "hat", "horn", "party", "partying face"}, false, 0, "11.0", nil},
+	{[]rune{0x1f978}, "disguised face", 0, 7, []string{"disguise", "disguised face", "face", "glasses", "incognito", "nose"}, false, 0, "13.0", nil},
+	{[]rune{0x1f60e}, "smiling face with sunglasses", 0, 8, []string{"bright", "cool", "face", "smiling face with sunglasses", "sun", "sunglasses"}, false, 0, "1.0", nil},
+	{[]rune{0x1f913}, "nerd face", 0, 8, []string{"face", "geek", "nerd"}, false, 0, "1.0", nil},
+	{[]rune{0x1f9d0}, "face with monocle", 0, 8, []string{"face with monocle", "stuffy"}, false, 0, "5.0", nil},
+	{[]rune{0x1f615}, "confused face", 0, 9, []string{"confused", "face", "meh"}, false, 0, "1.0", nil},
+	{[]rune{0x1fae4}, "face with diagonal mouth", 0, 9, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f61f}, "worried face", 0, 9, []string{"face", "worried"}, false, 0, "1.0", nil},
+	{[]rune{0x1f641}, "slightly frowning face", 0, 9, []string{"face", "frown", "slightly frowning face"}, false, 0, "1.0", nil},
+	{[]rune{0x2639, 0xfe0f}, "frowning face", 0, 9, []string{"face", "frown", "frowning face"}, false, 0, "0.7", nil},
+	{[]rune{0x1f62e}, "face with open mouth", 0, 9, []string{"face", "face with open mouth", "mouth", "open", "sympathy"}, false, 0, "1.0", nil},
+	{[]rune{0x1f62f}, "hushed face", 0, 9, []string{"face", "hushed", "stunned", "surprised"}, false, 0, "1.0", nil},
+	{[]rune{0x1f632}, "astonished face", 0, 9, []string{"astonished", "face", "shocked", "totally"}, false, 0, "0.6", nil},
+	{[]rune{0x1f633}, "flushed face", 0, 9, []string{"dazed", "face", "flushed"}, false, 0, "0.6", nil},
+	{[]rune{0x1f97a}, "pleading face", 0, 9, []string{"begging", "mercy", "pleading face", "puppy eyes"}, false, 0, "11.0", nil},
+	{[]rune{0x1f979}, "face holding back tears", 0, 9, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f626}, "frowning face with open mouth", 0, 9, []string{"face", "frown", "frowning face with open mouth", "mouth", "open"}, false, 0, "1.0", nil},
+	{[]rune{0x1f627}, "anguished face", 0, 9, []string{"anguished", "face"}, false, 0, "1.0", nil},
+	{[]rune{0x1f628}, "fearful face", 0, 9, []string{"face", "fear", "fearful", "scared"}, false, 0, "0.6", nil},
+	{[]rune{0x1f630}, "anxious face with sweat", 0, 9, []string{"anxious face with sweat", "blue", "cold", "face", "rushed", "sweat"}, false, 0, "0.6", nil},
+	{[]rune{0x1f625}, "sad but relieved face", 0, 9, []string{"disappointed", "face", "relieved", "sad but relieved face", "whew"}, false, 0, "0.6", nil},
+	{[]rune{0x1f622}, "crying face", 0, 9, []string{"cry", "crying face", "face", "sad", "tear"}, false, 0, "0.6", nil},
+	{[]rune{0x1f62d}, "loudly crying face", 0, 9, []string{"cry", "face", "loudly crying face", "sad", "sob", "tear"}, false, 0, "0.6", nil},
+	{[]rune{0x1f631}, "face screaming in fear", 0, 9, []string{"face", "face screaming in fear", "fear", "munch", "scared", "scream"}, false, 0, "0.6", nil},
+	{[]rune{0x1f616}, "confounded face", 0, 9, []string{"confounded", "face"}, false, 0, "0.6", nil},
+	{[]rune{0x1f623}, "persevering face", 0, 9, []string{"face", "persevere", "persevering face"}, false, 0, "0.6", nil},
+	{[]rune{0x1f61e}, "disappointed face", 0, 9, []string{"disappointed", "face"}, false, 0, "0.6", nil},
+	{[]rune{0x1f613}, "downcast face with sweat", 0, 9, []string{"cold", "downcast face with sweat", "face", "sweat"}, false, 0, "0.6", nil},
+	{[]rune{0x1f629}, "weary face", 0, 9, []string{"face", "tired", "weary"}, false, 0, "0.6", nil},
+	{[]rune{0x1f62b}, "tired face", 0, 9, []string{"face", "tired"}, false, 0, "0.6", nil},
+	{[]rune{0x1f971}, "yawning face", 0, 9, []string{"bored", "tired", "yawn", "yawning face"}, false, 0, "12.0", nil},
+	{[]rune{0x1f624}, "face with steam from nose", 0, 10, []string{"face", "face with steam from nose", "triumph", "won"}, false, 0, "0.6", nil},
+	{[]rune{0x1f621}, "enraged face", 0, 10, []string{"angry", "face", "mad", "pouting", "rage", "red"}, false, 0, "0.6", nil},
+	{[]rune{0x1f620}, "angry face", 0, 10, []string{"angry", "face", "mad", "anger"}, false, 0, "0.6", nil},
+	{[]rune{0x1f92c}, "face with symbols on mouth", 0, 10, []string{"face with symbols on mouth", "swearing"}, false, 0, "5.0", nil},
+	{[]rune{0x1f608}, "smiling face with horns", 0, 10, []string{"face", "fairy tale", "fantasy", "horns", "smile", "smiling face with horns"}, false, 0, "1.0", nil},
+	{[]rune{0x1f47f}, "angry face with horns", 0, 10, []string{"angry face with horns", "demon", "devil", "face", "fantasy", "imp"}, false, 0, "0.6", nil},
+	{[]rune{0x1f480}, "skull", 0, 10, []string{"death", "face", "fairy tale", "monster", "skull"}, false, 0, "0.6", nil},
+	{[]rune{0x2620, 0xfe0f}, "skull and crossbones", 0, 10, []string{"crossbones", "death", "face", "monster", "skull", "skull and crossbones"}, false, 0, "1.0", nil},
+	{[]rune{0x1f4a9}, "pile of poo", 0, 11, []string{"dung", "face", "monster", "pile of poo", "poo", "poop"}, false, 0, "0.6", nil},
+	{[]rune{0x1f921}, "clown face", 0, 11, []string{"clown", "face"}, false, 0, "3.0", nil},
+	{[]rune{0x1f479}, "ogre", 0, 11, []string{"creature", "face", "fairy tale", "fantasy", "monster", "ogre"}, false, 0, "0.6", nil},
+	{[]rune{0x1f47a}, "goblin", 0, 11, []string{"creature", "face", "fairy tale", "fantasy", "goblin", "monster"}, false, 0, "0.6", nil},
+	{[]rune{0x1f47b}, "ghost", 0, 11, []string{"creature", "face", "fairy tale", "fantasy", "ghost", "monster"}, false, 0, "0.6", nil},
+	{[]rune{0x1f47d}, "alien", 0, 11, []string{"alien", "creature", "extraterrestrial", "face", "fantasy", "ufo"}, false, 0, "0.6", nil},
+	{[]rune{0x1f47e}, "alien monster", 0, 11, []string{"alien", "creature", "extraterrestrial", "face", "monster", "ufo"}, false, 0, "0.6", nil},
+	{[]rune{0x1f916}, "robot", 0, 11, []string{"face", "monster", "robot"}, false, 0, "1.0", nil},
+	{[]rune{0x1f63a}, "grinning cat", 0, 12, []string{"cat", "face", "grinning", "mouth", "open", "smile"}, false, 0, "0.6", nil},
+	{[]rune{0x1f638}, "grinning cat with smiling eyes", 0, 12, []string{"cat", "eye", "face", "grin", "grinning cat with smiling eyes", "smile"}, false, 0, "0.6", nil},
+	{[]rune{0x1f639}, "cat with tears of joy", 0, 12, []string{"cat", "cat with tears of joy", "face", "joy", "tear"}, false, 0, "0.6", nil},
+	{[]rune{0x1f63b}, "smiling cat with heart-eyes", 0, 12, []string{"cat", "eye", "face", "heart", "love", "smile", "smiling cat with heart-eyes"}, false, 0, "0.6", nil},
+	{[]rune{0x1f63c}, "cat with wry smile", 0, 12, []string{"cat", "cat with wry smile", "face", "ironic", "smile", "wry"}, false, 0, "0.6", nil},
+	{[]rune{0x1f63d}, "kissing cat", 0, 12, []string{"cat", "eye", "face", "kiss", "kissing cat"}, false, 0, "0.6", nil},
+	{[]rune{0x1f640}, "weary cat", 0, 12, []string{"cat", "face", "oh", "surprised", "weary"}, false, 0, "0.6", nil},
+	{[]rune{0x1f63f}, "crying cat", 0, 12, []string{"cat", "cry", "crying cat", "face", "sad", "tear"}, false, 0, "0.6", nil},
+	{[]rune{0x1f63e}, "pouting cat", 0, 12, []string{"cat", "face", "pouting"}, false, 0, "0.6", nil},
+	{[]rune{0x1f648}, "see-no-evil monkey", 0, 13, []string{"evil", "face", "forbidden", "monkey", "see", "see-no-evil monkey"}, false, 0, "0.6", nil},
+	{[]rune{0x1f649}, "hear-no-evil monkey", 0, 13, []string{"evil", "face", "forbidden", "hear", "hear-no-evil monkey", "monkey"}, false, 0, "0.6", nil},
+	{[]rune{0x1f64a}, "speak-no-evil monkey", 0, 13, []string{"evil", "face", "forbidden", "monkey", "speak", "speak-no-evil monkey"}, false, 0, "0.6", nil},
+	{[]rune{0x1f48c}, "love letter", 0, 14, []string{"heart", "letter", "love", "mail"}, false, 0, "0.6", nil},
+	{[]rune{0x1f498}, "heart with arrow", 0, 14, []string{"arrow", "cupid", "heart with arrow"}, false, 0, "0.6", nil},
+	{[]rune{0x1f49d}, "heart with ribbon", 0, 14, []string{"heart with ribbon", "ribbon", "valentine"}, false, 0, "0.6", nil},
+	{[]rune{0x1f496}, "sparkling heart", 0, 14, []string{"excited", "sparkle", "sparkling heart"}, false, 0, "0.6", nil},
+	{[]rune{0x1f497}, "growing heart", 0, 14, []string{"excited", "growing", "growing heart", "nervous", "pulse"}, false, 0, "0.6", nil},
+	{[]rune{0x1f493}, "beating heart", 0, 14, []string{"beating", "beating heart", "heartbeat", "pulsating"}, false, 0, "0.6", nil},
+	{[]rune{0x1f49e}, "revolving hearts", 0, 14, []string{"revolving", "revolving hearts"}, false, 0, "0.6", nil},
+	{[]rune{0x1f495}, "two hearts", 0, 14, []string{"love", "two hearts"}, false, 0, "0.6", nil},
+	{[]rune{0x1f49f}, "heart decoration", 0, 14, []string{"heart", "heart decoration"}, false, 0, "0.6", nil},
+	{[]rune{0x2763, 0xfe0f}, "heart exclamation", 0, 14, []string{"exclamation", "heart exclamation", "mark", "punctuation"}, false, 0, "1.0", nil},
+	{[]rune{0x1f494}, "broken heart", 0, 14, []string{"break", "broken", "broken heart"}, false, 0, "0.6", nil},
+	{[]rune{0x2764, 0xfe0f, 0x1f525}, "heart on fire", 0, 14, []string{"burn", "heart", "heart on fire", "love", "lust", "sacred heart"}, false, 0, "13.1", nil},
+	{[]rune{0x2764, 0xfe0f, 0x1fa79}, "mending heart", 0, 14, []string{"healthier", "improving", "mending", "mending heart", "recovering", "recuperating", "well"}, false, 0, "13.1", nil},
+	{[]rune{0x2764, 0xfe0f}, "red heart", 0, 14, []string{"heart", "red heart"}, false, 0, "0.6", nil},
+	{[]rune{0x1f9e1}, "orange heart", 0, 14, []string{"orange", "orange heart"}, false, 0, "5.0", nil},
+	{[]rune{0x1f49b}, "yellow heart", 0, 14, []string{"yellow", "yellow heart"}, false, 0, "0.6", nil},
+	{[]rune{0x1f49a}, "green heart", 0, 14, []string{"green", "green heart"}, false, 0, "0.6", nil},
+	{[]rune{0x1f499}, "blue heart", 0, 14, []string{"blue", "blue heart"}, false, 0, "0.6", nil},
+	{[]rune{0x1f49c}, "purple heart", 0, 14, []string{"purple", "purple heart"}, false, 0, "0.6", nil},
+	{[]rune{0x1f90e}, "brown heart", 0, 14, []string{"brown", "heart"}, false, 0, "12.0", nil},
+	{[]rune{0x1f5a4}, "black heart", 0, 14, []string{"black", "black heart", "evil", "wicked"}, false, 0, "3.0", nil},
+	{[]rune{0x1f90d}, "white heart", 0, 14, []string{"heart", "white"}, false, 0, "12.0", nil},
+	{[]rune{0x1f48b}, "kiss mark", 0, 15, []string{"kiss", "kiss mark", "lips"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4af}, "hundred points", 0, 15, []string{"100", "full", "hundred", "hundred points", "score"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4a2}, "anger symbol", 0, 15, []string{"anger symbol", "angry", "comic", "mad"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4a5}, "collision", 0, 15, []string{"boom", "collision", "comic"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4ab}, "dizzy", 0, 15, []string{"comic", "dizzy", "star"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4a6}, "sweat droplets", 0, 15, []string{"comic", "splashing", "sweat", "sweat droplets"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4a8}, "dashing away", 0, 15, []string{"comic", "dash", "dashing away", "running"}, false, 0, "0.6", nil},
+	{[]rune{0x1f573, 0xfe0f}, "hole", 0, 15, []string{"hole"}, false, 0, "0.7", nil},
+	{[]rune{0x1f4ac}, "speech balloon", 0, 15, []string{"balloon", "bubble", "comic", "dialog", "speech"}, false, 0, "0.6", nil},
+	{[]rune{0x1f441, 0xfe0f, 0x1f5e8, 0xfe0f}, "eye in speech bubble", 0, 15, []string{"eye", "eye in speech bubble", "speech bubble", "witness"}, false, 0, "2.0", nil},
+	{[]rune{0x1f5e8, 0xfe0f}, "left speech bubble", 0, 15, []string{"dialog", "left speech bubble", "speech"}, false, 0, "2.0", nil},
+	{[]rune{0x1f5ef, 0xfe0f}, "right anger bubble", 0, 15, []string{"angry", "balloon", "bubble", "mad", "right anger bubble"}, false, 0, "0.7", nil},
+	{[]rune{0x1f4ad}, "thought balloon", 0, 15, []string{"balloon", "bubble", "comic", "thought"}, false, 0, "1.0", nil},
+	{[]rune{0x1f4a4}, "ZZZ", 0, 15, []string{"comic", "sleep", "zzz"}, false, 0, "0.6", nil},
+	{[]rune{0x1f44b}, "waving hand", 1, 0, []string{"hand", "wave", "waving"}, true, 0, "0.6", nil},
+	{[]rune{0x1f91a}, "raised back of hand", 1, 0, []string{"backhand", "raised", "raised back of hand"}, true, 0, "3.0", nil},
+	{[]rune{0x1f590, 0xfe0f}, "hand with fingers splayed", 1, 0, []string{"finger", "hand", "hand with fingers splayed", "splayed"}, true, 0, "0.7", nil},
+	{[]rune{0x270b}, "raised hand", 1, 0, []string{"hand", "high 5", "high five", "raised hand"}, true, 0, "0.6", nil},
+	{[]rune{0x1f596}, "vulcan salute", 1, 0, []string{"finger", "hand", "spock", "vulcan", "vulcan salute"}, true, 0, "1.0", nil},
+	{[]rune{0x1faf1}, "rightwards hand", 1, 0, []string(nil), true, 0, "14.0", nil},
+	{[]rune{0x1faf2}, "leftwards hand", 1, 0, []string(nil), true, 0, "14.0", nil},
+	{[]rune{0x1faf3}, "palm down hand", 1, 0, []string(nil), true, 0, "14.0", nil},
+	{[]rune{0x1faf4}, "palm up hand", 1, 0, []string(nil), true, 0, "14.0", nil},
+	{[]rune{0x1f44c}, "OK hand", 1, 1, []string{"hand", "OK"}, true, 0, "0.6", nil},
+	{[]rune{0x1f90c}, "pinched fingers", 1, 1, []string{"fingers", "hand gesture", "interrogation", "pinched", "sarcastic"}, true, 0, "13.0", nil},
+	{[]rune{0x1f90f}, "pinching hand", 1, 1, []string{"pinching hand", "small amount"}, true, 0, "12.0", nil},
+	{[]rune{0x270c, 0xfe0f}, "victory hand", 1, 1, []string{"hand", "v", "victory"}, true, 0, "0.6", nil},
+	{[]rune{0x1f91e}, "crossed fingers", 1, 1, []string{"cross", "crossed fingers", "finger", "hand", "luck"}, true, 0, "3.0", nil},
+	{[]rune{0x1faf0}, "hand with index finger and thumb crossed", 1, 1, []string(nil), true, 0, "14.0", nil},
+	{[]rune{0x1f91f}, "love-you gesture", 1, 1, []string{"hand", "ILY", "love-you gesture"}, true, 0, "5.0", nil},
+	{[]rune{0x1f918}, "sign of the horns", 1, 1, []string{"finger", "hand", "horns", "rock-on", "sign of the horns"}, true, 0, "1.0", nil},
+	{[]rune{0x1f919}, "call me hand", 1, 1, []string{"call", "call me hand", "hand"}, true, 0, "3.0", nil},
+	{[]rune{0x1f448}, "backhand index pointing left", 1, 2, []string{"backhand", "backhand index pointing left", "finger", "hand", "index", "point"}, true, 0, "0.6", nil},
+	{[]rune{0x1f449}, "backhand index pointing right", 1, 2, []string{"backhand", "backhand index pointing right", "finger", "hand", "index", "point"}, true, 0, "0.6", nil},
+	{[]rune{0x1f446}, "backhand index pointing up", 1, 2, []string{"backhand", "backhand index pointing up", "finger", "hand", "point", "up"}, true, 0, "0.6", nil},
+	{[]rune{0x1f595}, "middle finger", 1, 2, []string{"finger", "hand", "middle finger"}, true, 0, "1.0", nil},
+	{[]rune{0x1f447}, "backhand index pointing down", 1, 2, []string{"backhand", "backhand index pointing down", "down", "finger", "hand", "point"}, true, 0, "0.6", nil},
+	{[]rune{0x261d, 0xfe0f}, "index pointing up", 1, 2, []string{"finger", "hand", "index", "index pointing up", "point", "up"}, true, 0, "0.6", nil},
+	{[]rune{0x1faf5}, "index pointing at the viewer", 1, 2, []string(nil), true, 0, "14.0", nil},
+	{[]rune{0x1f44d}, "thumbs up", 1, 3, []string{"+1", "hand", "thumb", "thumbs up", "up"}, true, 0, "0.6", nil},
+	{[]rune{0x1f44e}, "thumbs down", 1, 3, []string{"-1", "down", "hand", "thumb", "thumbs down"}, true, 0, "0.6", nil},
+	{[]rune{0x270a}, "raised fist", 1, 3, []string{"clenched", "fist", "hand", "punch", "raised fist"}, true, 0, "0.6", nil},
+	{[]rune{0x1f44a}, "oncoming fist", 1, 3, []string{"clenched", "fist", "hand", "oncoming fist", "punch"}, true, 0, "0.6", nil},
+	{[]rune{0x1f91b}, "left-facing fist", 1, 3, []string{"fist", "left-facing fist", "leftwards"}, true, 0, "3.0", nil},
+	{[]rune{0x1f91c}, "right-facing fist", 1, 3, []string{"fist", "right-facing fist", "rightwards"}, true, 0, "3.0", nil},
+	{[]rune{0x1f44f}, "clapping hands", 1, 4, []string{"clap", "clapping hands", "hand"}, true, 0, "0.6", nil},
+	{[]rune{0x1f64c}, "raising hands", 1, 4, []string{"celebration", "gesture", "hand", "hooray", "raised", "raising hands"}, true, 0, "0.6", nil},
+	{[]rune{0x1faf6}, "heart hands", 1, 4, []string(nil), true, 0, "14.0", nil},
+	{[]rune{0x1f450}, "open hands", 1, 4, []string{"hand", "open", "open hands"}, true, 0, "0.6", nil},
+	{[]rune{0x1f932}, "palms up together", 1, 4, []string{"palms up together", "prayer"}, true, 0, "5.0", nil},
+	{[]rune{0x1f91d}, "handshake", 1, 4, []string{"agreement", "hand", "handshake", "meeting", "shake"}, true, 0, "3.0", []rune{0x1faf1, 0x1faf2}},
+	{[]rune{0x1f64f}, "folded hands", 1, 4, []string{"ask", "folded hands", "hand", "high 5", "high five", "please", "pray", "thanks"}, true, 0, "0.6", nil},
+	{[]rune{0x270d, 0xfe0f}, "writing hand", 1, 5, []string{"hand", "write", "writing hand"}, true, 0, "0.7", nil},
+	{[]rune{0x1f485}, "nail polish", 1, 5, []string{"care", "cosmetics", "manicure", "nail", "polish"}, true, 0, "0.6", nil},
+	{[]rune{0x1f933}, "selfie", 1, 5, []string{"camera", "phone", "selfie"}, true, 0, "3.0", nil},
+	{[]rune{0x1f4aa}, "flexed biceps", 1, 6, []string{"biceps", "comic", "flex", "flexed biceps", "muscle"}, true, 0, "0.6", nil},
+	{[]rune{0x1f9be}, "mechanical arm", 1, 6, []string{"accessibility", "mechanical arm", "prosthetic"}, false, 0, "12.0", nil},
+	{[]rune{0x1f9bf}, "mechanical leg", 1, 6, []string{"accessibility", "mechanical leg", "prosthetic"}, false, 0, "12.0", nil},
+	{[]rune{0x1f9b5}, "leg", 1, 6, []string{"kick", "leg", "limb"}, true, 0, "11.0", nil},
+	{[]rune{0x1f9b6}, "foot", 1, 6, []string{"foot", "kick", "stomp"}, true, 0, "11.0", nil},
+	{[]rune{0x1f442}, "ear", 1, 6, []string{"body", "ear"}, true, 0, "0.6", nil},
+	{[]rune{0x1f9bb}, "ear with hearing aid", 1, 6, []string{"accessibility", "ear with hearing aid", "hard of hearing"}, true, 0, "12.0", nil},
+	{[]rune{0x1f443}, "nose", 1, 6, []string{"body", "nose"}, true, 0, "0.6", nil},
+	{[]rune{0x1f9e0}, "brain", 1, 6, []string{"brain", "intelligent"}, false, 0, "5.0", nil},
+	{[]rune{0x1fac0}, "anatomical heart", 1, 6, []string{"anatomical", "cardiology", "heart", "organ", "pulse"}, false, 0, "13.0", nil},
+	{[]rune{0x1fac1}, "lungs", 1, 6, []string{"breath", "exhalation", "inhalation", "lungs", "organ", "respiration"}, false, 0, "13.0", nil},
+	{[]rune{0x1f9b7}, "tooth", 1, 6, []string{"dentist", "tooth"}, false, 0, "11.0", nil},
+	{[]rune{0x1f9b4}, "bone", 1, 6, []string{"bone", "skeleton"}, false, 0, "11.0", nil},
+	{[]rune{0x1f440}, "eyes", 1, 6, []string{"eye", "eyes", "face"}, false, 0, "0.6", nil},
+	{[]rune{0x1f441, 0xfe0f}, "eye", 1, 6, []string{"body", "eye"}, false, 0, "0.7", nil},
+	{[]rune{0x1f445}, "tongue", 1, 6, []string{"body", "tongue"}, false, 0, "0.6", nil},
+	{[]rune{0x1f444}, "mouth", 1, 6, []string{"lips", "mouth"}, false, 0, "0.6", nil},
+	{[]rune{0x1fae6}, "biting lip", 1, 6, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f476}, "baby", 1, 7, []string{"baby", "young"}, true, 0, "0.6", nil},
+	{[]rune{0x1f9d2}, "child", 1, 7, []string{"child", "gender-neutral", "unspecified gender", "young"}, true, 0, "5.0", nil},
+	{[]rune{0x1f466}, "boy", 1, 7, []string{"boy", "young"}, true, 0, "0.6", nil},
+	{[]rune{0x1f467}, "girl", 1, 7, []string{"girl", "Virgo", "young", "zodiac"}, true, 0, "0.6", nil},
+	{[]rune{0x1f9d1}, "person", 1, 7, []string{"adult", "gender-neutral", "person", "unspecified gender"}, true, 0, "5.0", nil},
+	{[]rune{0x1f471}, "person: blond hair", 1, 7, []string{"blond", "blond-haired person", "hair", "person: blond hair"}, true, 1, "0.6", nil},
+	{[]rune{0x1f468}, "man", 1, 7, []string{"adult", "man"}, true, 0, "0.6", nil},
+	{[]rune{0x1f9d4}, "person: beard", 1, 7, []string{"beard", "person", "person: beard"}, true, 1, "5.0", nil},
+	{[]rune{0x1f468, 0x1f9b0}, "man: red hair", 1, 7, []string(nil), true, 0, "11.0", nil},
+	{[]rune{0x1f468, 0x1f9b1}, "man: curly hair", 1, 7, []string(nil), true, 0, "11.0", nil},
+	{[]rune{0x1f468, 0x1f9b3}, "man: white hair", 1, 7, []string(nil), true, 0, "11.0", nil},
+	{[]rune{0x1f468, 0x1f9b2}, "man: bald", 1, 7, []string(nil), true, 0, "11.0", nil},
+	{[]rune{0x1f469}, "woman", 1, 7, []string{"adult", "woman"}, true, 0, "0.6", nil},
+	{[]rune{0x1f469, 0x1f9b0}, "woman: red hair", 1, 7, []string(nil), true, 0, "11.0", nil},
+	{[]rune{0x1f9d1, 0x1f9b0}, "person: red hair", 1, 7, []string(nil), true, 0, "12.1", nil},
+	{[]rune{0x1f469, 0x1f9b1}, "woman: curly hair", 1, 7, []string(nil), true, 0, "11.0", nil},
+	{[]rune{0x1f9d1, 0x1f9b1}, "person: curly hair", 1, 7, []string(nil), true, 0, "12.1", nil},
+	{[]rune{0x1f469, 0x1f9b3}, "woman: white hair", 1, 7, []string(nil), true, 0, "11.0", nil},
+	{[]rune{0x1f9d1, 0x1f9b3}, "person: white hair", 1, 7, []string(nil), true, 0, "12.1", nil},
+	{[]rune{0x1f469, 0x1f9b2}, "woman: bald", 1, 7, []string(nil), true, 0, "11.0", nil},
+	{[]rune{0x1f9d1, 0x1f9b2}, "person: bald", 1, 7, []string(nil), true, 0, "12.1", nil},
+	{[]rune{0x1f9d3}, "older person", 1, 7, []string{"adult", "gender-neutral", "old", "older person", "unspecified gender"}, true, 0, "5.0", nil},
+	{[]rune{0x1f474}, "old man", 1, 7, []string{"adult", "man", "old"}, true, 0, "0.6", nil},
+	{[]rune{0x1f475}, "old woman", 1, 7, []string{"adult", "old", "woman"}, true, 0, "0.6", nil},
+	{[]rune{0x1f64d}, "person frowning", 1, 8, []string{"frown", "gesture", "person frowning"}, true, 1, "0.6", nil},
+	{[]rune{0x1f64e}, "person pouting", 1, 8, []string{"gesture", "person pouting", "pouting"}, true, 1, "0.6", nil},
+	{[]rune{0x1f645}, "person gesturing NO", 1, 8, []string{"forbidden", "gesture", "hand", "person gesturing NO", "prohibited"}, true, 1, "0.6", nil},
+	{[]rune{0x1f646}, "person gesturing OK", 1, 8, []string{"gesture", "hand", "OK", "person gesturing OK"}, true, 1, "0.6", nil},
+	{[]rune{0x1f481}, "person tipping hand", 1, 8, []string{"hand", "help", "information", "person tipping hand", "sassy", "tipping"}, true, 1, "0.6", nil},
+	{[]rune{0x1f64b}, "person raising hand", 1, 8, []string{"gesture", "hand", "happy", "person raising hand", "raised"}, true, 1, "0.6", nil},
+	{[]rune{0x1f9cf}, "deaf person", 1, 8, []string{"accessibility", "deaf", "deaf person", "ear", "hear"}, true, 1, "12.0", nil},
+	{[]rune{0x1f647}, "person bowing", 1, 8, []string{"apology", "bow", "gesture", "person bowing", "sorry"}, true, 1, "0.6", nil},
+	{[]rune{0x1f926}, "person facepalming", 1, 8, []string{"disbelief", "exasperation", "face", "palm", "person facepalming"}, true, 1, "3.0", nil},
+	{[]rune{0x1f937}, "person shrugging", 1, 8, []string{"doubt", "ignorance", "indifference", "person shrugging", "shrug"}, true, 1, "3.0", nil},
+	{[]rune{0x1f9d1, 0x2695, 0xfe0f}, "health worker", 1, 9, []string{"doctor", "health worker", "healthcare", "nurse", "therapist"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x1f393}, "student", 1, 9, []string{"graduate", "student"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x1f3eb}, "teacher", 1, 9, []string{"instructor", "professor", "teacher"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x2696, 0xfe0f}, "judge", 1, 9, []string{"judge", "justice", "scales"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x1f33e}, "farmer", 1, 9, []string{"farmer", "gardener", "rancher"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x1f373}, "cook", 1, 9, []string{"chef", "cook"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x1f527}, "mechanic", 1, 9, []string{"electrician", "mechanic", "plumber", "tradesperson"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x1f3ed}, "factory worker", 1, 9, []string{"assembly", "factory", "industrial", "worker"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x1f4bc}, "office worker", 1, 9, []string{"architect", "business", "manager", "office worker", "white-collar"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x1f52c}, "scientist", 1, 9, []string{"biologist", "chemist", "engineer", "physicist", "scientist"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x1f4bb}, "technologist", 1, 9, []string{"coder", "developer", "inventor", "software", "technologist"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x1f3a4}, "singer", 1, 9, []string{"actor", "entertainer", "rock", "singer", "star"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x1f3a8}, "artist", 1, 9, []string{"artist", "palette"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x2708, 0xfe0f}, "pilot", 1, 9, []string{"pilot", "plane"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x1f680}, "astronaut", 1, 9, []string{"astronaut", "rocket"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x1f692}, "firefighter", 1, 9, []string{"firefighter", "firetruck"}, true, 2, "12.1", nil},
+	{[]rune{0x1f46e}, "police officer", 1, 9, []string{"cop", "officer", "police"}, true, 1, "0.6", nil},
+	{[]rune{0x1f575, 0xfe0f}, "detective", 1, 9, []string{"detective", "sleuth", "spy"}, true, 1, "0.7", nil},
+	{[]rune{0x1f482}, "guard", 1, 9, []string{"guard"}, true, 1, "0.6", nil},
+	{[]rune{0x1f977}, "ninja", 1, 9, []string{"fighter", "hidden", "ninja", "stealth"}, true, 0, "13.0", nil},
+	{[]rune{0x1f477}, "construction worker", 1, 9, []string{"construction", "hat", "worker"}, true, 1, "0.6", nil},
+	{[]rune{0x1fac5}, "person with crown", 1, 9, []string(nil), true, 0, "14.0", nil},
+	{[]rune{0x1f934}, "prince", 1, 9, []string{"prince"}, true, 0, "3.0", nil},
+	{[]rune{0x1f478}, "princess", 1, 9, []string{"fairy tale", "fantasy", "princess"}, true, 0, "0.6", nil},
+	{[]rune{0x1f473}, "person wearing turban", 1, 9, []string{"person wearing turban", "turban"}, true, 1, "0.6", nil},
+	{[]rune{0x1f472}, "person with skullcap", 1, 9, []string{"cap", "gua pi mao", "hat", "person", "person with skullcap", "skullcap"}, true, 0, "0.6", nil},
+	{[]rune{0x1f9d5}, "woman with headscarf", 1, 9, []string{"headscarf", "hijab", "mantilla", "tichel", "woman with headscarf"}, true, 0, "5.0", nil},
+	{[]rune{0x1f935}, "person in tuxedo", 1, 9, []string{"groom", "person", "person in tuxedo", "tuxedo"}, true, 1, "3.0", nil},
+	{[]rune{0x1f470}, "person with veil", 1, 9, []string{"bride", "person", "person with veil", "veil", "wedding"}, true, 1, "0.6", nil},
+	{[]rune{0x1f930}, "pregnant woman", 1, 9, []string{"pregnant", "woman"}, true, 0, "3.0", nil},
+	{[]rune{0x1fac3}, "pregnant man", 1, 9, []string(nil), true, 0, "14.0", nil},
+	{[]rune{0x1fac4}, "pregnant person", 1, 9, []string(nil), true, 0, "14.0", nil},
+	{[]rune{0x1f931}, "breast-feeding", 1, 9, []string{"baby", "breast", "breast-feeding", "nursing"}, true, 0, "5.0", nil},
+	{[]rune{0x1f469, 0x1f37c}, "woman feeding baby", 1, 9, []string{"baby", "feeding", "nursing", "woman"}, true, 0, "13.0", nil},
+	{[]rune{0x1f468, 0x1f37c}, "man feeding baby", 1, 9, []string{"baby", "feeding", "man", "nursing"}, true, 0, "13.0", nil},
+	{[]rune{0x1f9d1, 0x1f37c}, "person feeding baby", 1, 9, []string{"baby", "feeding", "nursing", "person"}, true, 0, "13.0", nil},
+	{[]rune{0x1f47c}, "baby angel", 1, 10, []string{"angel", "baby", "face", "fairy tale", "fantasy"}, true, 0, "0.6", nil},
+	{[]rune{0x1f385}, "Santa Claus", 1, 10, []string{"celebration", "Christmas", "claus", "father", "santa", "Santa Claus"}, true, 0, "0.6", nil},
+	{[]rune{0x1f936}, "Mrs. Claus", 1, 10, []string{"celebration", "Christmas", "claus", "mother", "Mrs.", "Mrs. Claus"}, true, 0, "3.0", nil},
+	{[]rune{0x1f9d1, 0x1f384}, "mx claus", 1, 10, []string{"Claus, christmas", "mx claus"}, true, 0, "13.0", nil},
+	{[]rune{0x1f9b8}, "superhero", 1, 10, []string{"good", "hero", "heroine", "superhero", "superpower"}, true, 1, "11.0", nil},
+	{[]rune{0x1f9b9}, "supervillain", 1, 10, []string{"criminal", "evil", "superpower", "supervillain", "villain"}, true, 1, "11.0", nil},
+	{[]rune{0x1f9d9}, "mage", 1, 10, []string{"mage", "sorcerer", "sorceress", "witch", "wizard"}, true, 1, "5.0", nil},
+	{[]rune{0x1f9da}, "fairy", 1, 10, []string{"fairy", "Oberon", "Puck", "Titania"}, true, 1, "5.0", nil},
+	{[]rune{0x1f9db}, "vampire", 1, 10, []string{"Dracula", "undead", "vampire"}, true, 1, "5.0", nil},
+	{[]rune{0x1f9dc}, "merperson", 1, 10, []string{"mermaid", "merman", "merperson", "merwoman"}, true, 1, "5.0", nil},
+	{[]rune{0x1f9dd}, "elf", 1, 10, []string{"elf", "magical"}, true, 1, "5.0", nil},
+	{[]rune{0x1f9de}, "genie", 1, 10, []string{"djinn", "genie"}, false, 1, "5.0", nil},
+	{[]rune{0x1f9df}, "zombie", 1, 10, []string{"undead", "walking dead", "zombie"}, false, 1, "5.0", nil},
+	{[]rune{0x1f9cc}, "troll", 1, 10, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f486}, "person getting massage", 1, 11, []string{"face", "massage", "person getting massage", "salon"}, true, 1, "0.6", nil},
+	{[]rune{0x1f487}, "person getting haircut", 1, 11, []string{"barber", "beauty", "haircut", "parlor", "person getting haircut"}, true, 1, "0.6", nil},
+	{[]rune{0x1f6b6}, "person walking", 1, 11, []string{"hike", "person walking", "walk", "walking"}, true, 1, "0.6", nil},
+	{[]rune{0x1f9cd}, "person standing", 1, 11, []string{"person standing", "stand", "standing"}, true, 1, "12.0", nil},
+	{[]rune{0x1f9ce}, "person kneeling", 1, 11, []string{"kneel", "kneeling", "person kneeling"}, true, 1, "12.0", nil},
+	{[]rune{0x1f9d1, 0x1f9af}, "person with white cane", 1, 11, []string{"accessibility", "blind", "person with white cane"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x1f9bc}, "person in motorized wheelchair", 1, 11, []string{"accessibility", "person in motorized wheelchair", "wheelchair"}, true, 2, "12.1", nil},
+	{[]rune{0x1f9d1, 0x1f9bd}, "person in manual wheelchair", 1, 11, []string{"accessibility", "person in manual wheelchair", "wheelchair"}, true, 2, "12.1", nil},
+	{[]rune{0x1f3c3}, "person running", 1, 11, []string{"marathon", "person running", "running"}, true, 1, "0.6", nil},
+	{[]rune{0x1f483}, "woman dancing", 1, 11, []string{"dance", "dancing", "woman"}, true, 0, "0.6", nil},
+	{[]rune{0x1f57a}, "man dancing", 1, 11, []string{"dance", "dancing", "man"}, true, 0, "3.0", nil},
+	{[]rune{0x1f574, 0xfe0f}, "person in suit levitating", 1, 11, []string{"business", "person", "person in suit levitating", "suit"}, true, 0, "0.7", nil},
+	{[]rune{0x1f46f}, "people with bunny ears", 1, 11, []string{"bunny ear", "dancer", "partying", "people with bunny ears"}, false, 1, "0.6", nil},
+	{[]rune{0x1f9d6}, "person in steamy room", 1, 11, []string{"person in steamy room", "sauna", "steam room"}, true, 1, "5.0", nil},
+	{[]rune{0x1f9d7}, "person climbing", 1, 11, []string{"climber", "person climbing"}, true, 1, "5.0", nil},
+	{[]rune{0x1f93a}, "person fencing", 1, 12, []string{"fencer", "fencing", "person fencing", "sword"}, false, 0, "3.0", nil},
+	{[]rune{0x1f3c7}, "horse racing", 1, 12, []string{"horse", "jockey", "racehorse", "racing"}, true, 0, "1.0", nil},
+	{[]rune{0x26f7, 0xfe0f}, "skier", 1, 12, []string{"ski", "skier", "snow"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3c2}, "snowboarder", 1, 12, []string{"ski", "snow", "snowboard", "snowboarder"}, true, 0, "0.6", nil},
+	{[]rune{0x1f3cc, 0xfe0f}, "person golfing", 1, 12, []string{"ball", "golf", "person golfing"}, true, 1, "0.7", nil},
+	{[]rune{0x1f3c4}, "person surfing", 1, 12, []string{"person surfing", "surfing"}, true, 1, "0.6", nil},
+	{[]rune{0x1f6a3}, "person rowing boat", 1, 12, []string{"boat", "person rowing boat", "rowboat"}, true, 1, "1.0", nil},
+	{[]rune{0x1f3ca}, "person swimming", 1, 12, []string{"person swimming", "swim"}, true, 1, "0.6", nil},
+	{[]rune{0x26f9, 0xfe0f}, "person bouncing ball", 1, 12, []string{"ball", "person bouncing ball"}, true, 1, "0.7", nil},
+	{[]rune{0x1f3cb, 0xfe0f}, "person lifting weights", 1, 12, []string{"lifter", "person lifting weights", "weight"}, true, 1, "0.7", nil},
+	{[]rune{0x1f6b4}, "person biking", 1, 12, []string{"bicycle", "biking", "cyclist", "person biking"}, true, 1, "1.0", nil},
+	{[]rune{0x1f6b5}, "person mountain biking", 1, 12, []string{"bicycle", "bicyclist", "bike", "cyclist", "mountain", "person mountain biking"}, true, 1, "1.0", nil},
+	{[]rune{0x1f938}, "person cartwheeling", 1, 12, []string{"cartwheel", "gymnastics", "person cartwheeling"}, true, 1, "3.0", nil},
+	{[]rune{0x1f93c}, "people wrestling", 1, 12, []string{"people wrestling", "wrestle", "wrestler"}, false, 1, "3.0", nil},
+	{[]rune{0x1f93d}, "person playing water polo", 1, 12, []string{"person playing water polo", "polo", "water"}, true, 1, "3.0", nil},
+	{[]rune{0x1f93e}, "person playing handball", 1, 12, []string{"ball", "handball", "person playing handball"}, true, 1, "3.0", nil},
+	{[]rune{0x1f939}, "person juggling", 1, 12, []string{"balance", "juggle", "multitask", "person juggling", "skill"}, true, 1, "3.0", nil},
+	{[]rune{0x1f9d8}, "person in lotus position", 1, 13, []string{"meditation", "person in lotus position", "yoga"}, true, 1, "5.0", nil},
+	{[]rune{0x1f6c0}, "person taking bath", 1, 13, []string{"bath", "bathtub", "person taking bath"}, true, 0, "0.6", nil},
+	{[]rune{0x1f6cc}, "person in bed", 1, 13, []string{"hotel", "person in bed", "sleep"}, true, 0, "1.0", nil},
+	{[]rune{0x1f9d1, 0x1f91d, 0x1f9d1}, "people holding hands", 1, 14, []string{"couple", "hand", "hold", "holding hands", "people holding hands", "person"}, true, 0, "12.0", []rune{0x1f9d1, 0x1f91d, 0x1f9d1}},
+	{[]rune{0x1f46d}, "women holding hands", 1, 14, []string{"couple", "hand", "holding hands", "women", "women holding hands"}, true, 0, "1.0", []rune{0x1f469, 0x1f91d, 0x1f469}},
+	{[]rune{0x1f46b}, "woman and man holding hands", 1, 14, []string{"couple", "hand", "hold", "holding hands", "man", "woman", "woman and man holding hands"}, true, 0, "0.6", []rune{0x1f469, 0x1f91d, 0x1f468}},
+	{[]rune{0x1f46c}, "men holding hands", 1, 14, []string{"couple", "Gemini", "holding hands", "man", "men", "men holding hands", "twins", "zodiac"}, true, 0, "1.0", []rune{0x1f468, 0x1f91d, 0x1f468}},
+	{[]rune{0x1f48f}, "kiss", 1, 14, []string{"couple", "kiss"}, true, 0, "0.6", []rune{0x1f9d1, 0x2764, 0xfe0f, 0x1f48b, 0x1f9d1}},
+	{[]rune{0x1f469, 0x2764, 0xfe0f, 0x1f48b, 0x1f468}, "kiss: woman, man", 1, 14, []string(nil), true, 0, "2.0", []rune{0x1f469, 0x2764, 0xfe0f, 0x1f48b, 0x1f468}},
+	{[]rune{0x1f468, 0x2764, 0xfe0f, 0x1f48b, 0x1f468}, "kiss: man, man", 1, 14, []string(nil), true, 0, "2.0", []rune{0x1f468, 0x2764, 0xfe0f, 0x1f48b, 0x1f468}},
+	{[]rune{0x1f469, 0x2764, 0xfe0f, 0x1f48b, 0x1f469}, "kiss: woman, woman", 1, 14, []string(nil), true, 0, "2.0", []rune{0x1f469, 0x2764, 0xfe0f, 0x1f48b, 0x1f469}},
+	{[]rune{0x1f491}, "couple with heart", 1, 14, []string{"couple", "couple with heart", "love"}, true, 0, "0.6", []rune{0x1f9d1, 0x2764, 0xfe0f, 0x1f9d1}},
+	{[]rune{0x1f469, 0x2764, 0xfe0f, 0x1f468}, "couple with heart: woman, man", 1, 14, []string(nil), true, 0, "2.0", []rune{0x1f469, 0x2764, 0xfe0f, 0x1f468}},
+	{[]rune{0x1f468, 0x2764, 0xfe0f, 0x1f468}, "couple with heart: man, man", 1, 14, []string(nil), true, 0, "2.0", []rune{0x1f468, 0x2764, 0xfe0f, 0x1f468}},
+	{[]rune{0x1f469, 0x2764, 0xfe0f, 0x1f469}, "couple with heart: woman, woman", 1, 14, []string(nil), true, 0, "2.0", []rune{0x1f469, 0x2764, 0xfe0f, 0x1f469}},
+	{[]rune{0x1f468, 0x1f469, 0x1f466}, "family: man, woman, boy", 1, 14, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f468, 0x1f469, 0x1f467}, "family: man, woman, girl", 1, 14, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f468, 0x1f469, 0x1f467, 0x1f466}, "family: man, woman, girl, boy", 1, 14, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f468, 0x1f469, 0x1f466, 0x1f466}, "family: man, woman, boy, boy", 1, 14, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f468, 0x1f469, 0x1f467, 0x1f467}, "family: man, woman, girl, girl", 1, 14, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f468, 0x1f468, 0x1f466}, "family: man, man, boy", 1, 14, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f468, 0x1f468, 0x1f467}, "family: man, man, girl", 1, 14, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f468, 0x1f468, 0x1f467, 0x1f466}, "family: man, man, girl, boy", 1, 14, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f468, 0x1f468, 0x1f466, 0x1f466}, "family: man, man, boy, boy", 1, 14, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f468, 0x1f468, 0x1f467, 0x1f467}, "family: man, man, girl, girl", 1, 14, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f469, 0x1f469, 0x1f466}, "family: woman, woman, boy", 1, 14, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f469, 0x1f469, 0x1f467}, "family: woman, woman, girl", 1, 14, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f469, 0x1f469, 0x1f467, 0x1f466}, "family: woman, woman, girl, boy", 1, 14, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f469, 0x1f469, 0x1f466, 0x1f466}, "family: woman, woman, boy, boy", 1, 14, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f469, 0x1f469, 0x1f467, 0x1f467}, "family: woman, woman, girl, girl", 1, 14, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f468, 0x1f466}, "family: man, boy", 1, 14, []string(nil), false, 0, "4.0", nil},
+	{[]rune{0x1f468, 0x1f466, 0x1f466}, "family: man, boy, boy", 1, 14, []string(nil), false, 0, "4.0", nil},
+	{[]rune{0x1f468, 0x1f467}, "family: man, girl", 1, 14, []string(nil), false, 0, "4.0", nil},
+	{[]rune{0x1f468, 0x1f467, 0x1f466}, "family: man, girl, boy", 1, 14, []string(nil), false, 0, "4.0", nil},
+	{[]rune{0x1f468, 0x1f467, 0x1f467}, "family: man, girl, girl", 1, 14, []string(nil), false, 0, "4.0", nil},
+	{[]rune{0x1f469, 0x1f466}, "family: woman, boy", 1, 14, []string(nil), false, 0, "4.0", nil},
+	{[]rune{0x1f469, 0x1f466, 0x1f466}, "family: woman, boy, boy", 1, 14, []string(nil), false, 0, "4.0", nil},
+	{[]rune{0x1f469, 0x1f467}, "family: woman, girl", 1, 14, []string(nil), false, 0, "4.0", nil},
+	{[]rune{0x1f469, 0x1f467, 0x1f466}, "family: woman, girl, boy", 1, 14, []string(nil), false, 0, "4.0", nil},
+	{[]rune{0x1f469, 0x1f467, 0x1f467}, "family: woman, girl, girl", 1, 14, []string(nil), false, 0, "4.0", nil},
+	{[]rune{0x1f5e3, 0xfe0f}, "speaking head", 1, 15, []string{"face", "head", "silhouette", "speak", "speaking"}, false, 0, "0.7", nil},
+	{[]rune{0x1f464}, "bust in silhouette", 1, 15, []string{"bust", "bust in silhouette", "silhouette"}, false, 0, "0.6", nil},
+	{[]rune{0x1f465}, "busts in silhouette", 1, 15, []string{"bust", "busts in silhouette", "silhouette"}, false, 0, "1.0", nil},
+	{[]rune{0x1fac2}, "people hugging", 1, 15, []string{"goodbye", "hello", "hug", "people hugging", "thanks"}, false, 0, "13.0", nil},
+	{[]rune{0x1f46a}, "family", 1, 15, []string{"family"}, false, 0, "0.6", nil},
+	{[]rune{0x1f463}, "footprints", 1, 15, []string{"clothing", "footprint", "footprints", "print"}, false, 0, "0.6", nil},
+	{[]rune{0x1f435}, "monkey face", 3, 0, []string{"face", "monkey"}, false, 0, "0.6", nil},
+	{[]rune{0x1f412}, "monkey", 3, 0, []string{"monkey"}, false, 0, "0.6", nil},
+	{[]rune{0x1f98d}, "gorilla", 3, 0, []string{"gorilla"}, false, 0, "3.0", nil},
+	{[]rune{0x1f9a7}, "orangutan", 3, 0, []string{"ape", "orangutan"}, false, 0, "12.0", nil},
+	{[]rune{0x1f436}, "dog face", 3, 0, []string{"dog", "face", "pet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f415}, "dog", 3, 0, []string{"dog", "pet"}, false, 0, "0.7", nil},
+	{[]rune{0x1f9ae}, "guide dog", 3, 0, []string{"accessibility", "blind", "guide", "guide dog"}, false, 0, "12.0", nil},
+	{[]rune{0x1f415, 0x1f9ba}, "service dog", 3, 0, []string{"accessibility", "assistance", "dog", "service"}, false, 0, "12.0", nil},
+	{[]rune{0x1f429}, "poodle", 3, 0, []string{"dog", "poodle"}, false, 0, "0.6", nil},
+	{[]rune{0x1f43a}, "wolf", 3, 0, []string{"face", "wolf"}, false, 0, "0.6", nil},
+	{[]rune{0x1f98a}, "fox", 3, 0, []string{"face", "fox"}, false, 0, "3.0", nil},
+	{[]rune{0x1f99d}, "raccoon", 3, 0, []string{"curious", "raccoon", "sly"}, false, 0, "11.0", nil},
+	{[]rune{0x1f431}, "cat face", 3, 0, []string{"cat", "face", "pet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f408}, "cat", 3, 0, []string{"cat", "pet"}, false, 0, "0.7", nil},
+	{[]rune{0x1f408, 0x2b1b}, "black cat", 3, 0, []string{"black", "cat", "unlucky"}, false, 0, "13.0", nil},
+	{[]rune{0x1f981}, "lion", 3, 0, []string{"face", "Leo", "lion", "zodiac"}, false, 0, "1.0", nil},
+	{[]rune{0x1f42f}, "tiger face", 3, 0, []string{"face", "tiger"}, false, 0, "0.6", nil},
+	{[]rune{0x1f405}, "tiger", 3, 0, []string{"tiger"}, false, 0, "1.0", nil},
+	{[]rune{0x1f406}, "leopard", 3, 0, []string{"leopard"}, false, 0, "1.0", nil},
+	{[]rune{0x1f434}, "horse face", 3, 0, []string{"face", "horse"}, false, 0, "0.6", nil},
+	{[]rune{0x1f40e}, "horse", 3, 0, []string{"equestrian", "horse", "racehorse", "racing"}, false, 0, "0.6", nil},
+	{[]rune{0x1f984}, "unicorn", 3, 0, []string{"face", "unicorn"}, false, 0, "1.0", nil},
+	{[]rune{0x1f993}, "zebra", 3, 0, []string{"stripe", "zebra"}, false, 0, "5.0", nil},
+	{[]rune{0x1f98c}, "deer", 3, 0, []string{"deer"}, false, 0, "3.0", nil},
+	{[]rune{0x1f9ac}, "bison", 3, 0, []string{"bison", "buffalo", "herd", "wisent"}, false, 0, "13.0", nil},
+	{[]rune{0x1f42e}, "cow face", 3, 0, []string{"cow", "face"}, false, 0, "0.6", nil},
+	{[]rune{0x1f402}, "ox", 3, 0, []string{"bull", "ox", "Taurus", "zodiac"}, false, 0, "1.0", nil},
+	{[]rune{0x1f403}, "water buffalo", 3, 0, []string{"buffalo", "water"}, false, 0, "1.0", nil},
+	{[]rune{0x1f404}, "cow", 3, 0, []string{"cow"}, false, 0, "1.0", nil},
+	{[]rune{0x1f437}, "pig face", 3, 0, []string{"face", "pig"}, false, 0, "0.6", nil},
+	{[]rune{0x1f416}, "pig", 3, 0, []string{"pig", "sow"}, false, 0, "1.0", nil},
+	{[]rune{0x1f417}, "boar", 3, 0, []string{"boar", "pig"}, false, 0, "0.6", nil},
+	{[]rune{0x1f43d}, "pig nose", 3, 0, []string{"face", "nose", "pig"}, false, 0, "0.6", nil},
+	{[]rune{0x1f40f}, "ram", 3, 0, []string{"Aries", "male", "ram", "sheep", "zodiac"}, false, 0, "1.0", nil},
+	{[]rune{0x1f411}, "ewe", 3, 0, []string{"ewe", "female", "sheep"}, false, 0, "0.6", nil},
+	{[]rune{0x1f410}, "goat", 3, 0, []string{"Capricorn", "goat", "zodiac"}, false, 0, "1.0", nil},
+	{[]rune{0x1f42a}, "camel", 3, 0, []string{"camel", "dromedary", "hump"}, false, 0, "1.0", nil},
+	{[]rune{0x1f42b}, "two-hump camel", 3, 0, []string{"bactrian", "camel", "hump", "two-hump camel"}, false, 0, "0.6", nil},
+	{[]rune{0x1f999}, "llama", 3, 0, []string{"alpaca", "guanaco", "llama", "vicuña", "wool"}, false, 0, "11.0", nil},
+	{[]rune{0x1f992}, "giraffe", 3, 0, []string{"giraffe", "spots"}, false, 0, "5.0", nil},
+	{[]rune{0x1f418}, "elephant", 3, 0, []string{"elephant"}, false, 0, "0.6", nil},
+	{[]rune{0x1f9a3}, "mammoth", 3, 0, []string{"extinction", "large", "mammoth", "tusk", "woolly"}, false, 0, "13.0", nil},
+	{[]rune{0x1f98f}, "rhinoceros", 3, 0, []string{"rhinoceros"}, false, 0, "3.0", nil},
+	{[]rune{0x1f99b}, "hippopotamus", 3, 0, []string{"hippo", "hippopotamus"}, false, 0, "11.0", nil},
+	{[]rune{0x1f42d}, "mouse face", 3, 0, []string{"face", "mouse"}, false, 0, "0.6", nil},
+	{[]rune{0x1f401}, "mouse", 3, 0, []string{"mouse"}, false, 0, "1.0", nil},
+	{[]rune{0x1f400}, "rat", 3, 0, []string{"rat"}, false, 0, "1.0", nil},
+	{[]rune{0x1f439}, "hamster", 3, 0, []string{"face", "hamster", "pet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f430}, "rabbit face", 3, 0, []string{"bunny", "face", "pet", "rabbit"}, false, 0, "0.6", nil},
+	{[]rune{0x1f407}, "rabbit", 3, 0, []string{"bunny", "pet", "rabbit"}, false, 0, "1.0", nil},
+	{[]rune{0x1f43f, 0xfe0f}, "chipmunk", 3, 0, []string{"chipmunk", "squirrel"}, false, 0, "0.7", nil},
+	{[]rune{0x1f9ab}, "beaver", 3, 0, []string{"beaver", "dam"}, false, 0, "13.0", nil},
+	{[]rune{0x1f994}, "hedgehog", 3, 0, []string{"hedgehog", "spiny"}, false, 0, "5.0", nil},
+	{[]rune{0x1f987}, "bat", 3, 0, []string{"bat", "vampire"}, false, 0, "3.0", nil},
+	{[]rune{0x1f43b}, "bear", 3, 0, []string{"bear", "face"}, false, 0, "0.6", nil},
+	{[]rune{0x1f43b, 0x2744, 0xfe0f}, "polar bear", 3, 0, []string{"arctic", "bear", "polar bear", "white"}, false, 0, "13.0", nil},
+	{[]rune{0x1f428}, "koala", 3, 0, []string{"bear", "koala"}, false, 0, "0.6", nil},
+	{[]rune{0x1f43c}, "panda", 3, 0, []string{"face", "panda"}, false, 0, "0.6", nil},
+	{[]rune{0x1f9a5}, "sloth", 3, 0, []string{"lazy", "sloth", "slow"}, false, 0, "12.0", nil},
+	{[]rune{0x1f9a6}, "otter", 3, 0, []string{"fishing", "otter", "playful"}, false, 0, "12.0", nil},
+	{[]rune{0x1f9a8}, "skunk", 3, 0, []string{"skunk", "stink"}, false, 0, "12.0", nil},
+	{[]rune{0x1f998}, "kangaroo", 3, 0, []string{"Australia", "joey", "jump", "kangaroo", "marsupial"}, false, 0, "11.0", nil},
+	{[]rune{0x1f9a1}, "badger", 3, 0, []string{"badger", "honey badger", "pester"}, false, 0, "11.0", nil},
+	{[]rune{0x1f43e}, "paw prints", 3, 0, []string{"feet", "paw", "paw prints", "print"}, false, 0, "0.6", nil},
+	{[]rune{0x1f983}, "turkey", 3, 1, []string{"bird", "turkey"}, false, 0, "1.0", nil},
+	{[]rune{0x1f414}, "chicken", 3, 1, []string{"bird", "chicken"}, false, 0, "0.6", nil},
+	{[]rune{0x1f413}, "rooster", 3, 1, []string{"bird", "rooster"}, false, 0, "1.0", nil},
+	{[]rune{0x1f423}, "hatching chick", 3, 1, []string{"baby", "bird", "chick", "hatching"}, false, 0, "0.6", nil},
+	{[]rune{0x1f424}, "baby chick", 3, 1, []string{"baby", "bird", "chick"}, false, 0, "0.6", nil},
+	{[]rune{0x1f425}, "front-facing baby chick", 3, 1, []string{"baby", "bird", "chick", "front-facing baby chick"}, false, 0, "0.6", nil},
+	{[]rune{0x1f426}, "bird", 3, 1, []string{"bird"}, false, 0, "0.6", nil},
+	{[]rune{0x1f427}, "penguin", 3, 1, []string{"bird", "penguin"}, false, 0, "0.6", nil},
+	{[]rune{0x1f54a, 0xfe0f}, "dove", 3, 1, []string{"bird", "dove", "fly", "peace"}, false, 0, "0.7", nil},
+	{[]rune{0x1f985}, "eagle", 3, 1, []string{"bird", "eagle"}, false, 0, "3.0", nil},
+	{[]rune{0x1f986}, "duck", 3, 1, []string{"bird", "duck"}, false, 0, "3.0", nil},
+	{[]rune{0x1f9a2}, "swan", 3, 1, []string{"bird", "cygnet", "swan", "ugly duckling"}, false, 0, "11.0", nil},
+	{[]rune{0x1f989}, "owl", 3, 1, []string{"bird", "owl", "wise"}, false, 0, "3.0", nil},
+	{[]rune{0x1f9a4}, "dodo", 3, 1, []string{"dodo", "extinction", "large", "Mauritius"}, false, 0, "13.0", nil},
+	{[]rune{0x1fab6}, "feather", 3, 1, []string{"bird", "feather", "flight", "light", "plumage"}, false, 0, "13.0", nil},
+	{[]rune{0x1f9a9}, "flamingo", 3, 1, []string{"flamboyant", "flamingo", "tropical"}, false, 0, "12.0", nil},
+	{[]rune{0x1f99a}, "peacock", 3, 1, []string{"bird", "ostentatious", "peacock", "peahen", "proud"}, false, 0, "11.0", nil},
+	{[]rune{0x1f99c}, "parrot", 3, 1, []string{"bird", "parrot", "pirate", "talk"}, false, 0, "11.0", nil},
+	{[]rune{0x1f438}, "frog", 3, 2, []string{"face", "frog"}, false, 0, "0.6", nil},
+	{[]rune{0x1f40a}, "crocodile", 3, 3, []string{"crocodile"}, false, 0, "1.0", nil},
+	{[]rune{0x1f422}, "turtle", 3, 3, []string{"terrapin", "tortoise", "turtle"}, false, 0, "0.6", nil},
+	{[]rune{0x1f98e}, "lizard", 3, 3, []string{"lizard", "reptile"}, false, 0, "3.0", nil},
+	{[]rune{0x1f40d}, "snake", 3, 3, []string{"bearer", "Ophiuchus", "serpent", "snake", "zodiac"}, false, 0, "0.6", nil},
+	{[]rune{0x1f432}, "dragon face", 3, 3, []string{"dragon", "face", "fairy tale"}, false, 0, "0.6", nil},
+	{[]rune{0x1f409}, "dragon", 3, 3, []string{"dragon", "fairy tale"}, false, 0, "1.0", nil},
+	{[]rune{0x1f995}, "sauropod", 3, 3, []string{"brachiosaurus", "brontosaurus", "diplodocus", "sauropod"}, false, 0, "5.0", nil},
+	{[]rune{0x1f996}, "T-Rex", 3, 3, []string{"T-Rex", "Tyrannosaurus Rex"}, false, 0, "5.0", nil},
+	{[]rune{0x1f433}, "spouting whale", 3, 4, []string{"face", "spouting", "whale"}, false, 0, "0.6", nil},
+	{[]rune{0x1f40b}, "whale", 3, 4, []string{"whale"}, false, 0, "1.0", nil},
+	{[]rune{0x1f42c}, "dolphin", 3, 4, []string{"dolphin", "flipper"}, false, 0, "0.6", nil},
+	{[]rune{0x1f9ad}, "seal", 3, 4, []string{"sea Lion", "seal"}, false, 0, "13.0", nil},
+	{[]rune{0x1f41f}, "fish", 3, 4, []string{"fish", "Pisces", "zodiac"}, false, 0, "0.6", nil},
+	{[]rune{0x1f420}, "tropical fish", 3, 4, []string{"fish", "tropical"}, false, 0, "0.6", nil},
+	{[]rune{0x1f421}, "blowfish", 3, 4, []string{"blowfish", "fish"}, false, 0, "0.6", nil},
+	{[]rune{0x1f988}, "shark", 3, 4, []string{"fish", "shark"}, false, 0, "3.0", nil},
+	{[]rune{0x1f419}, "octopus", 3, 4, []string{"octopus"}, false, 0, "0.6", nil},
+	{[]rune{0x1f41a}, "spiral shell", 3, 4, []string{"shell", "spiral"}, false, 0, "0.6", nil},
+	{[]rune{0x1fab8}, "coral", 3, 4, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f40c}, "snail", 3, 5, []string{"snail"}, false, 0, "0.6", nil},
+	{[]rune{0x1f98b}, "butterfly", 3, 5, []string{"butterfly", "insect", "pretty"}, false, 0, "3.0", nil},
+	{[]rune{0x1f41b}, "bug", 3, 5, []string{"bug", "insect"}, false, 0, "0.6", nil},
+	{[]rune{0x1f41c}, "ant", 3, 5, []string{"ant", "insect"}, false, 0, "0.6", nil},
+	{[]rune{0x1f41d}, "honeybee", 3, 5, []string{"bee", "honeybee", "insect"}, false, 0, "0.6", nil},
+	{[]rune{0x1fab2}, "beetle", 3, 5, []string{"beetle", "bug", "insect"}, false, 0, "13.0", nil},
+	{[]rune{0x1f41e}, "lady beetle", 3, 5, []string{"beetle", "insect", "lady beetle", "ladybird", "ladybug"}, false, 0, "0.6", nil},
+	{[]rune{0x1f997}, "cricket", 3, 5, []string{"cricket", "grasshopper"}, false, 0, "5.0", nil},
+	{[]rune{0x1fab3}, "cockroach", 3, 5, []string{"cockroach", "insect", "pest", "roach"}, false, 0, "13.0", nil},
+	{[]rune{0x1f577, 0xfe0f}, "spider", 3, 5, []string{"insect", "spider"}, false, 0, "0.7", nil},
+	{[]rune{0x1f578, 0xfe0f}, "spider web", 3, 5, []string{"spider", "web"}, false, 0, "0.7", nil},
+	{[]rune{0x1f982}, "scorpion", 3, 5, []string{"scorpio", "Scorpio", "scorpion", "zodiac"}, false, 0, "1.0", nil},
+	{[]rune{0x1f99f}, "mosquito", 3, 5, []string{"disease", "fever", "malaria", "mosquito", "pest", "virus"}, false, 0, "11.0", nil},
+	{[]rune{0x1fab0}, "fly", 3, 5, []string{"disease", "fly", "maggot", "pest", "rotting"}, false, 0, "13.0", nil},
+	{[]rune{0x1fab1}, "worm", 3, 5, []string{"annelid", "earthworm", "parasite", "worm"}, false, 0, "13.0", nil},
+	{[]rune{0x1f9a0}, "microbe", 3, 5, []string{"amoeba", "bacteria", "microbe", "virus"}, false, 0, "11.0", nil},
+	{[]rune{0x1f490}, "bouquet", 3, 6, []string{"bouquet", "flower"}, false, 0, "0.6", nil},
+	{[]rune{0x1f338}, "cherry blossom", 3, 6, []string{"blossom", "cherry", "flower"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4ae}, "white flower", 3, 6, []string{"flower", "white flower"}, false, 0, "0.6", nil},
+	{[]rune{0x1fab7}, "lotus", 3, 6, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f3f5, 0xfe0f}, "rosette", 3, 6, []string{"plant", "rosette"}, false, 0, "0.7", nil},
+	{[]rune{0x1f339}, "rose", 3, 6, []string{"flower", "rose"}, false, 0, "0.6", nil},
+	{[]rune{0x1f940}, "wilted flower", 3, 6, []string{"flower", "wilted"}, false, 0, "3.0", nil},
+	{[]rune{0x1f33a}, "hibiscus", 3, 6, []string{"flower", "hibiscus"}, false, 0, "0.6", nil},
+	{[]rune{0x1f33b}, "sunflower", 3, 6, []string{"flower", "sun", "sunflower"}, false, 0, "0.6", nil},
+	{[]rune{0x1f33c}, "blossom", 3, 6, []string{"blossom", "flower"}, false, 0, "0.6", nil},
+	{[]rune{0x1f337}, "tulip", 3, 6, []string{"flower", "tulip"}, false, 0, "0.6", nil},
+	{[]rune{0x1f331}, "seedling", 3, 7, []string{"seedling", "young"}, false, 0, "0.6", nil},
+	{[]rune{0x1fab4}, "potted plant", 3, 7, []string{"boring", "grow", "house", "nurturing", "plant", "potted plant", "useless"}, false, 0, "13.0", nil},
+	{[]rune{0x1f332}, "evergreen tree", 3, 7, []string{"evergreen tree", "tree"}, false, 0, "1.0", nil},
+	{[]rune{0x1f333}, "deciduous tree", 3, 7, []string{"deciduous", "shedding", "tree"}, false, 0, "1.0", nil},
+	{[]rune{0x1f334}, "palm tree", 3, 7, []string{"palm", "tree"}, false, 0, "0.6", nil},
+	{[]rune{0x1f335}, "cactus", 3, 7, []string{"cactus", "plant"}, false, 0, "0.6", nil},
+	{[]rune{0x1f33e}, "sheaf of rice", 3, 7, []string{"ear", "grain", "rice", "sheaf of rice"}, false, 0, "0.6", nil},
+	{[]rune{0x1f33f}, "herb", 3, 7, []string{"herb", "leaf"}, false, 0, "0.6", nil},
+	{[]rune{0x2618, 0xfe0f}, "shamrock", 3, 7, []string{"plant", "shamrock"}, false, 0, "1.0", nil},
+	{[]rune{0x1f340}, "four leaf clover", 3, 7, []string{"4", "clover", "four", "four-leaf clover", "leaf"}, false, 0, "0.6", nil},
+	{[]rune{0x1f341}, "maple leaf", 3, 7, []string{"falling", "leaf", "maple"}, false, 0, "0.6", nil},
+	{[]rune{0x1f342}, "fallen leaf", 3, 7, []string{"fallen leaf", "falling", "leaf"}, false, 0, "0.6", nil},
+	{[]rune{0x1f343}, "leaf fluttering in wind", 3, 7, []string{"blow", "flutter", "leaf", "leaf fluttering in wind", "wind"}, false, 0, "0.6", nil},
+	{[]rune{0x1fab9}, "empty nest", 3, 7, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1faba}, "nest with eggs", 3, 7, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f344}, "mushroom", 3, 7, []string{"mushroom", "toadstool"}, false, 0, "0.6", nil},
+	{[]rune{0x1f347}, "grapes", 4, 0, []string{"fruit", "grape", "grapes"}, false, 0, "0.6", nil},
+	{[]rune{0x1f348}, "melon", 4, 0, []string{"fruit", "melon"}, false, 0, "0.6", nil},
+	{[]rune{0x1f349}, "watermelon", 4, 0, []string{"fruit", "watermelon"}, false, 0, "0.6", nil},
+	{[]rune{0x1f34a}, "tangerine", 4, 0, []string{"fruit", "orange", "tangerine"}, false, 0, "0.6", nil},
+	{[]rune{0x1f34b}, "lemon", 4, 0, []string{"citrus", "fruit", "lemon"}, false, 0, "1.0", nil},
+	{[]rune{0x1f34c}, "banana", 4, 0, []string{"banana", "fruit"}, false, 0, "0.6", nil},
+	{[]rune{0x1f34d}, "pineapple", 4, 0, []string{"fruit", "pineapple"}, false, 0, "0.6", nil},
+	{[]rune{0x1f96d}, "mango", 4, 0, []string{"fruit", "mango", "tropical"}, false, 0, "11.0", nil},
+	{[]rune{0x1f34e}, "red apple", 4, 0, []string{"apple", "fruit", "red"}, false, 0, "0.6", nil},
+	{[]rune{0x1f34f}, "green apple", 4, 0, []string{"apple", "fruit", "green"}, false, 0, "0.6", nil},
+	{[]rune{0x1f350}, "pear", 4, 0, []string{"fruit", "pear"}, false, 0, "1.0", nil},
+	{[]rune{0x1f351}, "peach", 4, 0, []string{"fruit", "peach"}, false, 0, "0.6", nil},
+	{[]rune{0x1f352}, "cherries", 4, 0, []string{"berries", "cherries", "cherry", "fruit", "red"}, false, 0, "0.6", nil},
+	{[]rune{0x1f353}, "strawberry", 4, 0, []string{"berry", "fruit", "strawberry"}, false, 0, "0.6", nil},
+	{[]rune{0x1fad0}, "blueberries", 4, 0, []string{"berry", "bilberry", "blue", "blueberries", "blueberry"}, false, 0, "13.0", nil},
+	{[]rune{0x1f95d}, "kiwi fruit", 4, 0, []string{"food", "fruit", "kiwi"}, false, 0, "3.0", nil},
+	{[]rune{0x1f345}, "tomato", 4, 0, []string{"fruit", "tomato", "vegetable"}, false, 0, "0.6", nil},
+	{[]rune{0x1fad2}, "olive", 4, 0, []string{"food", "olive"}, false, 0, "13.0", nil},
+	{[]rune{0x1f965}, "coconut", 4, 0, []string{"coconut", "palm", "piña colada"}, false, 0, "5.0", nil},
+	{[]rune{0x1f951}, "avocado", 4, 1, []string{"avocado", "food", "fruit"}, false, 0, "3.0", nil},
+	{[]rune{0x1f346}, "eggplant", 4, 1, []string{"aubergine", "eggplant", "vegetable"}, false, 0, "0.6", nil},
+	{[]rune{0x1f954}, "potato", 4, 1, []string{"food", "potato", "vegetable"}, false, 0, "3.0", nil},
+	{[]rune{0x1f955}, "carrot", 4, 1, []string{"carrot", "food", "vegetable"}, false, 0, "3.0", nil},
+	{[]rune{0x1f33d}, "ear of corn", 4, 1, []string{"corn", "ear", "ear of corn", "maize", "maze"}, false, 0, "0.6", nil},
+	{[]rune{0x1f336, 0xfe0f}, "hot pepper", 4, 1, []string{"hot", "pepper"}, false, 0, "0.7", nil},
+	{[]rune{0x1fad1}, "bell pepper", 4, 1, []string{"bell pepper", "capsicum", "pepper", "vegetable"}, false, 0, "13.0", nil},
+	{[]rune{0x1f952}, "cucumber", 4, 1, []string{"cucumber", "food", "pickle", "vegetable"}, false, 0, "3.0", nil},
+	{[]rune{0x1f96c}, "leafy green", 4, 1, []string{"bok choy", "cabbage", "kale", "leafy green", "lettuce"}, false, 0, "11.0", nil},
+	{[]rune{0x1f966}, "broccoli", 4, 1, []string{"broccoli", "wild cabbage"}, false, 0, "5.0", nil},
+	{[]rune{0x1f9c4}, "garlic", 4, 1, []string{"flavoring", "garlic"}, false, 0, "12.0", nil},
+	{[]rune{0x1f9c5}, "onion", 4, 1, []string{"flavoring", "onion"}, false, 0, "12.0", nil},
+	{[]rune{0x1f95c}, "peanuts", 4, 1, []string{"food", "nut", "peanut", "peanuts", "vegetable"}, false, 0, "3.0", nil},
+	{[]rune{0x1fad8}, "beans", 4, 1, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f330}, "chestnut", 4, 1, []string{"chestnut", "plant"}, false, 0, "0.6", nil},
+	{[]rune{0x1f35e}, "bread", 4, 2, []string{"bread", "loaf"}, false, 0, "0.6", nil},
+	{[]rune{0x1f950}, "croissant", 4, 2, []string{"bread", "breakfast", "croissant", "food", "french", "roll"}, false, 0, "3.0", nil},
+	{[]rune{0x1f956}, "baguette bread", 4, 2, []string{"baguette", "bread", "food", "french"}, false, 0, "3.0", nil},
+	{[]rune{0x1fad3}, "flatbread", 4, 2, []string{"arepa", "flatbread", "lavash", "naan", "pita"}, false, 0, "13.0", nil},
+	{[]rune{0x1f968}, "pretzel", 4, 2, []string{"pretzel", "twisted"}, false, 0, "5.0", nil},
+	{[]rune{0x1f96f}, "bagel", 4, 2, []string{"bagel", "bakery", "breakfast", "schmear"}, false, 0, "11.0", nil},
+	{[]rune{0x1f95e}, "pancakes", 4, 2, []string{"breakfast", "crêpe", "food", "hotcake", "pancake", "pancakes"}, false, 0, "3.0", nil},
+	{[]rune{0x1f9c7}, "waffle", 4, 2, []string{"breakfast", "indecisive", "iron", "waffle"}, false, 0, "12.0", nil},
+	{[]rune{0x1f9c0}, "cheese wedge", 4, 2, []string{"cheese", "cheese wedge"}, false, 0, "1.0", nil},
+	{[]rune{0x1f356}, "meat on bone", 4, 2, []string{"bone", "meat", "meat on bone"}, false, 0, "0.6", nil},
+	{[]rune{0x1f357}, "poultry leg", 4, 2, []string{"bone", "chicken", "drumstick", "leg", "poultry"}, false, 0, "0.6", nil},
+	{[]rune{0x1f969}, "cut of meat", 4, 2, []string{"chop", "cut of meat", "lambchop", "porkchop", "steak"}, false, 0, "5.0", nil},
+	{[]rune{0x1f953}, "bacon", 4, 2, []string{"bacon", "breakfast", "food", "meat"}, false, 0, "3.0", nil},
+	{[]rune{0x1f354}, "hamburger", 4, 2, []string{"burger", "hamburger"}, false, 0, "0.6", nil},
+	{[]rune{0x1f35f}, "french fries", 4, 2, []string{"french", "fries"}, false, 0, "0.6", nil},
+	{[]rune{0x1f355}, "pizza", 4, 2, []string{"cheese", "pizza", "slice"}, false, 0, "0.6", nil},
+	{[]rune{0x1f32d}, "hot dog", 4, 2, []string{"frankfurter", "hot dog", "hotdog", "sausage"}, false, 0, "1.0", nil},
+	{[]rune{0x1f96a}, "sandwich", 4, 2, []string{"bread", "sandwich"}, false, 0, "5.0", nil},
+	{[]rune{0x1f32e}, "taco", 4, 2, []string{"mexican", "taco"}, false, 0, "1.0", nil},
+	{[]rune{0x1f32f}, "burrito", 4, 2, []string{"burrito", "mexican", "wrap"}, false, 0, "1.0", nil},
+	{[]rune{0x1fad4}, "tamale", 4, 2, []string{"mexican", "tamale", "wrapped"}, false, 0, "13.0", nil},
+	{[]rune{0x1f959}, "stuffed flatbread", 4, 2, []string{"falafel", "flatbread", "food", "gyro", "kebab", "stuffed"}, false, 0, "3.0", nil},
+	{[]rune{0x1f9c6}, "falafel", 4, 2, []string{"chickpea", "falafel", "meatball"}, false, 0, "12.0", nil},
+	{[]rune{0x1f95a}, "egg", 4, 2, []string{"breakfast", "egg", "food"}, false, 0, "3.0", nil},
+	{[]rune{0x1f373}, "cooking", 4, 2, []string{"breakfast", "cooking", "egg", "frying", "pan"}, false, 0, "0.6", nil},
+	{[]rune{0x1f958}, "shallow pan of food", 4, 2, []string{"casserole", "food", "paella", "pan", "shallow", "shallow pan of food"}, false, 0, "3.0", nil},
+	{[]rune{0x1f372}, "pot of food", 4, 2, []string{"pot", "pot of food", "stew"}, false, 0, "0.6", nil},
+	{[]rune{0x1fad5}, "fondue", 4, 2, []string{"cheese", "chocolate", "fondue", "melted", "pot", "Swiss"}, false, 0, "13.0", nil},
+	{[]rune{0x1f963}, "bowl with spoon", 4, 2, []string{"bowl with spoon", "breakfast", "cereal", "congee"}, false, 0, "5.0", nil},
+	{[]rune{0x1f957}, "green salad", 4, 2, []string{"food", "green", "salad"}, false, 0, "3.0", nil},
+	{[]rune{0x1f37f}, "popcorn", 4, 2, []string{"popcorn"}, false, 0, "1.0", nil},
+	{[]rune{0x1f9c8}, "butter", 4, 2, []string{"butter", "dairy"}, false, 0, "12.0", nil},
+	{[]rune{0x1f9c2}, "salt", 4, 2, []string{"condiment", "salt", "shaker"}, false, 0, "11.0", nil},
+	{[]rune{0x1f96b}, "canned food", 4, 2, []string{"can", "canned food"}, false, 0, "5.0", nil},
+	{[]rune{0x1f371}, "bento box", 4, 3, []string{"bento", "box"}, false, 0, "0.6", nil},
+	{[]rune{0x1f358}, "rice cracker", 4, 3, []string{"cracker", "rice"}, false, 0, "0.6", nil},
+	{[]rune{0x1f359}, "rice ball", 4, 3, []string{"ball", "Japanese", "rice"}, false, 0, "0.6", nil},
+	{[]rune{0x1f35a}, "cooked rice", 4, 3, []string{"cooked", "rice"}, false, 0, "0.6", nil},
+	{[]rune{0x1f35b}, "curry rice", 4, 3, []string{"curry", "rice"}, false, 0, "0.6", nil},
+	{[]rune{0x1f35c}, "steaming bowl", 4, 3, []string{"bowl", "noodle", "ramen", "steaming"}, false, 0, "0.6", nil},
+	{[]rune{0x1f35d}, "spaghetti", 4, 3, []string{"pasta", "spaghetti"}, false, 0, "0.6", nil},
+	{[]rune{0x1f360}, "roasted sweet potato", 4, 3, []string{"potato", "roasted", "sweet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f362}, "oden", 4, 3, []string{"kebab", "oden", "seafood", "skewer", "stick"}, false, 0, "0.6", nil},
+	{[]rune{0x1f363}, "sushi", 4, 3, []string{"sushi"}, false, 0, "0.6", nil},
+	{[]rune{0x1f364}, "fried shrimp", 4, 3, []string{"fried", "prawn", "shrimp", "tempura"}, false, 0, "0.6", nil},
+	{[]rune{0x1f365}, "fish cake with swirl", 4, 3, []string{"cake", "fish", "fish cake with swirl", "pastry", "swirl"}, false, 0, "0.6", nil},
+	{[]rune{0x1f96e}, "moon cake", 4, 3, []string{"autumn", "festival", "moon cake", "yuèbǐng"}, false, 0, "11.0", nil},
+	{[]rune{0x1f361}, "dango", 4, 3, []string{"dango", "dessert", "Japanese", "skewer", "stick", "sweet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f95f}, "dumpling", 4, 3, []string{"dumpling", "empanada", "gyōza", "jiaozi", "pierogi", "potsticker"}, false, 0, "5.0", nil},
+	{[]rune{0x1f960}, "fortune cookie", 4, 3, []string{"fortune cookie", "prophecy"}, false, 0, "5.0", nil},
+	{[]rune{0x1f961}, "takeout box", 4, 3, []string{"oyster pail", "takeout box"}, false, 0, "5.0", nil},
+	{[]rune{0x1f980}, "crab", 4, 4, []string{"Cancer", "crab", "zodiac"}, false, 0, "1.0", nil},
+	{[]rune{0x1f99e}, "lobster", 4, 4, []string{"bisque", "claws", "lobster", "seafood"}, false, 0, "11.0", nil},
+	{[]rune{0x1f990}, "shrimp", 4, 4, []string{"food", "shellfish", "shrimp", "small"}, false, 0, "3.0", nil},
+	{[]rune{0x1f991}, "squid", 4, 4, []string{"food", "molusc", "squid"}, false, 0, "3.0", nil},
+	{[]rune{0x1f9aa}, "oyster", 4, 4, []string{"diving", "oyster", "pearl"}, false, 0, "12.0", nil},
+	{[]rune{0x1f366}, "soft ice cream", 4, 5, []string{"cream", "dessert", "ice", "icecream", "soft", "sweet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f367}, "shaved ice", 4, 5, []string{"dessert", "ice", "shaved", "sweet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f368}, "ice cream", 4, 5, []string{"cream", "dessert", "ice", "sweet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f369}, "doughnut", 4, 5, []string{"breakfast", "dessert", "donut", "doughnut", "sweet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f36a}, "cookie", 4, 5, []string{"cookie", "dessert", "sweet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f382}, "birthday cake", 4, 5, []string{"birthday", "cake", "celebration", "dessert", "pastry", "sweet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f370}, "shortcake", 4, 5, []string{"cake", "dessert", "pastry", "shortcake", "slice", "sweet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f9c1}, "cupcake", 4, 5, []string{"bakery", "cupcake", "sweet"}, false, 0, "11.0", nil},
+	{[]rune{0x1f967}, "pie", 4, 5, []string{"filling", "pastry", "pie"}, false, 0, "5.0", nil},
+	{[]rune{0x1f36b}, "chocolate bar", 4, 5, []string{"bar", "chocolate", "dessert", "sweet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f36c}, "candy", 4, 5, []string{"candy", "dessert", "sweet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f36d}, "lollipop", 4, 5, []string{"candy", "dessert", "lollipop", "sweet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f36e}, "custard", 4, 5, []string{"custard", "dessert", "pudding", "sweet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f36f}, "honey pot", 4, 5, []string{"honey", "honeypot", "pot", "sweet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f37c}, "baby bottle", 4, 6, []string{"baby", "bottle", "drink", "milk"}, false, 0, "1.0", nil},
+	{[]rune{0x1f95b}, "glass of milk", 4, 6, []string{"drink", "glass", "glass of milk", "milk"}, false, 0, "3.0", nil},
+	{[]rune{0x2615}, "hot beverage", 4, 6, []string{"beverage", "coffee", "drink", "hot", "steaming", "tea"}, false, 0, "0.6", nil},
+	{[]rune{0x1fad6}, "teapot", 4, 6, []string{"drink", "pot", "tea", "teapot"}, false, 0, "13.0", nil},
+	{[]rune{0x1f375}, "teacup without handle", 4, 6, []string{"beverage", "cup", "drink", "tea", "teacup", "teacup without handle"}, false, 0, "0.6", nil},
+	{[]rune{0x1f376}, "sake", 4, 6, []string{"bar", "beverage", "bottle", "cup", "drink", "sake"}, false, 0, "0.6", nil},
+	{[]rune{0x1f37e}, "bottle with popping cork", 4, 6, []string{"bar", "bottle", "bottle with popping cork", "cork", "drink", "popping"}, false, 0, "1.0", nil},
+	{[]rune{0x1f377}, "wine glass", 4, 6, []string{"bar", "beverage", "drink", "glass", "wine"}, false, 0, "0.6", nil},
+	{[]rune{0x1f378}, "cocktail glass", 4, 6, []string{"bar", "cocktail", "drink", "glass"}, false, 0, "0.6", nil},
+	{[]rune{0x1f379}, "tropical drink", 4, 6, []string{"bar", "drink", "tropical"}, false, 0, "0.6", nil},
+	{[]rune{0x1f37a}, "beer mug", 4, 6, []string{"bar", "beer", "drink", "mug"}, false, 0, "0.6", nil},
+	{[]rune{0x1f37b}, "clinking beer mugs", 4, 6, []string{"bar", "beer", "clink", "clinking beer mugs", "drink", "mug"}, false, 0, "0.6", nil},
+	{[]rune{0x1f942}, "clinking glasses", 4, 6, []string{"celebrate", "clink", "clinking glasses", "drink", "glass"}, false, 0, "3.0", nil},
+	{[]rune{0x1f943}, "tumbler glass", 4, 6, []string{"glass", "liquor", "shot", "tumbler", "whisky"}, false, 0, "3.0", nil},
+	{[]rune{0x1fad7}, "pouring liquid", 4, 6, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f964}, "cup with straw", 4, 6, []string{"cup with straw", "juice", "soda"}, false, 0, "5.0", nil},
+	{[]rune{0x1f9cb}, "bubble tea", 4, 6, []string{"bubble", "milk", "pearl", "tea"}, false, 0, "13.0", nil},
+	{[]rune{0x1f9c3}, "beverage box", 4, 6, []string{"beverage", "box", "juice", "straw", "sweet"}, false, 0, "12.0", nil},
+	{[]rune{0x1f9c9}, "mate", 4, 6, []string{"drink", "mate"}, false, 0, "12.0", nil},
+	{[]rune{0x1f9ca}, "ice", 4, 6, []string{"cold", "ice", "ice cube", "iceberg"}, false, 0, "12.0", nil},
+	{[]rune{0x1f962}, "chopsticks", 4, 7, []string{"chopsticks", "hashi"}, false, 0, "5.0", nil},
+	{[]rune{0x1f37d, 0xfe0f}, "fork and knife with plate", 4, 7, []string{"cooking", "fork", "fork and knife with plate", "knife", "plate"}, false, 0, "0.7", nil},
+	{[]rune{0x1f374}, "fork and knife", 4, 7, []string{"cooking", "cutlery", "fork", "fork and knife", "knife"}, false, 0, "0.6", nil},
+	{[]rune{0x1f944}, "spoon", 4, 7, []string{"spoon", "tableware"}, false, 0, "3.0", nil},
+	{[]rune{0x1f52a}, "kitchen knife", 4, 7, []string{"cooking", "hocho", "kitchen knife", "knife", "tool", "weapon"}, false, 0, "0.6", nil},
+	{[]rune{0x1fad9}, "jar", 4, 7, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f3fa}, "amphora", 4, 7, []string{"amphora", "Aquarius", "cooking", "drink", "jug", "zodiac"}, false, 0, "1.0", nil},
+	{[]rune{0x1f30d}, "globe showing Europe-Africa", 5, 0, []string{"Africa", "earth", "Europe", "globe", "globe showing Europe-Africa", "world"}, false, 0, "0.7", nil},
+	{[]rune{0x1f30e}, "globe showing Americas", 5, 0, []string{"Americas", "earth", "globe", "globe showing Americas", "world"}, false, 0, "0.7", nil},
+	{[]rune{0x1f30f}, "globe showing Asia-Australia", 5, 0, []string{"Asia", "Australia", "earth", "globe", "globe showing Asia-Australia", "world"}, false, 0, "0.6", nil},
+	{[]rune{0x1f310}, "globe with meridians", 5, 0, []string{"earth", "globe", "globe with meridians", "meridians", "world"}, false, 0, "1.0", nil},
+	{[]rune{0x1f5fa, 0xfe0f}, "world map", 5, 0, []string{"map", "world"}, false, 0, "0.7", nil},
+	{[]rune{0x1f5fe}, "map of Japan", 5, 0, []string{"Japan", "map", "map of Japan"}, false, 0, "0.6", nil},
+	{[]rune{0x1f9ed}, "compass", 5, 0, []string{"compass", "magnetic", "navigation", "orienteering"}, false, 0, "11.0", nil},
+	{[]rune{0x1f3d4, 0xfe0f}, "snow-capped mountain", 5, 1, []string{"cold", "mountain", "snow", "snow-capped mountain"}, false, 0, "0.7", nil},
+	{[]rune{0x26f0, 0xfe0f}, "mountain", 5, 1, []string{"mountain"}, false, 0, "0.7", nil},
+	{[]rune{0x1f30b}, "volcano", 5, 1, []string{"eruption", "mountain", "volcano"}, false, 0, "0.6", nil},
+	{[]rune{0x1f5fb}, "mount fuji", 5, 1, []string{"fuji", "mount fuji", "mountain"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3d5, 0xfe0f}, "camping", 5, 1, []string{"camping"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3d6, 0xfe0f}, "beach with umbrella", 5, 1, []string{"beach", "beach with umbrella", "umbrella"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3dc, 0xfe0f}, "desert", 5, 1, []string{"desert"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3dd, 0xfe0f}, "desert island", 5, 1, []string{"desert", "island"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3de, 0xfe0f}, "national park", 5, 1, []string{"national park", "park"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3df, 0xfe0f}, "stadium", 5, 2, []string{"stadium"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3db, 0xfe0f}, "classical building", 5, 2, []string{"classical", "classical building"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3d7, 0xfe0f}, "building construction", 5, 2, []string{"building construction", "construction"}, false, 0, "0.7", nil},
+	{[]rune{0x1f9f1}, "brick", 5, 2, []string{"brick", "bricks", "clay", "mortar", "wall"}, false, 0, "11.0", nil},
+	{[]rune{0x1faa8}, "rock", 5, 2, []string{"boulder", "heavy", "rock", "solid", "stone"}, false, 0, "13.0", nil},
+	{[]rune{0x1fab5}, "wood", 5, 2, []string{"log", "lumber", "timber", "wood"}, false, 0, "13.0", nil},
+	{[]rune{0x1f6d6}, "hut", 5, 2, []string{"house", "hut", "roundhouse", "yurt"}, false, 0, "13.0", nil},
+	{[]rune{0x1f3d8, 0xfe0f}, "houses", 5, 2, []string{"houses"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3da, 0xfe0f}, "derelict house", 5, 2, []string{"derelict", "house"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3e0}, "house", 5, 2, []string{"home", "house"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3e1}, "house with garden", 5, 2, []string{"garden", "home", "house", "house with garden"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3e2}, "office building", 5, 2, []string{"building", "office building"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3e3}, "Japanese post office", 5, 2, []string{"Japanese", "Japanese post office", "post"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3e4}, "post office", 5, 2, []string{"European", "post", "post office"}, false, 0, "1.0", nil},
+	{[]rune{0x1f3e5}, "hospital", 5, 2, []string{"doctor", "hospital", "medicine"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3e6}, "bank", 5, 2, []string{"bank", "building"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3e8}, "hotel", 5, 2, []string{"building", "hotel"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3e9}, "love hotel", 5, 2, []string{"hotel", "love"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3ea}, "convenience store", 5, 2, []string{"convenience", "store"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3eb}, "school", 5, 2, []string{"building", "school"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3ec}, "department store", 5, 2, []string{"department", "store"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3ed}, "factory", 5, 2, []string{"building", "factory"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3ef}, "Japanese castle", 5, 2, []string{"castle", "Japanese"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3f0}, "castle", 5, 2, []string{"castle", "European"}, false, 0, "0.6", nil},
+	{[]rune{0x1f492}, "wedding", 5, 2, []string{"chapel", "romance", "wedding"}, false, 0, "0.6", nil},
+	{[]rune{0x1f5fc}, "Tokyo tower", 5, 2, []string{"Tokyo", "tower"}, false, 0, "0.6", nil},
+	{[]rune{0x1f5fd}, "Statue of Liberty", 5, 2, []string{"liberty", "statue", "Statue of Liberty"}, false, 0, "0.6", nil},
+	{[]rune{0x26ea}, "church", 5, 3, []string{"Christian", "church", "cross", "religion"}, false, 0, "0.6", nil},
+	{[]rune{0x1f54c}, "mosque", 5, 3, []string{"islam", "mosque", "Muslim", "religion"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6d5}, "hindu temple", 5, 3, []string{"hindu", "temple"}, false, 0, "12.0", nil},
+	{[]rune{0x1f54d}, "synagogue", 5, 3, []string{"Jew", "Jewish", "religion", "synagogue", "temple"}, false, 0, "1.0", nil},
+	{[]rune{0x26e9, 0xfe0f}, "shinto shrine", 5, 3, []string{"religion", "shinto", "shrine"}, false, 0, "0.7", nil},
+	{[]rune{0x1f54b}, "kaaba", 5, 3, []string{"islam", "kaaba", "Muslim", "religion"}, false, 0, "1.0", nil},
+	{[]rune{0x26f2}, "fountain", 5, 4, []string{"fountain"}, false, 0, "0.6", nil},
+	{[]rune{0x26fa}, "tent", 5, 4, []string{"camping", "tent"}, false, 0, "0.6", nil},
+	{[]rune{0x1f301}, "foggy", 5, 4, []string{"fog", "foggy"}, false, 0, "0.6", nil},
+	{[]rune{0x1f303}, "night with stars", 5, 4, []string{"night", "night with stars", "star"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3d9, 0xfe0f}, "cityscape", 5, 4, []string{"city", "cityscape"}, false, 0, "0.7", nil},
+	{[]rune{0x1f304}, "sunrise over mountains", 5, 4, []string{"morning", "mountain", "sun", "sunrise", "sunrise over mountains"}, false, 0, "0.6", nil},
+	{[]rune{0x1f305}, "sunrise", 5, 4, []string{"morning", "sun", "sunrise"}, false, 0, "0.6", nil},
+	{[]rune{0x1f306}, "cityscape at dusk", 5, 4, []string{"city", "cityscape at dusk", "dusk", "evening", "landscape", "sunset"}, false, 0, "0.6", nil},
+	{[]rune{0x1f307}, "sunset", 5, 4, []string{"dusk", "sun", "sunset"}, false, 0, "0.6", nil},
+	{[]rune{0x1f309}, "bridge at night", 5, 4, []string{"bridge", "bridge at night", "night"}, false, 0, "0.6", nil},
+	{[]rune{0x2668, 0xfe0f}, "hot springs", 5, 4, []string{"hot", "hotsprings", "springs", "steaming"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3a0}, "carousel horse", 5, 4, []string{"carousel", "horse"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6dd}, "playground slide", 5, 4, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f3a1}, "ferris wheel", 5, 4, []string{"amusement park", "ferris", "wheel"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3a2}, "roller coaster", 5, 4, []string{"amusement park", "coaster", "roller"}, false, 0, "0.6", nil},
+	{[]rune{0x1f488}, "barber pole", 5, 4, []string{"barber", "haircut", "pole"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3aa}, "circus tent", 5, 4, []string{"circus", "tent"}, false, 0, "0.6", nil},
+	{[]rune{0x1f682}, "locomotive", 5, 5, []string{"engine", "locomotive", "railway", "steam", "train"}, false, 0, "1.0", nil},
+	{[]rune{0x1f683}, "railway car", 5, 5, []string{"car", "electric", "railway", "train", "tram", "trolleybus"}, false, 0, "0.6", nil},
+	{[]rune{0x1f684}, "high-speed train", 5, 5, []string{"high-speed train", "railway", "shinkansen", "speed", "train"}, false, 0, "0.6", nil},
+	{[]rune{0x1f685}, "bullet train", 5, 5, []string{"bullet", "railway", "shinkansen", "speed", "train"}, false, 0, "0.6", nil},
+	{[]rune{0x1f686}, "train", 5, 5, []string{"railway", "train"}, false, 0, "1.0", nil},
+	{[]rune{0x1f687}, "metro", 5, 5, []string{"metro", "subway"}, false, 0, "0.6", nil},
+	{[]rune{0x1f688}, "light rail", 5, 5, []string{"light rail", "railway"}, false, 0, "1.0", nil},
+	{[]rune{0x1f689}, "station", 5, 5, []string{"railway", "station", "train"}, false, 0, "0.6", nil},
+	{[]rune{0x1f68a}, "tram", 5, 5, []string{"tram", "trolleybus"}, false, 0, "1.0", nil},
+	{[]rune{0x1f69d}, "monorail", 5, 5, []string{"monorail", "vehicle"}, false, 0, "1.0", nil},
+	{[]rune{0x1f69e}, "mountain railway", 5, 5, []string{"car", "mountain", "railway"}, false, 0, "1.0", nil},
+	{[]rune{0x1f68b}, "tram car", 5, 5, []string{"car", "tram", "trolleybus"}, false, 0, "1.0", nil},
+	{[]rune{0x1f68c}, "bus", 5, 5, []string{"bus", "vehicle"}, false, 0, "0.6", nil},
+	{[]rune{0x1f68d}, "oncoming bus", 5, 5, []string{"bus", "oncoming"}, false, 0, "0.7", nil},
+	{[]rune{0x1f68e}, "trolleybus", 5, 5, []string{"bus", "tram", "trolley", "trolleybus"}, false, 0, "1.0", nil},
+	{[]rune{0x1f690}, "minibus", 5, 5, []string{"bus", "minibus"}, false, 0, "1.0", nil},
+	{[]rune{0x1f691}, "ambulance", 5, 5, []string{"ambulance", "vehicle"}, false, 0, "0.6", nil},
+	{[]rune{0x1f692}, "fire engine", 5, 5, []string{"engine", "fire", "truck"}, false, 0, "0.6", nil},
+	{[]rune{0x1f693}, "police car", 5, 5, []string{"car", "patrol", "police"}, false, 0, "0.6", nil},
+	{[]rune{0x1f694}, "oncoming police car", 5, 5, []string{"car", "oncoming", "police"}, false, 0, "0.7", nil},
+	{[]rune{0x1f695}, "taxi", 5, 5, []string{"taxi", "vehicle"}, false, 0, "0.6", nil},
+	{[]rune{0x1f696}, "oncoming taxi", 5, 5, []string{"oncoming", "taxi"}, false, 0, "1.0", nil},
+	{[]rune{0x1f697}, "automobile", 5, 5, []string{"automobile", "car"}, false, 0, "0.6", nil},
+	{[]rune{0x1f698}, "oncoming automobile", 5, 5, []string{"automobile", "car", "oncoming"}, false, 0, "0.7", nil},
+	{[]rune{0x1f699}, "sport utility vehicle", 5, 5, []string{"recreational", "sport utility", "sport utility vehicle"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6fb}, "pickup truck", 5, 5, []string{"pick-up", "pickup", "truck"}, false, 0, "13.0", nil},
+	{[]rune{0x1f69a}, "delivery truck", 5, 5, []string{"delivery", "truck"}, false, 0, "0.6", nil},
+	{[]rune{0x1f69b}, "articulated lorry", 5, 5, []string{"articulated lorry", "lorry", "semi", "truck"}, false, 0, "1.0", nil},
+	{[]rune{0x1f69c}, "tractor", 5, 5, []string{"tractor", "vehicle"}, false, 0, "1.0", nil},
+	{[]rune{0x1f3ce, 0xfe0f}, "racing car", 5, 5, []string{"car", "racing"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3cd, 0xfe0f}, "motorcycle", 5, 5, []string{"motorcycle", "racing"}, false, 0, "0.7", nil},
+	{[]rune{0x1f6f5}, "motor scooter", 5, 5, []string{"motor", "scooter"}, false, 0, "3.0", nil},
+	{[]rune{0x1f9bd}, "manual wheelchair", 5, 5, []string{"accessibility", "manual wheelchair"}, false, 0, "12.0", nil},
+	{[]rune{0x1f9bc}, "motorized wheelchair", 5, 5, []string{"accessibility", "motorized wheelchair"}, false, 0, "12.0", nil},
+	{[]rune{0x1f6fa}, "auto rickshaw", 5, 5, []string{"auto rickshaw", "tuk tuk"}, false, 0, "12.0", nil},
+	{[]rune{0x1f6b2}, "bicycle", 5, 5, []string{"bicycle", "bike"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6f4}, "kick scooter", 5, 5, []string{"kick", "scooter"}, false, 0, "3.0", nil},
+	{[]rune{0x1f6f9}, "skateboard", 5, 5, []string{"board", "skateboard"}, false, 0, "11.0", nil},
+	{[]rune{0x1f6fc}, "roller skate", 5, 5, []string{"roller", "skate"}, false, 0, "13.0", nil},
+	{[]rune{0x1f68f}, "bus stop", 5, 5, []string{"bus", "busstop", "stop"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6e3, 0xfe0f}, "motorway", 5, 5, []string{"highway", "motorway", "road"}, false, 0, "0.7", nil},
+	{[]rune{0x1f6e4, 0xfe0f}, "railway track", 5, 5, []string{"railway", "railway track", "train"}, false, 0, "0.7", nil},
+	{[]rune{0x1f6e2, 0xfe0f}, "oil drum", 5, 5, []string{"drum", "oil"}, false, 0, "0.7", nil},
+	{[]rune{0x26fd}, "fuel pump", 5, 5, []string{"diesel", "fuel", "fuelpump", "gas", "pump", "station"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6de}, "wheel", 5, 5, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f6a8}, "police car light", 5, 5, []string{"beacon", "car", "light", "police", "revolving"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6a5}, "horizontal traffic light", 5, 5, []string{"horizontal traffic light", "light", "signal", "traffic"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6a6}, "vertical traffic light", 5, 5, []string{"light", "signal", "traffic", "vertical traffic light"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6d1}, "stop sign", 5, 5, []string{"octagonal", "sign", "stop"}, false, 0, "3.0", nil},
+	{[]rune{0x1f6a7}, "construction", 5, 5, []string{"barrier", "construction"}, false, 0, "0.6", nil},
+	{[]rune{0x2693}, "anchor", 5, 6, []string{"anchor", "ship", "tool"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6df}, "ring buoy", 5, 6, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x26f5}, "sailboat", 5, 6, []string{"boat", "resort", "sailboat", "sea", "yacht"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6f6}, "canoe", 5, 6, []string{"boat", "canoe"}, false, 0, "3.0", nil},
+	{[]rune{0x1f6a4}, "speedboat", 5, 6, []string{"boat", "speedboat"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6f3, 0xfe0f}, "passenger ship", 5, 6, []string{"passenger", "ship"}, false, 0, "0.7", nil},
+	{[]rune{0x26f4, 0xfe0f}, "ferry", 5, 6, []string{"boat", "ferry", "passenger"}, false, 0, "0.7", nil},
+	{[]rune{0x1f6e5, 0xfe0f}, "motor boat", 5, 6, []string{"boat", "motor boat", "motorboat"}, false, 0, "0.7", nil},
+	{[]rune{0x1f6a2}, "ship", 5, 6, []string{"boat", "passenger", "ship"}, false, 0, "0.6", nil},
+	{[]rune{0x2708, 0xfe0f}, "airplane", 5, 7, []string{"aeroplane", "airplane"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6e9, 0xfe0f}, "small airplane", 5, 7, []string{"aeroplane", "airplane", "small airplane"}, false, 0, "0.7", nil},
+	{[]rune{0x1f6eb}, "airplane departure", 5, 7, []string{"aeroplane", "airplane", "check-in", "departure", "departures"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6ec}, "airplane arrival", 5, 7, []string{"aeroplane", "airplane", "airplane arrival", "arrivals", "arriving", "landing"}, false, 0, "1.0", nil},
+	{[]rune{0x1fa82}, "parachute", 5, 7, []string{"hang-glide", "parachute", "parasail", "skydive"}, false, 0, "12.0", nil},
+	{[]rune{0x1f4ba}, "seat", 5, 7, []string{"chair", "seat"}, false, 0, "0.6", nil},
+	{[]rune{0x1f681}, "helicopter", 5, 7, []string{"helicopter", "vehicle"}, false, 0, "1.0", nil},
+	{[]rune{0x1f69f}, "suspension railway", 5, 7, []string{"railway", "suspension"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6a0}, "mountain cableway", 5, 7, []string{"cable", "gondola", "mountain", "mountain cableway"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6a1}, "aerial tramway", 5, 7, []string{"aerial", "cable", "car", "gondola", "tramway"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6f0, 0xfe0f}, "satellite", 5, 7, []string{"satellite", "space"}, false, 0, "0.7", nil},
+	{[]rune{0x1f680}, "rocket", 5, 7, []string{"rocket", "space"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6f8}, "flying saucer", 5, 7, []string{"flying saucer", "UFO"}, false, 0, "5.0", nil},
+	{[]rune{0x1f6ce, 0xfe0f}, "bellhop bell", 5, 8, []string{"bell", "bellhop", "hotel"}, false, 0, "0.7", nil},
+	{[]rune{0x1f9f3}, "luggage", 5, 8, []string{"luggage", "packing", "travel"}, false, 0, "11.0", nil},
+	{[]rune{0x231b}, "hourglass done", 5, 9, []string{"hourglass done", "sand", "timer"}, false, 0, "0.6", nil},
+	{[]rune{0x23f3}, "hourglass not done", 5, 9, []string{"hourglass", "hourglass not done", "sand", "timer"}, false, 0, "0.6", nil},
+	{[]rune{0x231a}, "watch", 5, 9, []string{"clock", "watch"}, false, 0, "0.6", nil},
+	{[]rune{0x23f0}, "alarm clock", 5, 9, []string{"alarm", "clock"}, false, 0, "0.6", nil},
+	{[]rune{0x23f1, 0xfe0f}, "stopwatch", 5, 9, []string{"clock", "stopwatch"}, false, 0, "1.0", nil},
+	{[]rune{0x23f2, 0xfe0f}, "timer clock", 5, 9, []string{"clock", "timer"}, false, 0, "1.0", nil},
+	{[]rune{0x1f570, 0xfe0f}, "mantelpiece clock", 5, 9, []string{"clock", "mantelpiece clock"}, false, 0, "0.7", nil},
+	{[]rune{0x1f55b}, "twelve o’clock", 5, 9, []string{"00", "12", "12:00", "clock", "o’clock", "twelve"}, false, 0, "0.6", nil},
+	{[]rune{0x1f567}, "twelve-thirty", 5, 9, []string{"12", "12:30", "clock", "thirty", "twelve", "twelve-thirty"}, false, 0, "0.7", nil},
+	{[]rune{0x1f550}, "one o’clock", 5, 9, []string{"00", "1", "1:00", "clock", "o’clock", "one"}, false, 0, "0.6", nil},
+	{[]rune{0x1f55c}, "one-thirty", 5, 9, []string{"1", "1:30", "clock", "one", "one-thirty", "thirty"}, false, 0, "0.7", nil},
+	{[]rune{0x1f551}, "two o’clock", 5, 9, []string{"00", "2", "2:00", "clock", "o’clock", "two"}, false, 0, "0.6", nil},
+	{[]rune{0x1f55d}, "two-thirty", 5, 9, []string{"2", "2:30", "clock", "thirty", "two", "two-thirty"}, false, 0, "0.7", nil},
+	{[]rune{0x1f552}, "three o’clock", 5, 9, []string{"00", "3", "3:00", "clock", "o’clock", "three"}, false, 0, "0.6", nil},
+	{[]rune{0x1f55e}, "three-thirty", 5, 9, []string{"3", "3:30", "clock", "thirty", "three", "three-thirty"}, false, 0, "0.7", nil},
+	{[]rune{0x1f553}, "four o’clock", 5, 9, []string{"00", "4", "4:00", "clock", "four", "o’clock"}, false, 0, "0.6", nil},
+	{[]rune{0x1f55f}, "four-thirty", 5, 9, []string{"4", "4:30", "clock", "four", "four-thirty", "thirty"}, false, 0, "0.7", nil},
+	{[]rune{0x1f554}, "five o’clock", 5, 9, []string{"00", "5", "5:00", "clock", "five", "o’clock"}, false, 0, "0.6", nil},
+	{[]rune{0x1f560}, "five-thirty", 5, 9, []string{"5", "5:30", "clock", "five", "five-thirty", "thirty"}, false, 0, "0.7", nil},
+	{[]rune{0x1f555}, "six o’clock", 5, 9, []string{"00", "6", "6:00", "clock", "o’clock", "six"}, false, 0, "0.6", nil},
+	{[]rune{0x1f561}, "six-thirty", 5, 9, []string{"6", "6:30", "clock", "six", "six-thirty", "thirty"}, false, 0, "0.7", nil},
+	{[]rune{0x1f556}, "seven o’clock", 5, 9, []string{"00", "7", "7:00", "clock", "o’clock", "seven"}, false, 0, "0.6", nil},
+	{[]rune{0x1f562}, "seven-thirty", 5, 9, []string{"7", "7:30", "clock", "seven", "seven-thirty", "thirty"}, false, 0, "0.7", nil},
+	{[]rune{0x1f557}, "eight o’clock", 5, 9, []string{"00", "8", "8:00", "clock", "eight", "o’clock"}, false, 0, "0.6", nil},
+	{[]rune{0x1f563}, "eight-thirty", 5, 9, []string{"8", "8:30", "clock", "eight", "eight-thirty", "thirty"}, false, 0, "0.7", nil},
+	{[]rune{0x1f558}, "nine o’clock", 5, 9, []string{"00", "9", "9:00", "clock", "nine", "o’clock"}, false, 0, "0.6", nil},
+	{[]rune{0x1f564}, "nine-thirty", 5, 9, []string{"9", "9:30", "clock", "nine", "nine-thirty", "thirty"}, false, 0, "0.7", nil},
+	{[]rune{0x1f559}, "ten o’clock", 5, 9, []string{"00", "10", "10:00", "clock", "o’clock", "ten"}, false, 0, "0.6", nil},
+	{[]rune{0x1f565}, "ten-thirty", 5, 9, []string{"10", "10:30", "clock", "ten", "ten-thirty", "thirty"}, false, 0, "0.7", nil},
+	{[]rune{0x1f55a}, "eleven o’clock", 5, 9, []string{"00", "11", "11:00", "clock", "eleven", "o’clock"}, false, 0, "0.6", nil},
+	{[]rune{0x1f566}, "eleven-thirty", 5, 9, []string{"11", "11:30", "clock", "eleven", "eleven-thirty", "thirty"}, false, 0, "0.7", nil},
+	{[]rune{0x1f311}, "new moon", 5, 10, []string{"dark", "moon", "new moon"}, false, 0, "0.6", nil},
+	{[]rune{0x1f312}, "waxing crescent moon", 5, 10, []string{"crescent", "moon", "waxing"}, false, 0, "1.0", nil},
+	{[]rune{0x1f313}, "first quarter moon", 5, 10, []string{"first quarter moon", "moon", "quarter"}, false, 0, "0.6", nil},
+	{[]rune{0x1f314}, "waxing gibbous moon", 5, 10, []string{"gibbous", "moon", "waxing"}, false, 0, "0.6", nil},
+	{[]rune{0x1f315}, "full moon", 5, 10, []string{"full", "moon"}, false, 0, "0.6", nil},
+	{[]rune{0x1f316}, "waning gibbous moon", 5, 10, []string{"gibbous", "moon", "waning"}, false, 0, "1.0", nil},
+	{[]rune{0x1f317}, "last quarter moon", 5, 10, []string{"last quarter moon", "moon", "quarter"}, false, 0, "1.0", nil},
+	{[]rune{0x1f318}, "waning crescent moon", 5, 10, []string{"crescent", "moon", "waning"}, false, 0, "1.0", nil},
+	{[]rune{0x1f319}, "crescent moon", 5, 10, []string{"crescent", "moon"}, false, 0, "0.6", nil},
+	{[]rune{0x1f31a}, "new moon face", 5, 10, []string{"face", "moon", "new moon face"}, false, 0, "1.0", nil},
+	{[]rune{0x1f31b}, "first quarter moon face", 5, 10, []string{"face", "first quarter moon face", "moon", "quarter"}, false, 0, "0.6", nil},
+	{[]rune{0x1f31c}, "last quarter moon face", 5, 10, []string{"face", "last quarter moon face", "moon", "quarter"}, false, 0, "0.7", nil},
+	{[]rune{0x1f321, 0xfe0f}, "thermometer", 5, 10, []string{"thermometer", "weather"}, false, 0, "0.7", nil},
+	{[]rune{0x2600, 0xfe0f}, "sun", 5, 10, []string{"bright", "rays", "sun", "sunny"}, false, 0, "0.6", nil},
+	{[]rune{0x1f31d}, "full moon face", 5, 10, []string{"bright", "face", "full", "moon"}, false, 0, "1.0", nil},
+	{[]rune{0x1f31e}, "sun with face", 5, 10, []string{"bright", "face", "sun", "sun with face"}, false, 0, "1.0", nil},
+	{[]rune{0x1fa90}, "ringed planet", 5, 10, []string{"ringed planet", "saturn", "saturnine"}, false, 0, "12.0", nil},
+	{[]rune{0x2b50}, "star", 5, 10, []string{"star"}, false, 0, "0.6", nil},
+	{[]rune{0x1f31f}, "glowing star", 5, 10, []string{"glittery", "glow", "glowing star", "shining", "sparkle", "star"}, false, 0, "0.6", nil},
+	{[]rune{0x1f320}, "shooting star", 5, 10, []string{"falling", "shooting", "star"}, false, 0, "0.6", nil},
+	{[]rune{0x1f30c}, "milky way", 5, 10, []string{"milky way", "space"}, false, 0, "0.6", nil},
+	{[]rune{0x2601, 0xfe0f}, "cloud", 5, 10, []string{"cloud", "weather"}, false, 0, "0.6", nil},
+	{[]rune{0x26c5}, "sun behind cloud", 5, 10, []string{"cloud", "sun", "sun behind cloud"}, false, 0, "0.6", nil},
+	{[]rune{0x26c8, 0xfe0f}, "cloud with lightning and rain", 5, 10, []string{"cloud", "cloud with lightning and rain", "rain", "thunder"}, false, 0, "0.7", nil},
+	{[]rune{0x1f324, 0xfe0f}, "sun behind small cloud", 5, 10, []string{"cloud", "sun", "sun behind small cloud"}, false, 0, "0.7", nil},
+	{[]rune{0x1f325, 0xfe0f}, "sun behind large cloud", 5, 10, []string{"cloud", "sun", "sun behind large cloud"}, false, 0, "0.7", nil},
+	{[]rune{0x1f326, 0xfe0f}, "sun behind rain cloud", 5, 10, []string{"cloud", "rain", "sun", "sun behind rain cloud"}, false, 0, "0.7", nil},
+	{[]rune{0x1f327, 0xfe0f}, "cloud with rain", 5, 10, []string{"cloud", "cloud with rain", "rain"}, false, 0, "0.7", nil},
+	{[]rune{0x1f328, 0xfe0f}, "cloud with snow", 5, 10, []string{"cloud", "cloud with snow", "cold", "snow"}, false, 0, "0.7", nil},
+	{[]rune{0x1f329, 0xfe0f}, "cloud with lightning", 5, 10, []string{"cloud", "cloud with lightning", "lightning"}, false, 0, "0.7", nil},
+	{[]rune{0x1f32a, 0xfe0f}, "tornado", 5, 10, []string{"cloud", "tornado", "whirlwind"}, false, 0, "0.7", nil},
+	{[]rune{0x1f32b, 0xfe0f}, "fog", 5, 10, []string{"cloud", "fog"}, false, 0, "0.7", nil},
+	{[]rune{0x1f32c, 0xfe0f}, "wind face", 5, 10, []string{"blow", "cloud", "face", "wind"}, false, 0, "0.7", nil},
+	{[]rune{0x1f300}, "cyclone", 5, 10, []string{"cyclone", "dizzy", "hurricane", "twister", "typhoon"}, false, 0, "0.6", nil},
+	{[]rune{0x1f308}, "rainbow", 5, 10, []string{"rain", "rainbow"}, false, 0, "0.6", nil},
+	{[]rune{0x1f302}, "closed umbrella", 5, 10, []string{"closed umbrella", "clothing", "rain", "umbrella"}, false, 0, "0.6", nil},
+	{[]rune{0x2602, 0xfe0f}, "umbrella", 5, 10, []string{"clothing", "rain", "umbrella"}, false, 0, "0.7", nil},
+	{[]rune{0x2614}, "umbrella with rain drops", 5, 10, []string{"clothing", "drop", "rain", "umbrella", "umbrella with rain drops"}, false, 0, "0.6", nil},
+	{[]rune{0x26f1, 0xfe0f}, "umbrella on ground", 5, 10, []string{"rain", "sun", "umbrella", "umbrella on ground"}, false, 0, "0.7", nil},
+	{[]rune{0x26a1}, "high voltage", 5, 10, []string{"danger", "electric", "high voltage", "lightning", "voltage", "zap"}, false, 0, "0.6", nil},
+	{[]rune{0x2744, 0xfe0f}, "snowflake", 5, 10, []string{"cold", "snow", "snowflake"}, false, 0, "0.6", nil},
+	{[]rune{0x2603, 0xfe0f}, "snowman", 5, 10, []string{"cold", "snow", "snowman"}, false, 0, "0.7", nil},
+	{[]rune{0x26c4}, "snowman without snow", 5, 10, []string{"cold", "snow", "snowman", "snowman without snow"}, false, 0, "0.6", nil},
+	{[]rune{0x2604, 0xfe0f}, "comet", 5, 10, []string{"comet", "space"}, false, 0, "1.0", nil},
+	{[]rune{0x1f525}, "fire", 5, 10, []string{"fire", "flame", "tool"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4a7}, "droplet", 5, 10, []string{"cold", "comic", "drop", "droplet", "sweat"}, false, 0, "0.6", nil},
+	{[]rune{0x1f30a}, "water wave", 5, 10, []string{"ocean", "water", "wave"}, false, 0, "0.6", nil},
+	{[]rune{0x1f383}, "jack-o-lantern", 6, 0, []string{"celebration", "halloween", "jack", "jack-o-lantern", "lantern"}, false, 0, "0.6", nil},
+	{[]rune{0x1f384}, "Christmas tree", 6, 0, []string{"celebration", "Christmas", "tree"}, false, 0, "0.6", nil},
+	{[]rune{0x1f386}, "fireworks", 6, 0, []string{"celebration", "fireworks"}, false, 0, "0.6", nil},
+	{[]rune{0x1f387}, "sparkler", 6, 0, []string{"celebration", "fireworks", "sparkle", "sparkler"}, false, 0, "0.6", nil},
+	{[]rune{0x1f9e8}, "firecracker", 6, 0, []string{"dynamite", "explosive", "firecracker", "fireworks"}, false, 0, "11.0", nil},
+	{[]rune{0x2728}, "sparkles", 6, 0, []string{"*", "sparkle", "sparkles", "star"}, false, 0, "0.6", nil},
+	{[]rune{0x1f388}, "balloon", 6, 0, []string{"balloon", "celebration"}, false, 0, "0.6", nil},
+	{[]rune{0x1f389}, "party popper", 6, 0, []string{"celebration", "party", "popper", "tada"}, false, 0, "0.6", nil},
+	{[]rune{0x1f38a}, "confetti ball", 6, 0, []string{"ball", "celebration", "confetti"}, false, 0, "0.6", nil},
+	{[]rune{0x1f38b}, "tanabata tree", 6, 0, []string{"banner", "celebration", "Japanese", "tanabata tree", "tree"}, false, 0, "0.6", nil},
+	{[]rune{0x1f38d}, "pine decoration", 6, 0, []string{"bamboo", "celebration", "Japanese", "pine", "pine decoration"}, false, 0, "0.6", nil},
+	{[]rune{0x1f38e}, "Japanese dolls", 6, 0, []string{"celebration", "doll", "festival", "Japanese", "Japanese dolls"}, false, 0, "0.6", nil},
+	{[]rune{0x1f38f}, "carp streamer", 6, 0, []string{"carp", "celebration", "streamer"}, false, 0, "0.6", nil},
+	{[]rune{0x1f390}, "wind chime", 6, 0, []string{"bell", "celebration", "chime", "wind"}, false, 0, "0.6", nil},
+	{[]rune{0x1f391}, "moon viewing ceremony", 6, 0, []string{"celebration", "ceremony", "moon", "moon viewing ceremony"}, false, 0, "0.6", nil},
+	{[]rune{0x1f9e7}, "red envelope", 6, 0, []string{"gift", "good luck", "hóngbāo", "lai see", "money", "red envelope"}, false, 0, "11.0", nil},
+	{[]rune{0x1f380}, "ribbon", 6, 0, []string{"celebration", "ribbon"}, false, 0, "0.6", nil},
+	{[]rune{0x1f381}, "wrapped gift", 6, 0, []string{"box", "celebration", "gift", "present", "wrapped"}, false, 0, "0.6", nil},
+	{[]rune{0x1f397, 0xfe0f}, "reminder ribbon", 6, 0, []string{"celebration", "reminder", "ribbon"}, false, 0, "0.7", nil},
+	{[]rune{0x1f39f, 0xfe0f}, "admission tickets", 6, 0, []string{"admission", "admission tickets", "ticket"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3ab}, "ticket", 6, 0, []string{"admission", "ticket"}, false, 0, "0.6", nil},
+	{[]rune{0x1f396, 0xfe0f}, "military medal", 6, 1, []string{"celebration", "medal", "military"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3c6}, "trophy", 6, 1, []string{"prize", "trophy"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3c5}, "sports medal", 6, 1, []string{"medal", "sports medal"}, false, 0, "1.0", nil},
+	{[]rune{0x1f947}, "1st place medal", 6, 1, []string{"1st place medal", "first", "gold", "medal"}, false, 0, "3.0", nil},
+	{[]rune{0x1f948}, "2nd place medal", 6, 1, []string{"2nd place medal", "medal", "second", "silver"}, false, 0, "3.0", nil},
+	{[]rune{0x1f949}, "3rd place medal", 6, 1, []string{"3rd place medal", "bronze", "medal", "third"}, false, 0, "3.0", nil},
+	{[]rune{0x26bd}, "soccer ball", 6, 2, []string{"ball", "football", "soccer"}, false, 0, "0.6", nil},
+	{[]rune{0x26be}, "baseball", 6, 2, []string{"ball", "baseball"}, false, 0, "0.6", nil},
+	{[]rune{0x1f94e}, "softball", 6, 2, []string{"ball", "glove", "softball", "underarm"}, false, 0, "11.0", nil},
+	{[]rune{0x1f3c0}, "basketball", 6, 2, []string{"ball", "basketball", "hoop"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3d0}, "volleyball", 6, 2, []string{"ball", "game", "volleyball"}, false, 0, "1.0", nil},
+	{[]rune{0x1f3c8}, "american football", 6, 2, []string{"american", "ball", "football"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3c9}, "rugby football", 6, 2, []string{"ball", "football", "rugby"}, false, 0, "1.0", nil},
+	{[]rune{0x1f3be}, "tennis", 6, 2, []string{"ball", "racquet", "tennis"}, false, 0, "0.6", nil},
+	{[]rune{0x1f94f}, "flying disc", 6, 2, []string{"flying disc", "ultimate"}, false, 0, "11.0", nil},
+	{[]rune{0x1f3b3}, "bowling", 6, 2, []string{"ball", "bowling", "game"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3cf}, "cricket game", 6, 2, []string{"ball", "bat", "cricket game", "game"}, false, 0, "1.0", nil},
+	{[]rune{0x1f3d1}, "field hockey", 6, 2, []string{"ball", "field", "game", "hockey", "stick"}, false, 0, "1.0", nil},
+	{[]rune{0x1f3d2}, "ice hockey", 6, 2, []string{"game", "hockey", "ice", "puck", "stick"}, false, 0, "1.0", nil},
+	{[]rune{0x1f94d}, "lacrosse", 6, 2, []string{"ball", "goal", "lacrosse", "stick"}, false, 0, "11.0", nil},
+	{[]rune{0x1f3d3}, "ping pong", 6, 2, []string{"ball", "bat", "game", "paddle", "ping pong", "table tennis"}, false, 0, "1.0", nil},
+	{[]rune{0x1f3f8}, "badminton", 6, 2, []string{"badminton", "birdie", "game", "racquet", "shuttlecock"}, false, 0, "1.0", nil},
+	{[]rune{0x1f94a}, "boxing glove", 6, 2, []string{"boxing", "glove"}, false, 0, "3.0", nil},
+	{[]rune{0x1f94b}, "martial arts uniform", 6, 2, []string{"judo", "karate", "martial arts", "martial arts uniform", "taekwondo", "uniform"}, false, 0, "3.0", nil},
+	{[]rune{0x1f945}, "goal net", 6, 2, []string{"goal", "net"}, false, 0, "3.0", nil},
+	{[]rune{0x26f3}, "flag in hole", 6, 2, []string{"flag in hole", "golf", "hole"}, false, 0, "0.6", nil},
+	{[]rune{0x26f8, 0xfe0f}, "ice skate", 6, 2, []string{"ice", "skate"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3a3}, "fishing pole", 6, 2, []string{"fish", "fishing pole", "pole"}, false, 0, "0.6", nil},
+	{[]rune{0x1f93f}, "diving mask", 6, 2, []string{"diving", "diving mask", "scuba", "snorkeling"}, false, 0, "12.0", nil},
+	{[]rune{0x1f3bd}, "running shirt", 6, 2, []string{"athletics", "running", "sash", "shirt"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3bf}, "skis", 6, 2, []string{"ski", "skis", "snow"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6f7}, "sled", 6, 2, []string{"sled", "sledge", "sleigh"}, false, 0, "5.0", nil},
+	{[]rune{0x1f94c}, "curling stone", 6, 2, []string{"curling stone", "game", "rock"}, false, 0, "5.0", nil},
+	{[]rune{0x1f3af}, "bullseye", 6, 3, []string{"bullseye", "dart", "direct hit", "game", "hit", "target"}, false, 0, "0.6", nil},
+	{[]rune{0x1fa80}, "yo-yo", 6, 3, []string{"fluctuate", "toy", "yo-yo"}, false, 0, "12.0", nil},
+	{[]rune{0x1fa81}, "kite", 6, 3, []string{"fly", "kite", "soar"}, false, 0, "12.0", nil},
+	{[]rune{0x1f52b}, "water pistol", 6, 3, []string{"gun", "handgun", "pistol", "revolver", "tool", "water", "weapon"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3b1}, "pool 8 ball", 6, 3, []string{"8", "ball", "billiard", "eight", "game", "pool 8 ball"}, false, 0, "0.6", nil},
+	{[]rune{0x1f52e}, "crystal ball", 6, 3, []string{"ball", "crystal", "fairy tale", "fantasy", "fortune", "tool"}, false, 0, "0.6", nil},
+	{[]rune{0x1fa84}, "magic wand", 6, 3, []string{"magic", "magic wand", "witch", "wizard"}, false, 0, "13.0", nil},
+	{[]rune{0x1f3ae}, "video game", 6, 3, []string{"controller", "game", "video game"}, false, 0, "0.6", nil},
+	{[]rune{0x1f579, 0xfe0f}, "joystick", 6, 3, []string{"game", "joystick", "video game"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3b0}, "slot machine", 6, 3, []string{"game", "slot", "slot machine"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3b2}, "game die", 6, 3, []string{"dice", "die", "game"}, false, 0, "0.6", nil},
+	{[]rune{0x1f9e9}, "puzzle piece", 6, 3, []string{"clue", "interlocking", "jigsaw", "piece", "puzzle"}, false, 0, "11.0", nil},
+	{[]rune{0x1f9f8}, "teddy bear", 6, 3, []string{"plaything", "plush", "stuffed", "teddy bear", "toy"}, false, 0, "11.0", nil},
+	{[]rune{0x1fa85}, "piñata", 6, 3, []string{"celebration", "party", "piñata"}, false, 0, "13.0", nil},
+	{[]rune{0x1faa9}, "mirror ball", 6, 3, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1fa86}, "nesting dolls", 6, 3, []string{"doll", "nesting", "nesting dolls", "russia"}, false, 0, "13.0", nil},
+	{[]rune{0x2660, 0xfe0f}, "spade suit", 6, 3, []string{"card", "game", "spade suit"}, false, 0, "0.6", nil},
+	{[]rune{0x2665, 0xfe0f}, "heart suit", 6, 3, []string{"card", "game", "heart suit"}, false, 0, "0.6", nil},
+	{[]rune{0x2666, 0xfe0f}, "diamond suit", 6, 3, []string{"card", "diamond suit", "game"}, false, 0, "0.6", nil},
+	{[]rune{0x2663, 0xfe0f}, "club suit", 6, 3, []string{"card", "club suit", "game"}, false, 0, "0.6", nil},
+	{[]rune{0x265f, 0xfe0f}, "chess pawn", 6, 3, []string{"chess", "chess pawn", "dupe", "expendable"}, false, 0, "11.0", nil},
+	{[]rune{0x1f0cf}, "joker", 6, 3, []string{"card", "game", "joker", "wildcard"}, false, 0, "0.6", nil},
+	{[]rune{0x1f004}, "mahjong red dragon", 6, 3, []string{"game", "mahjong", "mahjong red dragon", "red"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3b4}, "flower playing cards", 6, 3, []string{"card", "flower", "flower playing cards", "game", "Japanese", "playing"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3ad}, "performing arts", 6, 4, []string{"art", "mask", "performing", "performing arts", "theater", "theatre"}, false, 0, "0.6", nil},
+	{[]rune{0x1f5bc, 0xfe0f}, "framed picture", 6, 4, []string{"art", "frame", "framed picture", "museum", "painting", "picture"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3a8}, "artist palette", 6, 4, []string{"art", "artist palette", "museum", "painting", "palette"}, false, 0, "0.6", nil},
+	{[]rune{0x1f9f5}, "thread", 6, 4, []string{"needle", "sewing", "spool", "string", "thread"}, false, 0, "11.0", nil},
+	{[]rune{0x1faa1}, "sewing needle", 6, 4, []string{"embroidery", "needle", "sewing", "stitches", "sutures", "tailoring"}, false, 0, "13.0", nil},
+	{[]rune{0x1f9f6}, "yarn", 6, 4, []string{"ball", "crochet", "knit", "yarn"}, false, 0, "11.0", nil},
+	{[]rune{0x1faa2}, "knot", 6, 4, []string{"knot", "rope", "tangled", "tie", "twine", "twist"}, false, 0, "13.0", nil},
+	{[]rune{0x1f453}, "glasses", 7, 0, []string{"clothing", "eye", "eyeglasses", "eyewear", "glasses"}, false, 0, "0.6", nil},
+	{[]rune{0x1f576, 0xfe0f}, "sunglasses", 7, 0, []string{"dark", "eye", "eyewear", "glasses", "sunglasses"}, false, 0, "0.7", nil},
+	{[]rune{0x1f97d}, "goggles", 7, 0, []string{"eye protection", "goggles", "swimming", "welding"}, false, 0, "11.0", nil},
+	{[]rune{0x1f97c}, "lab coat", 7, 0, []string{"doctor", "experiment", "lab coat", "scientist"}, false, 0, "11.0", nil},
+	{[]rune{0x1f9ba}, "safety vest", 7, 0, []string{"emergency", "safety", "vest"}, false, 0, "12.0", nil},
+	{[]rune{0x1f454}, "necktie", 7, 0, []string{"clothing", "necktie", "tie"}, false, 0, "0.6", nil},
+	{[]rune{0x1f455}, "t-shirt", 7, 0, []string{"clothing", "shirt", "t-shirt", "tshirt"}, false, 0, "0.6", nil},
+	{[]rune{0x1f456}, "jeans", 7, 0, []string{"clothing", "jeans", "pants", "trousers"}, false, 0, "0.6", nil},
+	{[]rune{0x1f9e3}, "scarf", 7, 0, []string{"neck", "scarf"}, false, 0, "5.0", nil},
+	{[]rune{0x1f9e4}, "gloves", 7, 0, []string{"gloves", "hand"}, false, 0, "5.0", nil},
+	{[]rune{0x1f9e5}, "coat", 7, 0, []string{"coat", "jacket"}, false, 0, "5.0", nil},
+	{[]rune{0x1f9e6}, "socks", 7, 0, []string{"socks", "stocking"}, false, 0, "5.0", nil},
+	{[]rune{0x1f457}, "dress", 7, 0, []string{"clothing", "dress"}, false, 0, "0.6", nil},
+	{[]rune{0x1f458}, "kimono", 7, 0, []string{"clothing", "kimono"}, false, 0, "0.6", nil},
+	{[]rune{0x1f97b}, "sari", 7, 0, []string{"clothing", "dress", "sari"}, false, 0, "12.0", nil},
+	{[]rune{0x1fa71}, "one-piece swimsuit", 7, 0, []string{"bathing suit", "one-piece swimsuit"}, false, 0, "12.0", nil},
+	{[]rune{0x1fa72}, "briefs", 7, 0, []string{"bathing suit", "briefs", "one-piece", "swimsuit", "underwear"}, false, 0, "12.0", nil},
+	{[]rune{0x1fa73}, "shorts", 7, 0, []string{"bathing suit", "pants", "shorts", "underwear"}, false, 0, "12.0", nil},
+	{[]rune{0x1f459}, "bikini", 7, 0, []string{"bikini", "clothing", "swim"}, false, 0, "0.6", nil},
+	{[]rune{0x1f45a}, "woman’s clothes", 7, 0, []string{"clothing", "woman", "woman’s clothes"}, false, 0, "0.6", nil},
+	{[]rune{0x1f45b}, "purse", 7, 0, []string{"clothing", "coin", "purse"}, false, 0, "0.6", nil},
+	{[]rune{0x1f45c}, "handbag", 7, 0, []string{"bag", "clothing", "handbag", "purse"}, false, 0, "0.6", nil},
+	{[]rune{0x1f45d}, "clutch bag", 7, 0, []string{"bag", "clothing", "clutch bag", "pouch"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6cd, 0xfe0f}, "shopping bags", 7, 0, []string{"bag", "hotel", "shopping", "shopping bags"}, false, 0, "0.7", nil},
+	{[]rune{0x1f392}, "backpack", 7, 0, []string{"backpack", "bag", "rucksack", "satchel", "school"}, false, 0, "0.6", nil},
+	{[]rune{0x1fa74}, "thong sandal", 7, 0, []string{"beach sandals", "sandals", "thong sandal", "thong sandals", "thongs", "zōri"}, false, 0, "13.0", nil},
+	{[]rune{0x1f45e}, "man’s shoe", 7, 0, []string{"clothing", "man", "man’s shoe", "shoe"}, false, 0, "0.6", nil},
+	{[]rune{0x1f45f}, "running shoe", 7, 0, []string{"athletic", "clothing", "running shoe", "shoe", "sneaker"}, false, 0, "0.6", nil},
+	{[]rune{0x1f97e}, "hiking boot", 7, 0, []string{"backpacking", "boot", "camping", "hiking"}, false, 0, "11.0", nil},
+	{[]rune{0x1f97f}, "flat shoe", 7, 0, []string{"ballet flat", "flat shoe", "slip-on", "slipper"}, false, 0, "11.0", nil},
+	{[]rune{0x1f460}, "high-heeled shoe", 7, 0, []string{"clothing", "heel", "high-heeled shoe", "shoe", "woman"}, false, 0, "0.6", nil},
+	{[]rune{0x1f461}, "woman’s sandal", 7, 0, []string{"clothing", "sandal", "shoe", "woman", "woman’s sandal"}, false, 0, "0.6", nil},
+	{[]rune{0x1fa70}, "ballet shoes", 7, 0, []string{"ballet", "ballet shoes", "dance"}, false, 0, "12.0", nil},
+	{[]rune{0x1f462}, "woman’s boot", 7, 0, []string{"boot", "clothing", "shoe", "woman", "woman’s boot"}, false, 0, "0.6", nil},
+	{[]rune{0x1f451}, "crown", 7, 0, []string{"clothing", "crown", "king", "queen"}, false, 0, "0.6", nil},
+	{[]rune{0x1f452}, "woman’s hat", 7, 0, []string{"clothing", "hat", "woman", "woman’s hat"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3a9}, "top hat", 7, 0, []string{"clothing", "hat", "top", "tophat"}, false, 0, "0.6", nil},
+	{[]rune{0x1f393}, "graduation cap", 7, 0, []string{"cap", "celebration", "clothing", "graduation", "hat"}, false, 0, "0.6", nil},
+	{[]rune{0x1f9e2}, "billed cap", 7, 0, []string{"baseball cap", "billed cap"}, false, 0, "5.0", nil},
+	{[]rune{0x1fa96}, "military helmet", 7, 0, []string{"army", "helmet", "military", "soldier", "warrior"}, false, 0, "13.0", nil},
+	{[]rune{0x26d1, 0xfe0f}, "rescue worker’s helmet", 7, 0, []string{"aid", "cross", "face", "hat", "helmet", "rescue worker’s helmet"}, false, 0, "0.7", nil},
+	{[]rune{0x1f4ff}, "prayer beads", 7, 0, []string{"beads", "clothing", "necklace", "prayer", "religion"}, false, 0, "1.0", nil},
+	{[]rune{0x1f484}, "lipstick", 7, 0, []string{"cosmetics", "lipstick", "makeup"}, false, 0, "0.6", nil},
+	{[]rune{0x1f48d}, "ring", 7, 0, []string{"diamond", "ring"}, false, 0, "0.6", nil},
+	{[]rune{0x1f48e}, "gem stone", 7, 0, []string{"diamond", "gem", "gem stone", "jewel"}, false, 0, "0.6", nil},
+	{[]rune{0x1f507}, "muted speaker", 7, 1, []string{"mute", "muted speaker", "quiet", "silent", "speaker"}, false, 0, "1.0", nil},
+	{[]rune{0x1f508}, "speaker low volume", 7, 1, []string{"soft", "speaker low volume"}, false, 0, "0.7", nil},
+	{[]rune{0x1f509}, "speaker medium volume", 7, 1, []string{"medium", "speaker medium volume"}, false, 0, "1.0", nil},
+	{[]rune{0x1f50a}, "speaker high volume", 7, 1, []string{"loud", "speaker high volume"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4e2}, "loudspeaker", 7, 1, []string{"loud", "loudspeaker", "public address"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4e3}, "megaphone", 7, 1, []string{"cheering", "megaphone"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4ef}, "postal horn", 7, 1, []string{"horn", "post", "postal"}, false, 0, "1.0", nil},
+	{[]rune{0x1f514}, "bell", 7, 1, []string{"bell"}, false, 0, "0.6", nil},
+	{[]rune{0x1f515}, "bell with slash", 7, 1, []string{"bell", "bell with slash", "forbidden", "mute", "quiet", "silent"}, false, 0, "1.0", nil},
+	{[]rune{0x1f3bc}, "musical score", 7, 2, []string{"music", "musical score", "score"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3b5}, "musical note", 7, 2, []string{"music", "musical note", "note"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3b6}, "musical notes", 7, 2, []string{"music", "musical notes", "note", "notes"}, false, 0, "0.6", nil},
+	{[]rune{0x1f399, 0xfe0f}, "studio microphone", 7, 2, []string{"mic", "microphone", "music", "studio"}, false, 0, "0.7", nil},
+	{[]rune{0x1f39a, 0xfe0f}, "level slider", 7, 2, []string{"level", "music", "slider"}, false, 0, "0.7", nil},
+	{[]rune{0x1f39b, 0xfe0f}, "control knobs", 7, 2, []string{"control", "knobs", "music"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3a4}, "microphone", 7, 2, []string{"karaoke", "mic", "microphone"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3a7}, "headphone", 7, 2, []string{"earbud", "headphone"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4fb}, "radio", 7, 2, []string{"radio", "video"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3b7}, "saxophone", 7, 3, []string{"instrument", "music", "sax", "saxophone"}, false, 0, "0.6", nil},
+	{[]rune{0x1fa97}, "accordion", 7, 3, []string{"accordian", "accordion", "concertina", "squeeze box"}, false, 0, "13.0", nil},
+	{[]rune{0x1f3b8}, "guitar", 7, 3, []string{"guitar", "instrument", "music"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3b9}, "musical keyboard", 7, 3, []string{"instrument", "keyboard", "music", "musical keyboard", "piano"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3ba}, "trumpet", 7, 3, []string{"instrument", "music", "trumpet"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3bb}, "violin", 7, 3, []string{"instrument", "music", "violin"}, false, 0, "0.6", nil},
+	{[]rune{0x1fa95}, "banjo", 7, 3, []string{"banjo", "music", "stringed"}, false, 0, "12.0", nil},
+	{[]rune{0x1f941}, "drum", 7, 3, []string{"drum", "drumsticks", "music"}, false, 0, "3.0", nil},
+	{[]rune{0x1fa98}, "long drum", 7, 3, []string{"beat", "conga", "drum", "long drum", "rhythm"}, false, 0, "13.0", nil},
+	{[]rune{0x1f4f1}, "mobile phone", 7, 4, []string{"cell", "mobile", "phone", "telephone"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4f2}, "mobile phone with arrow", 7, 4, []string{"arrow", "cell", "mobile", "mobile phone with arrow", "phone", "receive"}, false, 0, "0.6", nil},
+	{[]rune{0x260e, 0xfe0f}, "telephone", 7, 4, []string{"phone", "telephone"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4de}, "telephone receiver", 7, 4, []string{"phone", "receiver", "telephone"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4df}, "pager", 7, 4, []string{"pager"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4e0}, "fax machine", 7, 4, []string{"fax", "fax machine"}, false, 0, "0.6", nil},
+	{[]rune{0x1f50b}, "battery", 7, 5, []string{"battery"}, false, 0, "0.6", nil},
+	{[]rune{0x1faab}, "low battery", 7, 5, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f50c}, "electric plug", 7, 5, []string{"electric", "electricity", "plug"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4bb}, "laptop", 7, 5, []string{"computer", "laptop", "pc", "personal"}, false, 0, "0.6", nil},
+	{[]rune{0x1f5a5, 0xfe0f}, "desktop computer", 7, 5, []string{"computer", "desktop"}, false, 0, "0.7", nil},
+	{[]rune{0x1f5a8, 0xfe0f}, "printer", 7, 5, []string{"computer", "printer"}, false, 0, "0.7", nil},
+	{[]rune{0x2328, 0xfe0f}, "keyboard", 7, 5, []string{"computer", "keyboard"}, false, 0, "1.0", nil},
+	{[]rune{0x1f5b1, 0xfe0f}, "computer mouse", 7, 5, []string{"computer", "computer mouse"}, false, 0, "0.7", nil},
+	{[]rune{0x1f5b2, 0xfe0f}, "trackball", 7, 5, []string{"computer", "trackball"}, false, 0, "0.7", nil},
+	{[]rune{0x1f4bd}, "computer disk", 7, 5, []string{"computer", "disk", "minidisk", "optical"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4be}, "floppy disk", 7, 5, []string{"computer", "disk", "floppy"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4bf}, "optical disk", 7, 5, []string{"cd", "computer", "disk", "optical"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4c0}, "dvd", 7, 5, []string{"blu-ray", "computer", "disk", "dvd", "optical"}, false, 0, "0.6", nil},
+	{[]rune{0x1f9ee}, "abacus", 7, 5, []string{"abacus", "calculation"}, false, 0, "11.0", nil},
+	{[]rune{0x1f3a5}, "movie camera", 7, 6, []string{"camera", "cinema", "movie"}, false, 0, "0.6", nil},
+	{[]rune{0x1f39e, 0xfe0f}, "film frames", 7, 6, []string{"cinema", "film", "frames", "movie"}, false, 0, "0.7", nil},
+	{[]rune{0x1f4fd, 0xfe0f}, "film projector", 7, 6, []string{"cinema", "film", "movie", "projector", "video"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3ac}, "clapper board", 7, 6, []string{"clapper", "clapper board", "movie"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4fa}, "television", 7, 6, []string{"television", "tv", "video"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4f7}, "camera", 7, 6, []string{"camera", "video"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4f8}, "camera with flash", 7, 6, []string{"camera", "camera with flash", "flash", "video"}, false, 0, "1.0", nil},
+	{[]rune{0x1f4f9}, "video camera", 7, 6, []string{"camera", "video"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4fc}, "videocassette", 7, 6, []string{"tape", "vhs", "video", "videocassette"}, false, 0, "0.6", nil},
+	{[]rune{0x1f50d}, "magnifying glass tilted left", 7, 6, []string{"glass", "magnifying", "magnifying glass tilted left", "search", "tool"}, false, 0, "0.6", nil},
+	{[]rune{0x1f50e}, "magnifying glass tilted right", 7, 6, []string{"glass", "magnifying", "magnifying glass tilted right", "search", "tool"}, false, 0, "0.6", nil},
+	{[]rune{0x1f56f, 0xfe0f}, "candle", 7, 6, []string{"candle", "light"}, false, 0, "0.7", nil},
+	{[]rune{0x1f4a1}, "light bulb", 7, 6, []string{"bulb", "comic", "electric", "idea", "light"}, false, 0, "0.6", nil},
+	{[]rune{0x1f526}, "flashlight", 7, 6, []string{"electric", "flashlight", "light", "tool", "torch"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3ee}, "red paper lantern", 7, 6, []string{"bar", "lantern", "light", "red", "red paper lantern"}, false, 0, "0.6", nil},
+	{[]rune{0x1fa94}, "diya lamp", 7, 6, []string{"diya", "lamp", "oil"}, false, 0, "12.0", nil},
+	{[]rune{0x1f4d4}, "notebook with decorative cover", 7, 7, []string{"book", "cover", "decorated", "notebook", "notebook with decorative cover"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4d5}, "closed book", 7, 7, []string{"book", "closed"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4d6}, "open book", 7, 7, []string{"book", "open"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4d7}, "green book", 7, 7, []string{"book", "green"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4d8}, "blue book", 7, 7, []string{"blue", "book"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4d9}, "orange book", 7, 7, []string{"book", "orange"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4da}, "books", 7, 7, []string{"book", "books"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4d3}, "notebook", 7, 7, []string{"notebook"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4d2}, "ledger", 7, 7, []string{"ledger", "notebook"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4c3}, "page with curl", 7, 7, []string{"curl", "document", "page", "page with curl"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4dc}, "scroll", 7, 7, []string{"paper", "scroll"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4c4}, "page facing up", 7, 7, []string{"document", "page", "page facing up"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4f0}, "newspaper", 7, 7, []string{"news", "newspaper", "paper"}, false, 0, "0.6", nil},
+	{[]rune{0x1f5de, 0xfe0f}, "rolled-up newspaper", 7, 7, []string{"news", "newspaper", "paper", "rolled", "rolled-up newspaper"}, false, 0, "0.7", nil},
+	{[]rune{0x1f4d1}, "bookmark tabs", 7, 7, []string{"bookmark", "mark", "marker", "tabs"}, false, 0, "0.6", nil},
+	{[]rune{0x1f516}, "bookmark", 7, 7, []string{"bookmark", "mark"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3f7, 0xfe0f}, "label", 7, 7, []string{"label"}, false, 0, "0.7", nil},
+	{[]rune{0x1f4b0}, "money bag", 7, 8, []string{"bag", "dollar", "money", "moneybag"}, false, 0, "0.6", nil},
+	{[]rune{0x1fa99}, "coin", 7, 8, []string{"coin", "gold", "metal", "money", "silver", "treasure"}, false, 0, "13.0", nil},
+	{[]rune{0x1f4b4}, "yen banknote", 7, 8, []string{"banknote", "bill", "currency", "money", "note", "yen"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4b5}, "dollar banknote", 7, 8, []string{"banknote", "bill", "currency", "dollar", "money", "note"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4b6}, "euro banknote", 7, 8, []string{"banknote", "bill", "currency", "euro", "money", "note"}, false, 0, "1.0", nil},
+	{[]rune{0x1f4b7}, "pound banknote", 7, 8, []string{"banknote", "bill", "currency", "money", "note", "pound"}, false, 0, "1.0", nil},
+	{[]rune{0x1f4b8}, "money with wings", 7, 8, []string{"banknote", "bill", "fly", "money", "money with wings", "wings"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4b3}, "credit card", 7, 8, []string{"card", "credit", "money"}, false, 0, "0.6", nil},
+	{[]rune{0x1f9fe}, "receipt", 7, 8, []string{"accounting", "bookkeeping", "evidence", "proof", "receipt"}, false, 0, "11.0", nil},
+	{[]rune{0x1f4b9}, "chart increasing with yen", 7, 8, []string{"chart", "chart increasing with yen", "graph", "growth", "money", "yen"}, false, 0, "0.6", nil},
+	{[]rune{0x2709, 0xfe0f}, "envelope", 7, 9, []string{"email", "envelope", "letter"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4e7}, "e-mail", 7, 9, []string{"e-mail", "email", "letter", "mail"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4e8}, "incoming envelope", 7, 9, []string{"e-mail", "email", "envelope", "incoming", "letter", "receive"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4e9}, "envelope with arrow", 7, 9, []string{"arrow", "e-mail", "email", "envelope", "envelope with arrow", "outgoing"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4e4}, "outbox tray", 7, 9, []string{"box", "letter", "mail", "outbox", "sent", "tray"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4e5}, "inbox tray", 7, 9, []string{"box", "inbox", "letter", "mail", "receive", "tray"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4e6}, "package", 7, 9, []string{"box", "package", "parcel"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4eb}, "closed mailbox with raised flag", 7, 9, []string{"closed", "closed mailbox with raised flag", "mail", "mailbox", "postbox"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4ea}, "closed mailbox with lowered flag", 7, 9, []string{"closed", "closed mailbox with lowered flag", "lowered", "mail", "mailbox", "postbox"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4ec}, "open mailbox with raised flag", 7, 9, []string{"mail", "mailbox", "open", "open mailbox with raised flag", "postbox"}, false, 0, "0.7", nil},
+	{[]rune{0x1f4ed}, "open mailbox with lowered flag", 7, 9, []string{"lowered", "mail", "mailbox", "open", "open mailbox with lowered flag", "postbox"}, false, 0, "0.7", nil},
+	{[]rune{0x1f4ee}, "postbox", 7, 9, []string{"mail", "mailbox", "postbox"}, false, 0, "0.6", nil},
+	{[]rune{0x1f5f3, 0xfe0f}, "ballot box with ballot", 7, 9, []string{"ballot", "ballot box with ballot", "box"}, false, 0, "0.7", nil},
+	{[]rune{0x270f, 0xfe0f}, "pencil", 7, 10, []string{"pencil"}, false, 0, "0.6", nil},
+	{[]rune{0x2712, 0xfe0f}, "black nib", 7, 10, []string{"black nib", "nib", "pen"}, false, 0, "0.6", nil},
+	{[]rune{0x1f58b, 0xfe0f}, "fountain pen", 7, 10, []string{"fountain", "pen"}, false, 0, "0.7", nil},
+	{[]rune{0x1f58a, 0xfe0f}, "pen", 7, 10, []string{"ballpoint", "pen"}, false, 0, "0.7", nil},
+	{[]rune{0x1f58c, 0xfe0f}, "paintbrush", 7, 10, []string{"paintbrush", "painting"}, false, 0, "0.7", nil},
+	{[]rune{0x1f58d, 0xfe0f}, "crayon", 7, 10, []string{"crayon"}, false, 0, "0.7", nil},
+	{[]rune{0x1f4dd}, "memo", 7, 10, []string{"memo", "pencil"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4bc}, "briefcase", 7, 11, []string{"briefcase"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4c1}, "file folder", 7, 11, []string{"file", "folder"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4c2}, "open file folder", 7, 11, []string{"file", "folder", "open"}, false, 0, "0.6", nil},
+	{[]rune{0x1f5c2, 0xfe0f}, "card index dividers", 7, 11, []string{"card", "dividers", "index"}, false, 0, "0.7", nil},
+	{[]rune{0x1f4c5}, "calendar", 7, 11, []string{"calendar", "date"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4c6}, "tear-off calendar", 7, 11, []string{"calendar", "tear-off calendar"}, false, 0, "0.6", nil},
+	{[]rune{0x1f5d2, 0xfe0f}, "spiral notepad", 7, 11, []string{"note", "pad", "spiral", "spiral notepad"}, false, 0, "0.7", nil},
+	{[]rune{0x1f5d3, 0xfe0f}, "spiral calendar", 7, 11, []string{"calendar", "pad", "spiral"}, false, 0, "0.7", nil},
+	{[]rune{0x1f4c7}, "card index", 7, 11, []string{"card", "index", "rolodex"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4c8}, "chart increasing", 7, 11, []string{"chart", "chart increasing", "graph", "growth", "trend", "upward"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4c9}, "chart decreasing", 7, 11, []string{"chart", "chart decreasing", "down", "graph", "trend"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4ca}, "bar chart", 7, 11, []string{"bar", "chart", "graph"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4cb}, "clipboard", 7, 11, []string{"clipboard"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4cc}, "pushpin", 7, 11, []string{"pin", "pushpin"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4cd}, "round pushpin", 7, 11, []string{"pin", "pushpin", "round pushpin"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4ce}, "paperclip", 7, 11, []string{"paperclip"}, false, 0, "0.6", nil},
+	{[]rune{0x1f587, 0xfe0f}, "linked paperclips", 7, 11, []string{"link", "linked paperclips", "paperclip"}, false, 0, "0.7", nil},
+	{[]rune{0x1f4cf}, "straight ruler", 7, 11, []string{"ruler", "straight edge", "straight ruler"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4d0}, "triangular ruler", 7, 11, []string{"ruler", "set", "triangle", "triangular ruler"}, false, 0, "0.6", nil},
+	{[]rune{0x2702, 0xfe0f}, "scissors", 7, 11, []string{"cutting", "scissors", "tool"}, false, 0, "0.6", nil},
+	{[]rune{0x1f5c3, 0xfe0f}, "card file box", 7, 11, []string{"box", "card", "file"}, false, 0, "0.7", nil},
+	{[]rune{0x1f5c4, 0xfe0f}, "file cabinet", 7, 11, []string{"cabinet", "file", "filing"}, false, 0, "0.7", nil},
+	{[]rune{0x1f5d1, 0xfe0f}, "wastebasket", 7, 11, []string{"wastebasket"}, false, 0, "0.7", nil},
+	{[]rune{0x1f512}, "locked", 7, 12, []string{"closed", "locked"}, false, 0, "0.6", nil},
+	{[]rune{0x1f513}, "unlocked", 7, 12, []string{"lock", "open", "unlock", "unlocked"}, false, 0, "0.6", nil},
+	{[]rune{0x1f50f}, "locked with pen", 7, 12, []string{"ink", "lock", "locked with pen", "nib", "pen", "privacy"}, false, 0, "0.6", nil},
+	{[]rune{0x1f510}, "locked with key", 7, 12, []string{"closed", "key", "lock", "locked with key", "secure"}, false, 0, "0.6", nil},
+	{[]rune{0x1f511}, "key", 7, 12, []string{"key", "lock", "password"}, false, 0, "0.6", nil},
+	{[]rune{0x1f5dd, 0xfe0f}, "old key", 7, 12, []string{"clue", "key", "lock", "old"}, false, 0, "0.7", nil},
+	{[]rune{0x1f528}, "hammer", 7, 13, []string{"hammer", "tool"}, false, 0, "0.6", nil},
+	{[]rune{0x1fa93}, "axe", 7, 13, []string{"axe", "chop", "hatchet", "split", "wood"}, false, 0, "12.0", nil},
+	{[]rune{0x26cf, 0xfe0f}, "pick", 7, 13, []string{"mining", "pick", "tool"}, false, 0, "0.7", nil},
+	{[]rune{0x2692, 0xfe0f}, "hammer and pick", 7, 13, []string{"hammer", "hammer and pick", "pick", "tool"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6e0, 0xfe0f}, "hammer and wrench", 7, 13, []string{"hammer", "hammer and wrench", "spanner", "tool", "wrench"}, false, 0, "0.7", nil},
+	{[]rune{0x1f5e1, 0xfe0f}, "dagger", 7, 13, []string{"dagger", "knife", "weapon"}, false, 0, "0.7", nil},
+	{[]rune{0x2694, 0xfe0f}, "crossed swords", 7, 13, []string{"crossed", "swords", "weapon"}, false, 0, "1.0", nil},
+	{[]rune{0x1f4a3}, "bomb", 7, 13, []string{"bomb", "comic"}, false, 0, "0.6", nil},
+	{[]rune{0x1fa83}, "boomerang", 7, 13, []string{"australia", "boomerang", "rebound", "repercussion"}, false, 0, "13.0", nil},
+	{[]rune{0x1f3f9}, "bow and arrow", 7, 13, []string{"archer", "arrow", "bow", "bow and arrow", "Sagittarius", "zodiac"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6e1, 0xfe0f}, "shield", 7, 13, []string{"shield", "weapon"}, false, 0, "0.7", nil},
+	{[]rune{0x1fa9a}, "carpentry saw", 7, 13, []string{"carpenter", "carpentry saw", "lumber", "saw", "tool"}, false, 0, "13.0", nil},
+	{[]rune{0x1f527}, "wrench", 7, 13, []string{"spanner", "tool", "wrench"}, false, 0, "0.6", nil},
+	{[]rune{0x1fa9b}, "screwdriver", 7, 13, []string{"screw", "screwdriver", "tool"}, false, 0, "13.0", nil},
+	{[]rune{0x1f529}, "nut and bolt", 7, 13, []string{"bolt", "nut", "nut and bolt", "tool"}, false, 0, "0.6", nil},
+	{[]rune{0x2699, 0xfe0f}, "gear", 7, 13, []string{"cog", "cogwheel", "gear", "tool"}, false, 0, "1.0", nil},
+	{[]rune{0x1f5dc, 0xfe0f}, "clamp", 7, 13, []string{"clamp", "compress", "tool", "vice"}, false, 0, "0.7", nil},
+	{[]rune{0x2696, 0xfe0f}, "balance scale", 7, 13, []string{"balance", "justice", "Libra", "scale", "zodiac"}, false, 0, "1.0", nil},
+	{[]rune{0x1f9af}, "white cane", 7, 13, []string{"accessibility", "blind", "white cane"}, false, 0, "12.0", nil},
+	{[]rune{0x1f517}, "link", 7, 13, []string{"link"}, false, 0, "0.6", nil},
+	{[]rune{0x26d3, 0xfe0f}, "chains", 7, 13, []string{"chain", "chains"}, false, 0, "0.7", nil},
+	{[]rune{0x1fa9d}, "hook", 7, 13, []string{"catch", "crook", "curve", "ensnare", "hook", "selling point"}, false, 0, "13.0", nil},
+	{[]rune{0x1f9f0}, "toolbox", 7, 13, []string{"chest", "mechanic", "tool", "toolbox"}, false, 0, "11.0", nil},
+	{[]rune{0x1f9f2}, "magnet", 7, 13, []string{"attraction", "horseshoe", "magnet", "magnetic"}, false, 0, "11.0", nil},
+	{[]rune{0x1fa9c}, "ladder", 7, 13, []string{"climb", "ladder", "rung", "step"}, false, 0, "13.0", nil},
+	{[]rune{0x2697, 0xfe0f}, "alembic", 7, 14, []string{"alembic", "chemistry", "tool"}, false, 0, "1.0", nil},
+	{[]rune{0x1f9ea}, "test tube", 7, 14, []string{"chemist", "chemistry", "experiment", "lab", "science", "test tube"}, false, 0, "11.0", nil},
+	{[]rune{0x1f9eb}, "petri dish", 7, 14, []string{"bacteria", "biologist", "biology", "culture", "lab", "petri dish"}, false, 0, "11.0", nil},
+	{[]rune{0x1f9ec}, "dna", 7, 14, []string{"biologist", "dna", "evolution", "gene", "genetics", "life"}, false, 0, "11.0", nil},
+	{[]rune{0x1f52c}, "microscope", 7, 14, []string{"microscope", "science", "tool"}, false, 0, "1.0", nil},
+	{[]rune{0x1f52d}, "telescope", 7, 14, []string{"science", "telescope", "tool"}, false, 0, "1.0", nil},
+	{[]rune{0x1f4e1}, "satellite antenna", 7, 14, []string{"antenna", "dish", "satellite"}, false, 0, "0.6", nil},
+	{[]rune{0x1f489}, "syringe", 7, 15, []string{"medicine", "needle", "shot", "sick", "syringe"}, false, 0, "0.6", nil},
+	{[]rune{0x1fa78}, "drop of blood", 7, 15, []string{"bleed", "blood donation", "drop of blood", "injury", "medicine", "menstruation"}, false, 0, "12.0", nil},
+	{[]rune{0x1f48a}, "pill", 7, 15, []string{"doctor", "medicine", "pill", "sick"}, false, 0, "0.6", nil},
+	{[]rune{0x1fa79}, "adhesive bandage", 7, 15, []string{"adhesive bandage", "bandage"}, false, 0, "12.0", nil},
+	{[]rune{0x1fa7c}, "crutch", 7, 15, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1fa7a}, "stethoscope", 7, 15, []string{"doctor", "heart", "medicine", "stethoscope"}, false, 0, "12.0", nil},
+	{[]rune{0x1fa7b}, "x-ray", 7, 15, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f6aa}, "door", 7, 16, []string{"door"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6d7}, "elevator", 7, 16, []string{"accessibility", "elevator", "hoist", "lift"}, false, 0, "13.0", nil},
+	{[]rune{0x1fa9e}, "mirror", 7, 16, []string{"mirror", "reflection", "reflector", "speculum"}, false, 0, "13.0", nil},
+	{[]rune{0x1fa9f}, "window", 7, 16, []string{"frame", "fresh air", "opening", "transparent", "view", "window"}, false, 0, "13.0", nil},
+	{[]rune{0x1f6cf, 0xfe0f}, "bed", 7, 16, []string{"bed", "hotel", "sleep"}, false, 0, "0.7", nil},
+	{[]rune{0x1f6cb, 0xfe0f}, "couch and lamp", 7, 16, []string{"couch", "couch and lamp", "hotel", "lamp"}, false, 0, "0.7", nil},
+	{[]rune{0x1fa91}, "chair", 7, 16, []string{"chair", "seat", "sit"}, false, 0, "12.0", nil},
+	{[]rune{0x1f6bd}, "toilet", 7, 16, []string{"toilet"}, false, 0, "0.6", nil},
+	{[]rune{0x1faa0}, "plunger", 7, 16, []string{"force cup", "plumber", "plunger", "suction", "toilet"}, false, 0, "13.0", nil},
+	{[]rune{0x1f6bf}, "shower", 7, 16, []string{"shower", "water"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6c1}, "bathtub", 7, 16, []string{"bath", "bathtub"}, false, 0, "1.0", nil},
+	{[]rune{0x1faa4}, "mouse trap", 7, 16, []string{"bait", "mouse trap", "mousetrap", "snare", "trap"}, false, 0, "13.0", nil},
+	{[]rune{0x1fa92}, "razor", 7, 16, []string{"razor", "sharp", "shave"}, false, 0, "12.0", nil},
+	{[]rune{0x1f9f4}, "lotion bottle", 7, 16, []string{"lotion", "lotion bottle", "moisturizer", "shampoo", "sunscreen"}, false, 0, "11.0", nil},
+	{[]rune{0x1f9f7}, "safety pin", 7, 16, []string{"diaper", "punk rock", "safety pin"}, false, 0, "11.0", nil},
+	{[]rune{0x1f9f9}, "broom", 7, 16, []string{"broom", "cleaning", "sweeping", "witch"}, false, 0, "11.0", nil},
+	{[]rune{0x1f9fa}, "basket", 7, 16, []string{"basket", "farming", "laundry", "picnic"}, false, 0, "11.0", nil},
+	{[]rune{0x1f9fb}, "roll of paper", 7, 16, []string{"paper towels", "roll of paper", "toilet paper"}, false, 0, "11.0", nil},
+	{[]rune{0x1faa3}, "bucket", 7, 16, []string{"bucket", "cask", "pail", "vat"}, false, 0, "13.0", nil},
+	{[]rune{0x1f9fc}, "soap", 7, 16, []string{"bar", "bathing", "cleaning", "lather", "soap", "soapdish"}, false, 0, "11.0", nil},
+	{[]rune{0x1fae7}, "bubbles", 7, 16, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1faa5}, "toothbrush", 7, 16, []string{"bathroom", "brush", "clean", "dental", "hygiene", "teeth", "toothbrush"}, false, 0, "13.0", nil},
+	{[]rune{0x1f9fd}, "sponge", 7, 16, []string{"absorbing", "cleaning", "porous", "sponge"}, false, 0, "11.0", nil},
+	{[]rune{0x1f9ef}, "fire extinguisher", 7, 16, []string{"extinguish", "fire", "fire extinguisher", "quench"}, false, 0, "11.0", nil},
+	{[]rune{0x1f6d2}, "shopping cart", 7, 16, []string{"cart", "shopping", "trolley"}, false, 0, "3.0", nil},
+	{[]rune{0x1f6ac}, "cigarette", 7, 17, []string{"cigarette", "smoking"}, false, 0, "0.6", nil},
+	{[]rune{0x26b0, 0xfe0f}, "coffin", 7, 17, []string{"coffin", "death"}, false, 0, "1.0", nil},
+	{[]rune{0x1faa6}, "headstone", 7, 17, []string{"cemetery", "grave", "graveyard", "headstone", "tombstone"}, false, 0, "13.0", nil},
+	{[]rune{0x26b1, 0xfe0f}, "funeral urn", 7, 17, []string{"ashes", "death", "funeral", "urn"}, false, 0, "1.0", nil},
+	{[]rune{0x1f9ff}, "nazar amulet", 7, 17, []string{"bead", "charm", "evil-eye", "nazar", "nazar amulet", "talisman"}, false, 0, "11.0", nil},
+	{[]rune{0x1faac}, "hamsa", 7, 17, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f5ff}, "moai", 7, 17, []string{"face", "moai", "moyai", "statue"}, false, 0, "0.6", nil},
+	{[]rune{0x1faa7}, "placard", 7, 17, []string{"demonstration", "picket", "placard", "protest", "sign"}, false, 0, "13.0", nil},
+	{[]rune{0x1faaa}, "identification card", 7, 17, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x1f3e7}, "ATM sign", 8, 0, []string{"atm", "ATM sign", "automated", "bank", "teller"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6ae}, "litter in bin sign", 8, 0, []string{"litter", "litter bin", "litter in bin sign"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6b0}, "potable water", 8, 0, []string{"drinking", "potable", "water"}, false, 0, "1.0", nil},
+	{[]rune{0x267f}, "wheelchair symbol", 8, 0, []string{"access", "wheelchair symbol"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6b9}, "men’s room", 8, 0, []string{"lavatory", "man", "men’s room", "restroom", "wc"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6ba}, "women’s room", 8, 0, []string{"lavatory", "restroom", "wc", "woman", "women’s room"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6bb}, "restroom", 8, 0, []string{"lavatory", "restroom", "WC"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6bc}, "baby symbol", 8, 0, []string{"baby", "baby symbol", "changing"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6be}, "water closet", 8, 0, []string{"closet", "lavatory", "restroom", "water", "wc"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6c2}, "passport control", 8, 0, []string{"control", "passport"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6c3}, "customs", 8, 0, []string{"customs"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6c4}, "baggage claim", 8, 0, []string{"baggage", "claim"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6c5}, "left luggage", 8, 0, []string{"baggage", "left luggage", "locker", "luggage"}, false, 0, "1.0", nil},
+	{[]rune{0x26a0, 0xfe0f}, "warning", 8, 1, []string{"warning"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6b8}, "children crossing", 8, 1, []string{"child", "children crossing", "crossing", "pedestrian", "traffic"}, false, 0, "1.0", nil},
+	{[]rune{0x26d4}, "no entry", 8, 1, []string{"entry", "forbidden", "no", "not", "prohibited", "traffic"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6ab}, "prohibited", 8, 1, []string{"entry", "forbidden", "no", "not", "prohibited"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6b3}, "no bicycles", 8, 1, []string{"bicycle", "bike", "forbidden", "no", "no bicycles", "prohibited"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6ad}, "no smoking", 8, 1, []string{"forbidden", "no", "not", "prohibited", "smoking"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6af}, "no littering", 8, 1, []string{"forbidden", "litter", "no", "no littering", "not", "prohibited"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6b1}, "non-potable water", 8, 1, []string{"non-drinking", "non-potable", "water"}, false, 0, "1.0", nil},
+	{[]rune{0x1f6b7}, "no pedestrians", 8, 1, []string{"forbidden", "no", "no pedestrians", "not", "pedestrian", "prohibited"}, false, 0, "1.0", nil},
+	{[]rune{0x1f4f5}, "no mobile phones", 8, 1, []string{"cell", "forbidden", "mobile", "no", "no mobile phones", "phone"}, false, 0, "1.0", nil},
+	{[]rune{0x1f51e}, "no one under eighteen", 8, 1, []string{"18", "age restriction", "eighteen", "no one under eighteen", "prohibited", "underage"}, false, 0, "0.6", nil},
+	{[]rune{0x2622, 0xfe0f}, "radioactive", 8, 1, []string{"radioactive", "sign"}, false, 0, "1.0", nil},
+	{[]rune{0x2623, 0xfe0f}, "biohazard", 8, 1, []string{"biohazard", "sign"}, false, 0, "1.0", nil},
+	{[]rune{0x2b06, 0xfe0f}, "up arrow", 8, 2, []string{"arrow", "cardinal", "direction", "north", "up arrow"}, false, 0, "0.6", nil},
+	{[]rune{0x2197, 0xfe0f}, "up-right arrow", 8, 2, []string{"arrow", "direction", "intercardinal", "northeast", "up-right arrow"}, false, 0, "0.6", nil},
+	{[]rune{0x27a1, 0xfe0f}, "right arrow", 8, 2, []string{"arrow", "cardinal", "direction", "east", "right arrow"}, false, 0, "0.6", nil},
+	{[]rune{0x2198, 0xfe0f}, "down-right arrow", 8, 2, []string{"arrow", "direction", "down-right arrow", "intercardinal", "southeast"}, false, 0, "0.6", nil},
+	{[]rune{0x2b07, 0xfe0f}, "down arrow", 8, 2, []string{"arrow", "cardinal", "direction", "down", "south"}, false, 0, "0.6", nil},
+	{[]rune{0x2199, 0xfe0f}, "down-left arrow", 8, 2, []string{"arrow", "direction", "down-left arrow", "intercardinal", "southwest"}, false, 0, "0.6", nil},
+	{[]rune{0x2b05, 0xfe0f}, "left arrow", 8, 2, []string{"arrow", "cardinal", "direction", "left arrow", "west"}, false, 0, "0.6", nil},
+	{[]rune{0x2196, 0xfe0f}, "up-left arrow", 8, 2, []string{"arrow", "direction", "intercardinal", "northwest", "up-left arrow"}, false, 0, "0.6", nil},
+	{[]rune{0x2195, 0xfe0f}, "up-down arrow", 8, 2, []string{"arrow", "up-down arrow"}, false, 0, "0.6", nil},
+	{[]rune{0x2194, 0xfe0f}, "left-right arrow", 8, 2, []string{"arrow", "left-right arrow"}, false, 0, "0.6", nil},
+	{[]rune{0x21a9, 0xfe0f}, "right arrow curving left", 8, 2, []string{"arrow", "right arrow curving left"}, false, 0, "0.6", nil},
+	{[]rune{0x21aa, 0xfe0f}, "left arrow curving right", 8, 2, []string{"arrow", "left arrow curving right"}, false, 0, "0.6", nil},
+	{[]rune{0x2934, 0xfe0f}, "right arrow curving up", 8, 2, []string{"arrow", "right arrow curving up"}, false, 0, "0.6", nil},
+	{[]rune{0x2935, 0xfe0f}, "right arrow curving down", 8, 2, []string{"arrow", "down", "right arrow curving down"}, false, 0, "0.6", nil},
+	{[]rune{0x1f503}, "clockwise vertical arrows", 8, 2, []string{"arrow", "clockwise", "clockwise vertical arrows", "reload"}, false, 0, "0.6", nil},
+	{[]rune{0x1f504}, "counterclockwise arrows button", 8, 2, []string{"anticlockwise", "arrow", "counterclockwise", "counterclockwise arrows button", "withershins"}, false, 0, "1.0", nil},
+	{[]rune{0x1f519}, "BACK arrow", 8, 2, []string{"arrow", "back", "BACK arrow"}, false, 0, "0.6", nil},
+	{[]rune{0x1f51a}, "END arrow", 8, 2, []string{"arrow", "end", "END arrow"}, false, 0, "0.6", nil},
+	{[]rune{0x1f51b}, "ON! arrow", 8, 2, []string{"arrow", "mark", "on", "ON! arrow"}, false, 0, "0.6", nil},
+	{[]rune{0x1f51c}, "SOON arrow", 8, 2, []string{"arrow", "soon", "SOON arrow"}, false, 0, "0.6", nil},
+	{[]rune{0x1f51d}, "TOP arrow", 8, 2, []string{"arrow", "top", "TOP arrow", "up"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6d0}, "place of worship", 8, 3, []string{"place of worship", "religion", "worship"}, false, 0, "1.0", nil},
+	{[]rune{0x269b, 0xfe0f}, "atom symbol", 8, 3, []string{"atheist", "atom", "atom symbol"}, false, 0, "1.0", nil},
+	{[]rune{0x1f549, 0xfe0f}, "om", 8, 3, []string{"Hindu", "om", "religion"}, false, 0, "0.7", nil},
+	{[]rune{0x2721, 0xfe0f}, "star of David", 8, 3, []string{"David", "Jew", "Jewish", "religion", "star", "star of David"}, false, 0, "0.7", nil},
+	{[]rune{0x2638, 0xfe0f}, "wheel of dharma", 8, 3, []string{"Buddhist", "dharma", "religion", "wheel", "wheel of dharma"}, false, 0, "0.7", nil},
+	{[]rune{0x262f, 0xfe0f}, "yin yang", 8, 3, []string{"religion", "tao", "taoist", "yang", "yin"}, false, 0, "0.7", nil},
+	{[]rune{0x271d, 0xfe0f}, "latin cross", 8, 3, []string{"Christian", "cross", "latin cross", "religion"}, false, 0, "0.7", nil},
+	{[]rune{0x2626, 0xfe0f}, "orthodox cross", 8, 3, []string{"Christian", "cross", "orthodox cross", "religion"}, false, 0, "1.0", nil},
+	{[]rune{0x262a, 0xfe0f}, "star and crescent", 8, 3, []string{"islam", "Muslim", "religion", "star and crescent"}, false, 0, "0.7", nil},
+	{[]rune{0x262e, 0xfe0f}, "peace symbol", 8, 3, []string{"peace", "peace symbol"}, false, 0, "1.0", nil},
+	{[]rune{0x1f54e}, "menorah", 8, 3, []string{"candelabrum", "candlestick", "menorah", "religion"}, false, 0, "1.0", nil},
+	{[]rune{0x1f52f}, "dotted six-pointed star", 8, 3, []string{"dotted six-pointed star", "fortune", "star"}, false, 0, "0.6", nil},
+	{[]rune{0x2648}, "Aries", 8, 4, []string{"Aries", "ram", "zodiac"}, false, 0, "0.6", nil},
+	{[]rune{0x2649}, "Taurus", 8, 4, []string{"bull", "ox", "Taurus", "zodiac"}, false, 0, "0.6", nil},
+	{[]rune{0x264a}, "Gemini", 8, 4, []string{"Gemini", "twins", "zodiac"}, false, 0, "0.6", nil},
+	{[]rune{0x264b}, "Cancer", 8, 4, []string{"Cancer", "crab", "zodiac"}, false, 0, "0.6", nil},
+	{[]rune{0x264c}, "Leo", 8, 4, []string{"Leo", "lion", "zodiac"}, false, 0, "0.6", nil},
+	{[]rune{0x264d}, "Virgo", 8, 4, []string{"Virgo", "zodiac"}, false, 0, "0.6", nil},
+	{[]rune{0x264e}, "Libra", 8, 4, []string{"balance", "justice", "Libra", "scales", "zodiac"}, false, 0, "0.6", nil},
+	{[]rune{0x264f}, "Scorpio", 8, 4, []string{"Scorpio", "scorpion", "scorpius", "zodiac"}, false, 0, "0.6", nil},
+	{[]rune{0x2650}, "Sagittarius", 8, 4, []string{"archer", "Sagittarius", "zodiac"}, false, 0, "0.6", nil},
+	{[]rune{0x2651}, "Capricorn", 8, 4, []string{"Capricorn", "goat", "zodiac"}, false, 0, "0.6", nil},
+	{[]rune{0x2652}, "Aquarius", 8, 4, []string{"Aquarius", "bearer", "water", "zodiac"}, false, 0, "0.6", nil},
+	{[]rune{0x2653}, "Pisces", 8, 4, []string{"fish", "Pisces", "zodiac"}, false, 0, "0.6", nil},
+	{[]rune{0x26ce}, "Ophiuchus", 8, 4, []string{"bearer", "Ophiuchus", "serpent", "snake", "zodiac"}, false, 0, "0.6", nil},
+	{[]rune{0x1f500}, "shuffle tracks button", 8, 5, []string{"arrow", "crossed", "shuffle tracks button"}, false, 0, "1.0", nil},
+	{[]rune{0x1f501}, "repeat button", 8, 5, []string{"arrow", "clockwise", "repeat", "repeat button"}, false, 0, "1.0", nil},
+	{[]rune{0x1f502}, "repeat single button", 8, 5, []string{"arrow", "clockwise", "once", "repeat single button"}, false, 0, "1.0", nil},
+	{[]rune{0x25b6, 0xfe0f}, "play button", 8, 5, []string{"arrow", "play", "play button", "right", "triangle"}, false, 0, "0.6", nil},
+	{[]rune{0x23e9}, "fast-forward button", 8, 5, []string{"arrow", "double", "fast", "fast-forward button", "forward"}, false, 0, "0.6", nil},
+	{[]rune{0x23ed, 0xfe0f}, "next track button", 8, 5, []string{"arrow", "next scene", "next track", "next track button", "triangle"}, false, 0, "0.7", nil},
+	{[]rune{0x23ef, 0xfe0f}, "play or pause button", 8, 5, []string{"arrow", "pause", "play", "play or pause button", "right", "triangle"}, false, 0, "1.0", nil},
+	{[]rune{0x25c0, 0xfe0f}, "reverse button", 8, 5, []string{"arrow", "left", "reverse", "reverse button", "triangle"}, false, 0, "0.6", nil},
+	{[]rune{0x23ea}, "fast reverse button", 8, 5, []string{"arrow", "double", "fast reverse button", "rewind"}, false, 0, "0.6", nil},
+	{[]rune{0x23ee, 0xfe0f}, "last track button", 8, 5, []string{"arrow", "last track button", "previous scene", "previous track", "triangle"}, false, 0, "0.7", nil},
+	{[]rune{0x1f53c}, "upwards button", 8, 5, []string{"arrow", "button", "red", "upwards button"}, false, 0, "0.6", nil},
+	{[]rune{0x23eb}, "fast up button", 8, 5, []string{"arrow", "double", "fast up button"}, false, 0, "0.6", nil},
+	{[]rune{0x1f53d}, "downwards button", 8, 5, []string{"arrow", "button", "down", "downwards button", "red"}, false, 0, "0.6", nil},
+	{[]rune{0x23ec}, "fast down button", 8, 5, []string{"arrow", "double", "down", "fast down button"}, false, 0, "0.6", nil},
+	{[]rune{0x23f8, 0xfe0f}, "pause button", 8, 5, []string{"bar", "double", "pause", "pause button", "vertical"}, false, 0, "0.7", nil},
+	{[]rune{0x23f9, 0xfe0f}, "stop button", 8, 5, []string{"square", "stop", "stop button"}, false, 0, "0.7", nil},
+	{[]rune{0x23fa, 0xfe0f}, "record button", 8, 5, []string{"circle", "record", "record button"}, false, 0, "0.7", nil},
+	{[]rune{0x23cf, 0xfe0f}, "eject button", 8, 5, []string{"eject", "eject button"}, false, 0, "1.0", nil},
+	{[]rune{0x1f3a6}, "cinema", 8, 5, []string{"camera", "cinema", "film", "movie"}, false, 0, "0.6", nil},
+	{[]rune{0x1f505}, "dim button", 8, 5, []string{"brightness", "dim", "dim button", "low"}, false, 0, "1.0", nil},
+	{[]rune{0x1f506}, "bright button", 8, 5, []string{"bright", "bright button", "brightness"}, false, 0, "1.0", nil},
+	{[]rune{0x1f4f6}, "antenna bars", 8, 5, []string{"antenna", "antenna bars", "bar", "cell", "mobile", "phone"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4f3}, "vibration mode", 8, 5, []string{"cell", "mobile", "mode", "phone", "telephone", "vibration"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4f4}, "mobile phone off", 8, 5, []string{"cell", "mobile", "off", "phone", "telephone"}, false, 0, "0.6", nil},
+	{[]rune{0x2640, 0xfe0f}, "female sign", 8, 6, []string{"female sign", "woman"}, false, 0, "4.0", nil},
+	{[]rune{0x2642, 0xfe0f}, "male sign", 8, 6, []string{"male sign", "man"}, false, 0, "4.0", nil},
+	{[]rune{0x26a7, 0xfe0f}, "transgender symbol", 8, 6, []string{"transgender", "transgender symbol"}, false, 0, "13.0", nil},
+	{[]rune{0x2716, 0xfe0f}, "multiply", 8, 7, []string{"×", "cancel", "multiplication", "multiply", "sign", "x"}, false, 0, "0.6", nil},
+	{[]rune{0x2795}, "plus", 8, 7, []string{"+", "math", "plus", "sign"}, false, 0, "0.6", nil},
+	{[]rune{0x2796}, "minus", 8, 7, []string{"-", "−", "math", "minus", "sign"}, false, 0, "0.6", nil},
+	{[]rune{0x2797}, "divide", 8, 7, []string{"÷", "divide", "division", "math", "sign"}, false, 0, "0.6", nil},
+	{[]rune{0x1f7f0}, "heavy equals sign", 8, 7, []string(nil), false, 0, "14.0", nil},
+	{[]rune{0x267e, 0xfe0f}, "infinity", 8, 7, []string{"forever", "infinity", "unbounded", "universal"}, false, 0, "11.0", nil},
+	{[]rune{0x203c, 0xfe0f}, "double exclamation mark", 8, 8, []string{"!", "!!", "bangbang", "double exclamation mark", "exclamation", "mark"}, false, 0, "0.6", nil},
+	{[]rune{0x2049, 0xfe0f}, "exclamation question mark", 8, 8, []string{"!", "!?", "?", "exclamation", "interrobang", "mark", "punctuation", "question"}, false, 0, "0.6", nil},
+	{[]rune{0x2753}, "red question mark", 8, 8, []string{"?", "mark", "punctuation", "question", "red question mark"}, false, 0, "0.6", nil},
+	{[]rune{0x2754}, "white question mark", 8, 8, []string{"?", "mark", "outlined", "punctuation", "question", "white question mark"}, false, 0, "0.6", nil},
+	{[]rune{0x2755}, "white exclamation mark", 8, 8, []string{"!", "exclamation", "mark", "outlined", "punctuation", "white exclamation mark"}, false, 0, "0.6", nil},
+	{[]rune{0x2757}, "red exclamation mark", 8, 8, []string{"!", "exclamation", "mark", "punctuation", "red exclamation mark"}, false, 0, "0.6", nil},
+	{[]rune{0x3030, 0xfe0f}, "wavy dash", 8, 8, []string{"dash", "punctuation", "wavy"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4b1}, "currency exchange", 8, 9, []string{"bank", "currency", "exchange", "money"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4b2}, "heavy dollar sign", 8, 9, []string{"currency", "dollar", "heavy dollar sign", "money"}, false, 0, "0.6", nil},
+	{[]rune{0x2695, 0xfe0f}, "medical symbol", 8, 10, []string{"aesculapius", "medical symbol", "medicine", "staff"}, false, 0, "4.0", nil},
+	{[]rune{0x267b, 0xfe0f}, "recycling symbol", 8, 10, []string{"recycle", "recycling symbol"}, false, 0, "0.6", nil},
+	{[]rune{0x269c, 0xfe0f}, "fleur-de-lis", 8, 10, []string{"fleur-de-lis"}, false, 0, "1.0", nil},
+	{[]rune{0x1f531}, "trident emblem", 8, 10, []string{"anchor", "emblem", "ship", "tool", "trident"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4db}, "name badge", 8, 10, []string{"badge", "name"}, false, 0, "0.6", nil},
+	{[]rune{0x1f530}, "Japanese symbol for beginner", 8, 10, []string{"beginner", "chevron", "Japanese", "Japanese symbol for beginner", "leaf"}, false, 0, "0.6", nil},
+	{[]rune{0x2b55}, "hollow red circle", 8, 10, []string{"circle", "hollow red circle", "large", "o", "red"}, false, 0, "0.6", nil},
+	{[]rune{0x2705}, "check mark button", 8, 10, []string{"✓", "button", "check", "mark"}, false, 0, "0.6", nil},
+	{[]rune{0x2611, 0xfe0f}, "check box with check", 8, 10, []string{"✓", "box", "check", "check box with check"}, false, 0, "0.6", nil},
+	{[]rune{0x2714, 0xfe0f}, "check mark", 8, 10, []string{"✓", "check", "mark"}, false, 0, "0.6", nil},
+	{[]rune{0x274c}, "cross mark", 8, 10, []string{"×", "cancel", "cross", "mark", "multiplication", "multiply", "x"}, false, 0, "0.6", nil},
+	{[]rune{0x274e}, "cross mark button", 8, 10, []string{"×", "cross mark button", "mark", "square", "x"}, false, 0, "0.6", nil},
+	{[]rune{0x27b0}, "curly loop", 8, 10, []string{"curl", "curly loop", "loop"}, false, 0, "0.6", nil},
+	{[]rune{0x27bf}, "double curly loop", 8, 10, []string{"curl", "double", "double curly loop", "loop"}, false, 0, "1.0", nil},
+	{[]rune{0x303d, 0xfe0f}, "part alternation mark", 8, 10, []string{"mark", "part", "part alternation mark"}, false, 0, "0.6", nil},
+	{[]rune{0x2733, 0xfe0f}, "eight-spoked asterisk", 8, 10, []string{"*", "asterisk", "eight-spoked asterisk"}, false, 0, "0.6", nil},
+	{[]rune{0x2734, 0xfe0f}, "eight-pointed star", 8, 10, []string{"*", "eight-pointed star", "star"}, false, 0, "0.6", nil},
+	{[]rune{0x2747, 0xfe0f}, "sparkle", 8, 10, []string{"*", "sparkle"}, false, 0, "0.6", nil},
+	{[]rune{0xa9, 0xfe0f}, "copyright", 8, 10, []string{"c", "copyright"}, false, 0, "0.6", nil},
+	{[]rune{0xae, 0xfe0f}, "registered", 8, 10, []string{"r", "registered"}, false, 0, "0.6", nil},
+	{[]rune{0x2122, 0xfe0f}, "trade mark", 8, 10, []string{"mark", "tm", "trade mark", "trademark"}, false, 0, "0.6", nil},
+	{[]rune{0x23, 0xfe0f, 0x20e3}, "keycap: #", 8, 11, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x2a, 0xfe0f, 0x20e3}, "keycap: *", 8, 11, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x30, 0xfe0f, 0x20e3}, "keycap: 0", 8, 11, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x31, 0xfe0f, 0x20e3}, "keycap: 1", 8, 11, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x32, 0xfe0f, 0x20e3}, "keycap: 2", 8, 11, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x33, 0xfe0f, 0x20e3}, "keycap: 3", 8, 11, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x34, 0xfe0f, 0x20e3}, "keycap: 4", 8, 11, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x35, 0xfe0f, 0x20e3}, "keycap: 5", 8, 11, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x36, 0xfe0f, 0x20e3}, "keycap: 6", 8, 11, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x37, 0xfe0f, 0x20e3}, "keycap: 7", 8, 11, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x38, 0xfe0f, 0x20e3}, "keycap: 8", 8, 11, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x39, 0xfe0f, 0x20e3}, "keycap: 9", 8, 11, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x1f51f}, "keycap: 10", 8, 11, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x1f520}, "input latin uppercase", 8, 12, []string{"ABCD", "input", "latin", "letters", "uppercase"}, false, 0, "0.6", nil},
+	{[]rune{0x1f521}, "input latin lowercase", 8, 12, []string{"abcd", "input", "latin", "letters", "lowercase"}, false, 0, "0.6", nil},
+	{[]rune{0x1f522}, "input numbers", 8, 12, []string{"1234", "input", "numbers"}, false, 0, "0.6", nil},
+	{[]rune{0x1f523}, "input symbols", 8, 12, []string{"〒♪&amp;%", "input", "input symbols"}, false, 0, "0.6", nil},
+	{[]rune{0x1f524}, "input latin letters", 8, 12, []string{"abc", "alphabet", "input", "latin", "letters"}, false, 0, "0.6", nil},
+	{[]rune{0x1f170, 0xfe0f}, "A button (blood type)", 8, 12, []string{"a", "A button (blood type)", "blood type"}, false, 0, "0.6", nil},
+	{[]rune{0x1f18e}, "AB button (blood type)", 8, 12, []string{"ab", "AB button (blood type)", "blood type"}, false, 0, "0.6", nil},
+	{[]rune{0x1f171, 0xfe0f}, "B button (blood type)", 8, 12, []string{"b", "B button (blood type)", "blood type"}, false, 0, "0.6", nil},
+	{[]rune{0x1f191}, "CL button", 8, 12, []string{"cl", "CL button"}, false, 0, "0.6", nil},
+	{[]rune{0x1f192}, "COOL button", 8, 12, []string{"cool", "COOL button"}, false, 0, "0.6", nil},
+	{[]rune{0x1f193}, "FREE button", 8, 12, []string{"free", "FREE button"}, false, 0, "0.6", nil},
+	{[]rune{0x2139, 0xfe0f}, "information", 8, 12, []string{"i", "information"}, false, 0, "0.6", nil},
+	{[]rune{0x1f194}, "ID button", 8, 12, []string{"id", "ID button", "identity"}, false, 0, "0.6", nil},
+	{[]rune{0x24c2, 0xfe0f}, "circled M", 8, 12, []string{"circle", "circled M", "m"}, false, 0, "0.6", nil},
+	{[]rune{0x1f195}, "NEW button", 8, 12, []string{"new", "NEW button"}, false, 0, "0.6", nil},
+	{[]rune{0x1f196}, "NG button", 8, 12, []string{"ng", "NG button"}, false, 0, "0.6", nil},
+	{[]rune{0x1f17e, 0xfe0f}, "O button (blood type)", 8, 12, []string{"blood type", "o", "O button (blood type)"}, false, 0, "0.6", nil},
+	{[]rune{0x1f197}, "OK button", 8, 12, []string{"OK", "OK button"}, false, 0, "0.6", nil},
+	{[]rune{0x1f17f, 0xfe0f}, "P button", 8, 12, []string{"P button", "parking"}, false, 0, "0.6", nil},
+	{[]rune{0x1f198}, "SOS button", 8, 12, []string{"help", "sos", "SOS button"}, false, 0, "0.6", nil},
+	{[]rune{0x1f199}, "UP! button", 8, 12, []string{"mark", "up", "UP! button"}, false, 0, "0.6", nil},
+	{[]rune{0x1f19a}, "VS button", 8, 12, []string{"versus", "vs", "VS button"}, false, 0, "0.6", nil},
+	{[]rune{0x1f201}, "Japanese “here” button", 8, 12, []string{"“here”", "Japanese", "Japanese “here” button", "katakana", "ココ"}, false, 0, "0.6", nil},
+	{[]rune{0x1f202, 0xfe0f}, "Japanese “service charge” button", 8, 12, []string{"“service charge”", "Japanese", "Japanese “service charge” button", "katakana", "サ"}, false, 0, "0.6", nil},
+	{[]rune{0x1f237, 0xfe0f}, "Japanese “monthly amount” button", 8, 12, []string{"“monthly amount”", "ideograph", "Japanese", "Japanese “monthly amount” button", "月"}, false, 0, "0.6", nil},
+	{[]rune{0x1f236}, "Japanese “not free of charge” button", 8, 12, []string{"“not free of charge”", "ideograph", "Japanese", "Japanese “not free of charge” button", "有"}, false, 0, "0.6", nil},
+	{[]rune{0x1f22f}, "Japanese “reserved” button", 8, 12, []string{"“reserved”", "ideograph", "Japanese", "Japanese “reserved” button", "指"}, false, 0, "0.6", nil},
+	{[]rune{0x1f250}, "Japanese “bargain” button", 8, 12, []string{"“bargain”", "ideograph", "Japanese", "Japanese “bargain” button", "得"}, false, 0, "0.6", nil},
+	{[]rune{0x1f239}, "Japanese “discount” button", 8, 12, []string{"“discount”", "ideograph", "Japanese", "Japanese “discount” button", "割"}, false, 0, "0.6", nil},
+	{[]rune{0x1f21a}, "Japanese “free of charge” button", 8, 12, []string{"“free of charge”", "ideograph", "Japanese", "Japanese “free of charge” button", "無"}, false, 0, "0.6", nil},
+	{[]rune{0x1f232}, "Japanese “prohibited” button", 8, 12, []string{"“prohibited”", "ideograph", "Japanese", "Japanese “prohibited” button", "禁"}, false, 0, "0.6", nil},
+	{[]rune{0x1f251}, "Japanese “acceptable” button", 8, 12, []string{"“acceptable”", "ideograph", "Japanese", "Japanese “acceptable” button", "可"}, false, 0, "0.6", nil},
+	{[]rune{0x1f238}, "Japanese “application” button", 8, 12, []string{"“application”", "ideograph", "Japanese", "Japanese “application” button", "申"}, false, 0, "0.6", nil},
+	{[]rune{0x1f234}, "Japanese “passing grade” button", 8, 12, []string{"“passing grade”", "ideograph", "Japanese", "Japanese “passing grade” button", "合"}, false, 0, "0.6", nil},
+	{[]rune{0x1f233}, "Japanese “vacancy” button", 8, 12, []string{"“vacancy”", "ideograph", "Japanese", "Japanese “vacancy” button", "空"}, false, 0, "0.6", nil},
+	{[]rune{0x3297, 0xfe0f}, "Japanese “congratulations” button", 8, 12, []string{"“congratulations”", "ideograph", "Japanese", "Japanese “congratulations” button", "祝"}, false, 0, "0.6", nil},
+	{[]rune{0x3299, 0xfe0f}, "Japanese “secret” button", 8, 12, []string{"“secret”", "ideograph", "Japanese", "Japanese “secret” button", "秘"}, false, 0, "0.6", nil},
+	{[]rune{0x1f23a}, "Japanese “open for business” button", 8, 12, []string{"“open for business”", "ideograph", "Japanese", "Japanese “open for business” button", "営"}, false, 0, "0.6", nil},
+	{[]rune{0x1f235}, "Japanese “no vacancy” button", 8, 12, []string{"“no vacancy”", "ideograph", "Japanese", "Japanese “no vacancy” button", "満"}, false, 0, "0.6", nil},
+	{[]rune{0x1f534}, "red circle", 8, 13, []string{"circle", "geometric", "red"}, false, 0, "0.6", nil},
+	{[]rune{0x1f7e0}, "orange circle", 8, 13, []string{"circle", "orange"}, false, 0, "12.0", nil},
+	{[]rune{0x1f7e1}, "yellow circle", 8, 13, []string{"circle", "yellow"}, false, 0, "12.0", nil},
+	{[]rune{0x1f7e2}, "green circle", 8, 13, []string{"circle", "green"}, false, 0, "12.0", nil},
+	{[]rune{0x1f535}, "blue circle", 8, 13, []string{"blue", "circle", "geometric"}, false, 0, "0.6", nil},
+	{[]rune{0x1f7e3}, "purple circle", 8, 13, []string{"circle", "purple"}, false, 0, "12.0", nil},
+	{[]rune{0x1f7e4}, "brown circle", 8, 13, []string{"brown", "circle"}, false, 0, "12.0", nil},
+	{[]rune{0x26ab}, "black circle", 8, 13, []string{"black circle", "circle", "geometric"}, false, 0, "0.6", nil},
+	{[]rune{0x26aa}, "white circle", 8, 13, []string{"circle", "geometric", "white circle"}, false, 0, "0.6", nil},
+	{[]rune{0x1f7e5}, "red square", 8, 13, []string{"red", "square"}, false, 0, "12.0", nil},
+	{[]rune{0x1f7e7}, "orange square", 8, 13, []string{"orange", "square"}, false, 0, "12.0", nil},
+	{[]rune{0x1f7e8}, "yellow square", 8, 13, []string{"square", "yellow"}, false, 0, "12.0", nil},
+	{[]rune{0x1f7e9}, "green square", 8, 13, []string{"green", "square"}, false, 0, "12.0", nil},
+	{[]rune{0x1f7e6}, "blue square", 8, 13, []string{"blue", "square"}, false, 0, "12.0", nil},
+	{[]rune{0x1f7ea}, "purple square", 8, 13, []string{"purple", "square"}, false, 0, "12.0", nil},
+	{[]rune{0x1f7eb}, "brown square", 8, 13, []string{"brown", "square"}, false, 0, "12.0", nil},
+	{[]rune{0x2b1b}, "black large square", 8, 13, []string{"black large square", "geometric", "square"}, false, 0, "0.6", nil},
+	{[]rune{0x2b1c}, "white large square", 8, 13, []string{"geometric", "square", "white large square"}, false, 0, "0.6", nil},
+	{[]rune{0x25fc, 0xfe0f}, "black medium square", 8, 13, []string{"black medium square", "geometric", "square"}, false, 0, "0.6", nil},
+	{[]rune{0x25fb, 0xfe0f}, "white medium square", 8, 13, []string{"geometric", "square", "white medium square"}, false, 0, "0.6", nil},
+	{[]rune{0x25fe}, "black medium-small square", 8, 13, []string{"black medium-small square", "geometric", "square"}, false, 0, "0.6", nil},
+	{[]rune{0x25fd}, "white medium-small square", 8, 13, []string{"geometric", "square", "white medium-small square"}, false, 0, "0.6", nil},
+	{[]rune{0x25aa, 0xfe0f}, "black small square", 8, 13, []string{"black small square", "geometric", "square"}, false, 0, "0.6", nil},
+	{[]rune{0x25ab, 0xfe0f}, "white small square", 8, 13, []string{"geometric", "square", "white small square"}, false, 0, "0.6", nil},
+	{[]rune{0x1f536}, "large orange diamond", 8, 13, []string{"diamond", "geometric", "large orange diamond", "orange"}, false, 0, "0.6", nil},
+	{[]rune{0x1f537}, "large blue diamond", 8, 13, []string{"blue", "diamond", "geometric", "large blue diamond"}, false, 0, "0.6", nil},
+	{[]rune{0x1f538}, "small orange diamond", 8, 13, []string{"diamond", "geometric", "orange", "small orange diamond"}, false, 0, "0.6", nil},
+	{[]rune{0x1f539}, "small blue diamond", 8, 13, []string{"blue", "diamond", "geometric", "small blue diamond"}, false, 0, "0.6", nil},
+	{[]rune{0x1f53a}, "red triangle pointed up", 8, 13, []string{"geometric", "red", "red triangle pointed up"}, false, 0, "0.6", nil},
+	{[]rune{0x1f53b}, "red triangle pointed down", 8, 13, []string{"down", "geometric", "red", "red triangle pointed down"}, false, 0, "0.6", nil},
+	{[]rune{0x1f4a0}, "diamond with a dot", 8, 13, []string{"comic", "diamond", "diamond with a dot", "geometric", "inside"}, false, 0, "0.6", nil},
+	{[]rune{0x1f518}, "radio button", 8, 13, []string{"button", "geometric", "radio"}, false, 0, "0.6", nil},
+	{[]rune{0x1f533}, "white square button", 8, 13, []string{"button", "geometric", "outlined", "square", "white square button"}, false, 0, "0.6", nil},
+	{[]rune{0x1f532}, "black square button", 8, 13, []string{"black square button", "button", "geometric", "square"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3c1}, "chequered flag", 9, 0, []string{"checkered", "chequered", "chequered flag", "racing"}, false, 0, "0.6", nil},
+	{[]rune{0x1f6a9}, "triangular flag", 9, 0, []string{"post", "triangular flag"}, false, 0, "0.6", nil},
+	{[]rune{0x1f38c}, "crossed flags", 9, 0, []string{"celebration", "cross", "crossed", "crossed flags", "Japanese"}, false, 0, "0.6", nil},
+	{[]rune{0x1f3f4}, "black flag", 9, 0, []string{"black flag", "waving"}, false, 0, "1.0", nil},
+	{[]rune{0x1f3f3, 0xfe0f}, "white flag", 9, 0, []string{"waving", "white flag"}, false, 0, "0.7", nil},
+	{[]rune{0x1f3f3, 0xfe0f, 0x1f308}, "rainbow flag", 9, 0, []string{"pride", "rainbow", "rainbow flag"}, false, 0, "4.0", nil},
+	{[]rune{0x1f3f3, 0xfe0f, 0x26a7, 0xfe0f}, "transgender flag", 9, 0, []string{"flag", "light blue", "pink", "transgender", "white"}, false, 0, "13.0", nil},
+	{[]rune{0x1f3f4, 0x2620, 0xfe0f}, "pirate flag", 9, 0, []string{"Jolly Roger", "pirate", "pirate flag", "plunder", "treasure"}, false, 0, "11.0", nil},
+	{[]rune{0x1f1e6, 0x1f1e8}, "flag: Ascension Island", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1e9}, "flag: Andorra", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1ea}, "flag: United Arab Emirates", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1eb}, "flag: Afghanistan", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1ec}, "flag: Antigua & Barbuda", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1ee}, "flag: Anguilla", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1f1}, "flag: Albania", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1f2}, "flag: Armenia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1f4}, "flag: Angola", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1f6}, "flag: Antarctica", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1f7}, "flag: Argentina", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1f8}, "flag: American Samoa", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1f9}, "flag: Austria", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1fa}, "flag: Australia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1fc}, "flag: Aruba", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1fd}, "flag: Åland Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e6, 0x1f1ff}, "flag: Azerbaijan", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1e6}, "flag: Bosnia & Herzegovina", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1e7}, "flag: Barbados", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1e9}, "flag: Bangladesh", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1ea}, "flag: Belgium", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1eb}, "flag: Burkina Faso", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1ec}, "flag: Bulgaria", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1ed}, "flag: Bahrain", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1ee}, "flag: Burundi", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1ef}, "flag: Benin", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1f1}, "flag: St. Barthélemy", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1f2}, "flag: Bermuda", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1f3}, "flag: Brunei", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1f4}, "flag: Bolivia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1f6}, "flag: Caribbean Netherlands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1f7}, "flag: Brazil", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1f8}, "flag: Bahamas", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1f9}, "flag: Bhutan", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1fb}, "flag: Bouvet Island", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1fc}, "flag: Botswana", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1fe}, "flag: Belarus", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e7, 0x1f1ff}, "flag: Belize", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1e6}, "flag: Canada", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1e8}, "flag: Cocos (Keeling) Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1e9}, "flag: Congo - Kinshasa", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1eb}, "flag: Central African Republic", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1ec}, "flag: Congo - Brazzaville", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1ed}, "flag: Switzerland", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1ee}, "flag: Côte d’Ivoire", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1f0}, "flag: Cook Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1f1}, "flag: Chile", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1f2}, "flag: Cameroon", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1f3}, "flag: China", 9, 1, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x1f1e8, 0x1f1f4}, "flag: Colombia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1f5}, "flag: Clipperton Island", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1f7}, "flag: Costa Rica", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1fa}, "flag: Cuba", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1fb}, "flag: Cape Verde", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1fc}, "flag: Curaçao", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1fd}, "flag: Christmas Island", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1fe}, "flag: Cyprus", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e8, 0x1f1ff}, "flag: Czechia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e9, 0x1f1ea}, "flag: Germany", 9, 1, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x1f1e9, 0x1f1ec}, "flag: Diego Garcia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e9, 0x1f1ef}, "flag: Djibouti", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e9, 0x1f1f0}, "flag: Denmark", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e9, 0x1f1f2}, "flag: Dominica", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e9, 0x1f1f4}, "flag: Dominican Republic", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1e9, 0x1f1ff}, "flag: Algeria", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ea, 0x1f1e6}, "flag: Ceuta & Melilla", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ea, 0x1f1e8}, "flag: Ecuador", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ea, 0x1f1ea}, "flag: Estonia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ea, 0x1f1ec}, "flag: Egypt", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ea, 0x1f1ed}, "flag: Western Sahara", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ea, 0x1f1f7}, "flag: Eritrea", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ea, 0x1f1f8}, "flag: Spain", 9, 1, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x1f1ea, 0x1f1f9}, "flag: Ethiopia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ea, 0x1f1fa}, "flag: European Union", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1eb, 0x1f1ee}, "flag: Finland", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1eb, 0x1f1ef}, "flag: Fiji", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1eb, 0x1f1f0}, "flag: Falkland Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1eb, 0x1f1f2}, "flag: Micronesia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1eb, 0x1f1f4}, "flag: Faroe Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1eb, 0x1f1f7}, "flag: France", 9, 1, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x1f1ec, 0x1f1e6}, "flag: Gabon", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1e7}, "flag: United Kingdom", 9, 1, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x1f1ec, 0x1f1e9}, "flag: Grenada", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1ea}, "flag: Georgia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1eb}, "flag: French Guiana", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1ec}, "flag: Guernsey", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1ed}, "flag: Ghana", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1ee}, "flag: Gibraltar", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1f1}, "flag: Greenland", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1f2}, "flag: Gambia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1f3}, "flag: Guinea", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1f5}, "flag: Guadeloupe", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1f6}, "flag: Equatorial Guinea", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1f7}, "flag: Greece", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1f8}, "flag: South Georgia & South Sandwich Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1f9}, "flag: Guatemala", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1fa}, "flag: Guam", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1fc}, "flag: Guinea-Bissau", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ec, 0x1f1fe}, "flag: Guyana", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ed, 0x1f1f0}, "flag: Hong Kong SAR China", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ed, 0x1f1f2}, "flag: Heard & McDonald Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ed, 0x1f1f3}, "flag: Honduras", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ed, 0x1f1f7}, "flag: Croatia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ed, 0x1f1f9}, "flag: Haiti", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ed, 0x1f1fa}, "flag: Hungary", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ee, 0x1f1e8}, "flag: Canary Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ee, 0x1f1e9}, "flag: Indonesia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ee, 0x1f1ea}, "flag: Ireland", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ee, 0x1f1f1}, "flag: Israel", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ee, 0x1f1f2}, "flag: Isle of Man", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ee, 0x1f1f3}, "flag: India", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ee, 0x1f1f4}, "flag: British Indian Ocean Territory", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ee, 0x1f1f6}, "flag: Iraq", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ee, 0x1f1f7}, "flag: Iran", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ee, 0x1f1f8}, "flag: Iceland", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ee, 0x1f1f9}, "flag: Italy", 9, 1, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x1f1ef, 0x1f1ea}, "flag: Jersey", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ef, 0x1f1f2}, "flag: Jamaica", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ef, 0x1f1f4}, "flag: Jordan", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ef, 0x1f1f5}, "flag: Japan", 9, 1, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x1f1f0, 0x1f1ea}, "flag: Kenya", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f0, 0x1f1ec}, "flag: Kyrgyzstan", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f0, 0x1f1ed}, "flag: Cambodia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f0, 0x1f1ee}, "flag: Kiribati", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f0, 0x1f1f2}, "flag: Comoros", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f0, 0x1f1f3}, "flag: St. Kitts & Nevis", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f0, 0x1f1f5}, "flag: North Korea", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f0, 0x1f1f7}, "flag: South Korea", 9, 1, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x1f1f0, 0x1f1fc}, "flag: Kuwait", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f0, 0x1f1fe}, "flag: Cayman Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f0, 0x1f1ff}, "flag: Kazakhstan", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f1, 0x1f1e6}, "flag: Laos", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f1, 0x1f1e7}, "flag: Lebanon", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f1, 0x1f1e8}, "flag: St. Lucia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f1, 0x1f1ee}, "flag: Liechtenstein", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f1, 0x1f1f0}, "flag: Sri Lanka", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f1, 0x1f1f7}, "flag: Liberia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f1, 0x1f1f8}, "flag: Lesotho", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f1, 0x1f1f9}, "flag: Lithuania", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f1, 0x1f1fa}, "flag: Luxembourg", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f1, 0x1f1fb}, "flag: Latvia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f1, 0x1f1fe}, "flag: Libya", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1e6}, "flag: Morocco", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1e8}, "flag: Monaco", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1e9}, "flag: Moldova", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1ea}, "flag: Montenegro", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1eb}, "flag: St. Martin", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1ec}, "flag: Madagascar", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1ed}, "flag: Marshall Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1f0}, "flag: North Macedonia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1f1}, "flag: Mali", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1f2}, "flag: Myanmar (Burma)", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1f3}, "flag: Mongolia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1f4}, "flag: Macao SAR China", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1f5}, "flag: Northern Mariana Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1f6}, "flag: Martinique", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1f7}, "flag: Mauritania", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1f8}, "flag: Montserrat", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1f9}, "flag: Malta", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1fa}, "flag: Mauritius", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1fb}, "flag: Maldives", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1fc}, "flag: Malawi", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1fd}, "flag: Mexico", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1fe}, "flag: Malaysia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f2, 0x1f1ff}, "flag: Mozambique", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f3, 0x1f1e6}, "flag: Namibia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f3, 0x1f1e8}, "flag: New Caledonia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f3, 0x1f1ea}, "flag: Niger", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f3, 0x1f1eb}, "flag: Norfolk Island", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f3, 0x1f1ec}, "flag: Nigeria", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f3, 0x1f1ee}, "flag: Nicaragua", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f3, 0x1f1f1}, "flag: Netherlands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f3, 0x1f1f4}, "flag: Norway", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f3, 0x1f1f5}, "flag: Nepal", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f3, 0x1f1f7}, "flag: Nauru", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f3, 0x1f1fa}, "flag: Niue", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f3, 0x1f1ff}, "flag: New Zealand", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f4, 0x1f1f2}, "flag: Oman", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f5, 0x1f1e6}, "flag: Panama", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f5, 0x1f1ea}, "flag: Peru", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f5, 0x1f1eb}, "flag: French Polynesia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f5, 0x1f1ec}, "flag: Papua New Guinea", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f5, 0x1f1ed}, "flag: Philippines", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f5, 0x1f1f0}, "flag: Pakistan", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f5, 0x1f1f1}, "flag: Poland", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f5, 0x1f1f2}, "flag: St. Pierre & Miquelon", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f5, 0x1f1f3}, "flag: Pitcairn Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f5, 0x1f1f7}, "flag: Puerto Rico", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f5, 0x1f1f8}, "flag: Palestinian Territories", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f5, 0x1f1f9}, "flag: Portugal", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f5, 0x1f1fc}, "flag: Palau", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f5, 0x1f1fe}, "flag: Paraguay", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f6, 0x1f1e6}, "flag: Qatar", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f7, 0x1f1ea}, "flag: Réunion", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f7, 0x1f1f4}, "flag: Romania", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f7, 0x1f1f8}, "flag: Serbia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f7, 0x1f1fa}, "flag: Russia", 9, 1, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x1f1f7, 0x1f1fc}, "flag: Rwanda", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1e6}, "flag: Saudi Arabia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1e7}, "flag: Solomon Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1e8}, "flag: Seychelles", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1e9}, "flag: Sudan", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1ea}, "flag: Sweden", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1ec}, "flag: Singapore", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1ed}, "flag: St. Helena", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1ee}, "flag: Slovenia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1ef}, "flag: Svalbard & Jan Mayen", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1f0}, "flag: Slovakia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1f1}, "flag: Sierra Leone", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1f2}, "flag: San Marino", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1f3}, "flag: Senegal", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1f4}, "flag: Somalia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1f7}, "flag: Suriname", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1f8}, "flag: South Sudan", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1f9}, "flag: São Tomé & Príncipe", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1fb}, "flag: El Salvador", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1fd}, "flag: Sint Maarten", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1fe}, "flag: Syria", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f8, 0x1f1ff}, "flag: Eswatini", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1e6}, "flag: Tristan da Cunha", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1e8}, "flag: Turks & Caicos Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1e9}, "flag: Chad", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1eb}, "flag: French Southern Territories", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1ec}, "flag: Togo", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1ed}, "flag: Thailand", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1ef}, "flag: Tajikistan", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1f0}, "flag: Tokelau", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1f1}, "flag: Timor-Leste", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1f2}, "flag: Turkmenistan", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1f3}, "flag: Tunisia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1f4}, "flag: Tonga", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1f7}, "flag: Türkiye", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1f9}, "flag: Trinidad & Tobago", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1fb}, "flag: Tuvalu", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1fc}, "flag: Taiwan", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1f9, 0x1f1ff}, "flag: Tanzania", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fa, 0x1f1e6}, "flag: Ukraine", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fa, 0x1f1ec}, "flag: Uganda", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fa, 0x1f1f2}, "flag: U.S. Outlying Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fa, 0x1f1f3}, "flag: United Nations", 9, 1, []string(nil), false, 0, "4.0", nil},
+	{[]rune{0x1f1fa, 0x1f1f8}, "flag: United States", 9, 1, []string(nil), false, 0, "0.6", nil},
+	{[]rune{0x1f1fa, 0x1f1fe}, "flag: Uruguay", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fa, 0x1f1ff}, "flag: Uzbekistan", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fb, 0x1f1e6}, "flag: Vatican City", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fb, 0x1f1e8}, "flag: St. Vincent & Grenadines", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fb, 0x1f1ea}, "flag: Venezuela", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fb, 0x1f1ec}, "flag: British Virgin Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fb, 0x1f1ee}, "flag: U.S. Virgin Islands", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fb, 0x1f1f3}, "flag: Vietnam", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fb, 0x1f1fa}, "flag: Vanuatu", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fc, 0x1f1eb}, "flag: Wallis & Futuna", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fc, 0x1f1f8}, "flag: Samoa", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fd, 0x1f1f0}, "flag: Kosovo", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fe, 0x1f1ea}, "flag: Yemen", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1fe, 0x1f1f9}, "flag: Mayotte", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ff, 0x1f1e6}, "flag: South Africa", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ff, 0x1f1f2}, "flag: Zambia", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f1ff, 0x1f1fc}, "flag: Zimbabwe", 9, 1, []string(nil), false, 0, "2.0", nil},
+	{[]rune{0x1f3f4, 0xe0067, 0xe0062, 0xe0065, 0xe006e, 0xe0067, 0xe007f}, "flag: England", 9, 2, []string(nil), false, 0, "5.0", nil},
+	{[]rune{0x1f3f4, 0xe0067, 0xe0062, 0xe0073, 0xe0063, 0xe0074, 0xe007f}, "flag: Scotland", 9, 2, []string(nil), false, 0, "5.0", nil},
+	{[]rune{0x1f3f4, 0xe0067, 0xe0062, 0xe0077, 0xe006c, 0xe0073, 0xe007f}, "flag: Wales", 9, 2, []string(nil), false, 0, "5.0", nil},
 }
 
@@ -0,0 +1,248 @@
+package unidata
+
+import "unicode/utf8"
+
+var lineTable = newRangeTable(LineBreaks)
+
+// LineBreak gets the Line_Break property as the short name, such as "AL"
+// (alphabetic) or "BA" (break after); this is "XX" if it has none.
+func (c Codepoint) LineBreak() string { return lineTable.lookup(c.Codepoint, "XX") }
+
+// Lines splits s at every line break opportunity, as defined in UAX #14;
+// spaces are included at the end of a segment. For example "Hello, world" is
+// split in "Hello, " and "world".
+//
+// Numbers use the tailoring from example 7 in section 8.2, as the test data
+// from Unicode does; this keeps things like "$(12.35)" together. Scripts such
+// as Thai that need a dictionary to find word boundaries (the SA class) are
+// treated as alphabetic, and are never broken.
+//
+// https://www.unicode.org/reports/tr14/
+func Lines(s string) []string {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return nil
+	}
+
+	// LB1: resolve classes not used in the algorithm.
+	cls := make([]string, len(runes))
+	for i, r := range runes {
+		switch c := lineTable.lookup(r, "XX"); c {
+		case "AI", "SG", "XX":
+			cls[i] = "AL"
+		case "CJ":
+			cls[i] = "NS"
+		case "SA":
+			cls[i] = "AL"
+			if info, _ := Find(r); info.Cat == CatNonspacingMark || info.Cat == CatSpacingMark {
+				cls[i] = "CM"
+			}
+		default:
+			cls[i] = c
+		}
+	}
+
+	// LB9: combining marks and ZWJ are treated as the character before it,
+	// and LB10: as AL if there is no such character.
+	var (
+		base = make([]int, len(runes))
+		eff  = make([]string, len(runes))
+	)
+	for i := range cls {
+		base[i], eff[i] = i, cls[i]
+		if cls[i] != "CM" && cls[i] != "ZWJ" {
+			continue
+		}
+		if i > 0 {
+			switch eff[i-1] {
+			case "BK", "CR", "LF", "NL", "SP", "ZW":
+			default:
+				base[i], eff[i] = base[i-1], eff[i-1]
+				continue
+			}
+		}
+		eff[i] = "AL"
+	}
+
+	// Get the index of the character before i, treating combining marks as
+	// the character they combine with; this returns -1 at the start.
+	prev := func(i int) int {
+		if i <= 0 {
+			return -1
+		}
+		return base[i-1]
+	}
+	class := func(i int) string {
+		if i < 0 || i >= len(eff) {
+			return ""
+		}
+		return eff[i]
+	}
+	// Skip back over spaces, as in "OP SP* ×".
+	skipSP := func(i int) string {
+		for ; class(i) == "SP"; i = prev(i) {
+		}
+		return class(i)
+	}
+	// Get the class of the character after i.
+	next := func(i int) string {
+		for i++; i < len(eff) && base[i] != i; i++ {
+		}
+		return class(i)
+	}
+	// East Asian Width is fullwidth, wide, or halfwidth.
+	eastAsian := func(r rune) bool {
+		info, _ := Find(r)
+		return info.Width == WidthFullWidth || info.Width == WidthWide || info.Width == WidthHalfWidth
+	}
+	is := func(c string, classes ...string) bool {
+		for _, cc := range classes {
+			if c == cc {
+				return true
+			}
+		}
+		return false
+	}
+
+	return splitAt(s, runes, func(i int) bool {
+		switch x := cls[i-1]; {
+		case x == "BK": // LB4
+			return true
+		case x == "CR" && cls[i] == "LF": // LB5
+			return false
+		case is(x, "CR", "LF", "NL"): // LB5
+			return true
+		case is(cls[i], "BK", "CR", "LF", "NL"): // LB6
+			return false
+		case is(cls[i], "SP", "ZW"): // LB7
+			return false
+		case skipSP(i-1) == "ZW": // LB8
+			return true
+		case x == "ZWJ": // LB8a
+			return false
+		case base[i] != i: // LB9
+			return false
+		}
+
+		j := prev(i)
+		a, b := class(j), eff[i]
+		switch {
+		case a == "WJ" || b == "WJ": // LB11
+			return false
+		case a == "GL": // LB12
+			return false
+		case b == "GL" && !is(a, "SP", "BA", "HY"): // LB12a
+			return false
+		case is(b, "CL", "CP", "EX", "IS", "SY"): // LB13
+			return false
+		case skipSP(j) == "OP": // LB14
+			return false
+		case b == "OP" && skipSP(j) == "QU": // LB15
+			return false
+		case b == "NS" && is(skipSP(j), "CL", "CP"): // LB16
+			return false
+		case b == "B2" && skipSP(j) == "B2": // LB17
+			return false
+		case a == "SP": // LB18
+			return true
+		case a == "QU" || b == "QU": // LB19
+			return false
+		case a == "CB" || b == "CB": // LB20
+			return true
+		case is(b, "BA", "HY", "NS") || a == "BB": // LB21
+			return false
+		case is(a, "HY", "BA") && class(prev(j)) == "HL": // LB21a
+			return false
+		case a == "SY" && b == "HL": // LB21b
+			return false
+		case b == "IN": // LB22
+			return false
+		case is(a, "AL", "HL") && b == "NU", a == "NU" && is(b, "AL", "HL"): // LB23
+			return false
+		case a == "PR" && is(b, "ID", "EB", "EM"), is(a, "ID", "EB", "EM") && b == "PO": // LB23a
+			return false
+		case is(a, "PR", "PO") && is(b, "AL", "HL"), is(a, "AL", "HL") && is(b, "PR", "PO"): // LB24
+			return false
+		case lineNumber(a, b, j, prev, class, next(i)): // LB25
+			return false
+		case a == "JL" && is(b, "JL", "JV", "H2", "H3"), // LB26
+			is(a, "JV", "H2") && is(b, "JV", "JT"),
+			is(a, "JT", "H3") && b == "JT":
+			return false
+		case is(a, "JL", "JV", "JT", "H2", "H3") && b == "PO", a == "PR" && is(b, "JL", "JV", "JT", "H2", "H3"): // LB27
+			return false
+		case is(a, "AL", "HL") && is(b, "AL", "HL"): // LB28
+			return false
+		case a == "IS" && is(b, "AL", "HL"): // LB29
+			return false
+		case is(a, "AL", "HL", "NU") && b == "OP" && !eastAsian(runes[i]), // LB30
+			a == "CP" && !eastAsian(runes[j]) && is(b, "AL", "HL", "NU"):
+			return false
+		case a == "RI" && b == "RI": // LB30a
+			n := 0
+			for ; class(j) == "RI"; j = prev(j) {
+				n++
+			}
+			return n%2 == 0
+		case b == "EM" && a == "EB": // LB30b
+			return false
+		case b == "EM" && inRanges(runes[j], Props[PropExtendedPictographic]):
+			if info, ok := Find(runes[j]); !ok || info.Cat == CatUnassigned {
+				return false
+			}
+		}
+		return true // LB31
+	})
+}
+
+// lineNumber reports if there's no break between a and b because of the
+// tailored LB25:
+//
+//	(PR | PO) × ( OP | HY )? NU
+//	( OP | HY ) × NU
+//	NU × (NU | SY | IS)
+//	NU (NU | SY | IS)* × (NU | SY | IS | CL | CP)
+//	NU (NU | SY | IS)* (CL | CP)? × (PO | PR)
+func lineNumber(a, b string, j int, prev func(int) int, class func(int) string, next string) bool {
+	// Check for "NU (NU | SY | IS)*" ending at j.
+	number := func(j int) bool {
+		for ; ; j = prev(j) {
+			switch class(j) {
+			case "NU":
+				return true
+			case "SY", "IS":
+			default:
+				return false
+			}
+		}
+	}
+
+	switch {
+	case (a == "PR" || a == "PO") && b == "NU":
+		return true
+	case (a == "PR" || a == "PO") && (b == "OP" || b == "HY") && next == "NU":
+		return true
+	case (a == "OP" || a == "HY") && b == "NU":
+		return true
+	case b == "NU" || b == "SY" || b == "IS" || b == "CL" || b == "CP":
+		return number(j)
+	case b == "PO" || b == "PR":
+		if a == "CL" || a == "CP" {
+			j = prev(j)
+		}
+		return number(j)
+	}
+	return false
+}
+
+// MandatoryBreak reports if there is a mandatory break after the segment s,
+// which is the case if it ends with a newline or other line terminator (the
+// BK, CR, LF, and NL classes).
+func MandatoryBreak(s string) bool {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	switch lineTable.lookup(r, "XX") {
+	case "BK", "CR", "LF", "NL":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,7 @@
+package unidata
+
+import "testing"
+
+func TestLines(t *testing.T) {
+	testBreaks(t, "testdata/LineBreakTest.txt", Lines)
+}
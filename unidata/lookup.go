@@ -0,0 +1,106 @@
+package unidata
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Reverse indexes for the HTMLEntities, KeySyms, and Digraph fields and
+// HTMLSequences; these are built on first use as it's a bit slow.
+var (
+	indexOnce                 sync.Once
+	htmlIndex                 map[string][]rune
+	keysymIndex, digraphIndex map[string]rune
+)
+
+func buildIndexes() {
+	var (
+		html    = make(map[string][]rune)
+		keysym  = make(map[string]rune)
+		digraph = make(map[string]rune)
+	)
+	add := func(m map[string]rune, k string, cp rune) {
+		// Use the lowest codepoint if there are several, so the result doesn't
+		// depend on the map order.
+		if o, ok := m[k]; k != "" && (!ok || cp < o) {
+			m[k] = cp
+		}
+	}
+	for cp, info := range Codepoints {
+		for _, e := range info.HTMLEntities {
+			if o, ok := html[e]; !ok || cp < o[0] {
+				html[e] = []rune{cp}
+			}
+		}
+		for _, k := range info.KeySyms {
+			add(keysym, k, cp)
+		}
+		add(digraph, info.Digraph, cp)
+	}
+	for seq, ents := range HTMLSequences {
+		for _, e := range ents {
+			html[e] = []rune(seq)
+		}
+	}
+	htmlIndex, keysymIndex, digraphIndex = html, keysym, digraph
+}
+
+// FindHTMLEntity finds the codepoints for an HTML entity, which can be a named
+// entity with or without the & and ; ("&euro;", "euro") or a numeric character
+// reference ("&#x20AC;", "&#8364;"). Named entities are case-sensitive.
+//
+// Most entities are a single codepoint, but some are a sequence of several:
+// "&NotEqualTilde;" is U+2242 U+0338.
+func FindHTMLEntity(e string) ([]Codepoint, bool) {
+	e = strings.TrimSuffix(strings.TrimPrefix(e, "&"), ";")
+	if strings.HasPrefix(e, "#") {
+		base := 10
+		e = e[1:]
+		if strings.HasPrefix(e, "x") || strings.HasPrefix(e, "X") {
+			base, e = 16, e[1:]
+		}
+		n, err := strconv.ParseInt(e, base, 32)
+		if err != nil {
+			return nil, false
+		}
+		info, ok := Find(rune(n))
+		if !ok {
+			return nil, false
+		}
+		return []Codepoint{info}, true
+	}
+
+	indexOnce.Do(buildIndexes)
+	cps, ok := htmlIndex[e]
+	if !ok {
+		return nil, false
+	}
+	infos := make([]Codepoint, 0, len(cps))
+	for _, cp := range cps {
+		info, _ := Find(cp)
+		infos = append(infos, info)
+	}
+	return infos, true
+}
+
+// FindKeySym finds a codepoint by the X11 keysym name, such as "EuroSign".
+// Keysyms are case-sensitive.
+func FindKeySym(name string) (Codepoint, bool) {
+	indexOnce.Do(buildIndexes)
+	cp, ok := keysymIndex[name]
+	if !ok {
+		return Codepoint{}, false
+	}
+	return Find(cp)
+}
+
+// FindDigraph finds a codepoint by the Vim digraph, such as "=e" or "Eu".
+func FindDigraph(d string) (Codepoint, bool) {
+	indexOnce.Do(buildIndexes)
+	cp, ok := digraphIndex[d]
+	if !ok {
+		return Codepoint{}, false
+	}
+	return Find(cp)
+}
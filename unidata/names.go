@@ -0,0 +1,128 @@
+package unidata
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Short names of the conjoining jamo, from Jamo.txt; these are used to derive
+// the names of Hangul syllables.
+var (
+	jamoL = []string{"G", "GG", "N", "D", "DD", "R", "M", "B", "BB", "S", "SS",
+		"", "J", "JJ", "C", "K", "T", "P", "H"}
+	jamoV = []string{"A", "AE", "YA", "YAE", "EO", "E", "YEO", "YE", "O", "WA",
+		"WAE", "OE", "YO", "U", "WEO", "WE", "WI", "YU", "EU", "YI", "I"}
+	jamoT = []string{"", "G", "GG", "GS", "N", "NJ", "NH", "D", "L", "LG", "LM",
+		"LB", "LS", "LT", "LP", "LH", "M", "B", "BS", "S", "SS", "NG", "J", "C",
+		"K", "T", "P", "H"}
+)
+
+// NamedSequence is a name for a sequence of codepoints, from
+// NamedSequences.txt; for example "KEYCAP DIGIT ONE" for 1️⃣ (U+0031 U+FE0F
+// U+20E3).
+type NamedSequence struct {
+	Name       string
+	Codepoints []rune
+}
+
+func (n NamedSequence) String() string { return string(n.Codepoints) }
+
+var namedSequences = func() map[string]NamedSequence {
+	m := make(map[string]NamedSequence, len(NamedSequences))
+	for _, n := range NamedSequences {
+		m[n.String()] = n
+	}
+	return m
+}()
+
+// FindNamedSequence finds the named sequence for s, which must be the exact
+// sequence.
+func FindNamedSequence(s string) (NamedSequence, bool) {
+	n, ok := namedSequences[s]
+	return n, ok
+}
+
+// derivedName gets the name for cp, which is in the range with the name
+// rangeName; this uses the name derivation rules from section 4.8 of the
+// Unicode standard for Hangul syllables and CJK and Tangut ideographs. Other
+// ranges (private use, surrogates) have no name, and rangeName is returned.
+//
+// https://www.unicode.org/reports/tr44/#Name
+func derivedName(cp rune, rangeName string) string {
+	switch {
+	case cp >= hangulSBase && cp < hangulSBase+hangulSCount:
+		s := cp - hangulSBase
+		return "HANGUL SYLLABLE " + jamoL[s/hangulNCount] +
+			jamoV[(s%hangulNCount)/hangulTCount] + jamoT[s%hangulTCount]
+	case strings.HasPrefix(rangeName, "<CJK Ideograph"):
+		// Not fmt.Sprintf(), as this is called for every ideograph when
+		// searching. All of these have at least 4 hex digits.
+		return "CJK UNIFIED IDEOGRAPH-" + strings.ToUpper(strconv.FormatInt(int64(cp), 16))
+	case strings.HasPrefix(rangeName, "<Tangut Ideograph"):
+		return "TANGUT IDEOGRAPH-" + strings.ToUpper(strconv.FormatInt(int64(cp), 16))
+	}
+	return rangeName
+}
+
+// parseDerivedName gets the codepoint for a derived name, which must be in
+// upper case.
+func parseDerivedName(name string) (rune, bool) {
+	for _, p := range []string{"CJK UNIFIED IDEOGRAPH-", "TANGUT IDEOGRAPH-"} {
+		if !strings.HasPrefix(name, p) {
+			continue
+		}
+		n, err := strconv.ParseUint(name[len(p):], 16, 32)
+		if err != nil {
+			return 0, false
+		}
+		info, ok := Find(rune(n))
+		return rune(n), ok && info.Name == name
+	}
+
+	if strings.HasPrefix(name, "HANGUL SYLLABLE ") {
+		for cp := rune(hangulSBase); cp < hangulSBase+hangulSCount; cp++ {
+			if derivedName(cp, "") == name {
+				return cp, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// Derived calls f for all codepoints with a derived name, in order. These are
+// only listed as a range in UnicodeData.txt and aren't in Codepoints, except
+// for the first and last codepoint of every range.
+func Derived(f func(Codepoint)) {
+	for i, r := range ranges {
+		if derivedName(r[0], rangeNames[i]) == rangeNames[i] {
+			continue
+		}
+		info := Codepoints[r[0]]
+		for cp := r[0]; cp <= r[1]; cp++ {
+			info.Codepoint, info.Name = cp, derivedName(cp, rangeNames[i])
+			f(info)
+		}
+	}
+}
+
+// Jamo gets the conjoining jamo a Hangul syllable decomposes to; for example
+// 각 (HANGUL SYLLABLE GAG) is ᄀ, ᅡ, and ᆨ. This returns nil if this isn't a
+// Hangul syllable.
+func (c Codepoint) Jamo() []rune {
+	s := c.Codepoint - hangulSBase
+	if s < 0 || s >= hangulSCount {
+		return nil
+	}
+	j := []rune{hangulLBase + s/hangulNCount, hangulVBase + (s%hangulNCount)/hangulTCount}
+	if t := s % hangulTCount; t != 0 {
+		j = append(j, hangulTBase+t)
+	}
+	return j
+}
+
+// IsRangeEnd reports if this is the first or last codepoint of a range in
+// UnicodeData.txt, which have names such as "<CJK Ideograph, First>"; use
+// Find() to get the correct name.
+func (c Codepoint) IsRangeEnd() bool {
+	return strings.HasSuffix(c.Name, ", First>") || strings.HasSuffix(c.Name, ", Last>")
+}
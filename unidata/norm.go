@@ -0,0 +1,245 @@
+package unidata
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Form is a Unicode normalization form.
+type Form uint8
+
+// Normalization forms.
+const (
+	NFC Form = iota
+	NFD
+	NFKC
+	NFKD
+)
+
+// Forms is a list of all normalization forms.
+var Forms = []Form{NFC, NFD, NFKC, NFKD}
+
+func (f Form) String() string {
+	switch f {
+	case NFC:
+		return "NFC"
+	case NFD:
+		return "NFD"
+	case NFKC:
+		return "NFKC"
+	case NFKD:
+		return "NFKD"
+	}
+	return fmt.Sprintf("Form(%d)", uint8(f))
+}
+
+// ParseForm parses a normalization form name such as "NFC" or "nfkd".
+func ParseForm(s string) (Form, error) {
+	for _, f := range Forms {
+		if strings.EqualFold(s, f.String()) {
+			return f, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown normalization form: %q", s)
+}
+
+func (f Form) compat() bool  { return f == NFKC || f == NFKD }
+func (f Form) compose() bool { return f == NFC || f == NFKC }
+
+// Decomposition is a decomposition mapping from UnicodeData.txt.
+type Decomposition struct {
+	Tag     string // Formatting tag for compatibility mappings, e.g. "noBreak"; blank for canonical mappings.
+	Mapping []rune
+}
+
+// QuickCheck is the value of a normalization quick check property.
+type QuickCheck uint8
+
+// Quick check values.
+const (
+	QuickCheckYes QuickCheck = iota
+	QuickCheckNo
+	QuickCheckMaybe
+)
+
+func (q QuickCheck) String() string {
+	switch q {
+	case QuickCheckYes:
+		return "yes"
+	case QuickCheckNo:
+		return "no"
+	case QuickCheckMaybe:
+		return "maybe"
+	}
+	return fmt.Sprintf("QuickCheck(%d)", uint8(q))
+}
+
+// Hangul syllables are decomposed and composed algorithmically; see section
+// 3.12 of the Unicode standard.
+const (
+	hangulSBase  = 0xac00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11a7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// Primary composites, indexed by the two codepoints they decompose to.
+var compositions = func() map[[2]rune]rune {
+	c := make(map[[2]rune]rune)
+	for cp, d := range Decompositions {
+		if d.Tag != "" || len(d.Mapping) != 2 || inRanges(cp, CompositionExclusions) {
+			continue
+		}
+		c[[2]rune{d.Mapping[0], d.Mapping[1]}] = cp
+	}
+	return c
+}()
+
+// CombiningClass gets the Canonical_Combining_Class.
+func (c Codepoint) CombiningClass() uint8 { return CombiningClasses[c.Codepoint] }
+
+// Decomposition gets the decomposition mapping; the mapping is nil if the
+// codepoint doesn't decompose.
+//
+// This is the mapping as listed in UnicodeData.txt, which may need to be
+// applied recursively to get the full decomposition; Hangul syllables are
+// decomposed algorithmically and don't have a mapping here.
+func (c Codepoint) Decomposition() Decomposition { return Decompositions[c.Codepoint] }
+
+// Normalize s to the normalization form f.
+func Normalize(s string, f Form) string {
+	d := decompose(s, f.compat())
+	if f.compose() {
+		d = compose(d)
+	}
+	return string(d)
+}
+
+// QuickCheckString runs the quick check algorithm on s; a result of
+// QuickCheckMaybe means that s needs to be normalized to know for sure.
+//
+// https://www.unicode.org/reports/tr15/#Detecting_Normalization_Forms
+func QuickCheckString(s string, f Form) QuickCheck {
+	var (
+		lastCC uint8
+		result = QuickCheckYes
+	)
+	for _, r := range s {
+		cc := CombiningClasses[r]
+		if cc != 0 && lastCC > cc {
+			return QuickCheckNo
+		}
+		switch {
+		case inRanges(r, NotNormalized[f]):
+			return QuickCheckNo
+		case inRanges(r, MaybeNormalized[f]):
+			result = QuickCheckMaybe
+		}
+		lastCC = cc
+	}
+	return result
+}
+
+// IsNormalized reports if s is in the normalization form f.
+func IsNormalized(s string, f Form) bool {
+	switch QuickCheckString(s, f) {
+	case QuickCheckYes:
+		return true
+	case QuickCheckNo:
+		return false
+	}
+	return Normalize(s, f) == s
+}
+
+// decompose s to the full canonical or compatibility decomposition, and put
+// combining marks in the canonical order.
+func decompose(s string, compat bool) []rune {
+	d := make([]rune, 0, len(s))
+	for _, r := range s {
+		d = decomposeRune(d, r, compat)
+	}
+
+	// Canonical ordering: a stable sort of every run of non-starters by their
+	// combining class.
+	for i := 1; i < len(d); i++ {
+		for j := i; j > 0; j-- {
+			a, b := CombiningClasses[d[j-1]], CombiningClasses[d[j]]
+			if b == 0 || a <= b {
+				break
+			}
+			d[j-1], d[j] = d[j], d[j-1]
+		}
+	}
+	return d
+}
+
+func decomposeRune(d []rune, r rune, compat bool) []rune {
+	if r >= hangulSBase && r < hangulSBase+hangulSCount {
+		s := r - hangulSBase
+		d = append(d, hangulLBase+s/hangulNCount, hangulVBase+(s%hangulNCount)/hangulTCount)
+		if t := s % hangulTCount; t != 0 {
+			d = append(d, hangulTBase+t)
+		}
+		return d
+	}
+
+	m, ok := Decompositions[r]
+	if !ok || (m.Tag != "" && !compat) {
+		return append(d, r)
+	}
+	for _, c := range m.Mapping {
+		d = decomposeRune(d, c, compat)
+	}
+	return d
+}
+
+// compose applies the canonical composition algorithm to the decomposed d.
+func compose(d []rune) []rune {
+	if len(d) == 0 {
+		return d
+	}
+
+	var (
+		out     = make([]rune, 0, len(d))
+		starter = -1 // Index of the last starter in out.
+		lastCC  = -1 // Combining class of the last character since starter; -1 if there is none.
+	)
+	for _, r := range d {
+		cc := int(CombiningClasses[r])
+		if starter > -1 && (lastCC == -1 || (lastCC != 0 && lastCC < cc)) {
+			if c, ok := composePair(out[starter], r); ok {
+				out[starter] = c
+				continue
+			}
+		}
+
+		out = append(out, r)
+		if cc == 0 {
+			starter, lastCC = len(out)-1, -1
+		} else {
+			lastCC = cc
+		}
+	}
+	return out
+}
+
+func composePair(a, b rune) (rune, bool) {
+	// LV
+	if a >= hangulLBase && a < hangulLBase+hangulLCount &&
+		b >= hangulVBase && b < hangulVBase+hangulVCount {
+		return hangulSBase + ((a-hangulLBase)*hangulVCount+(b-hangulVBase))*hangulTCount, true
+	}
+	// LVT
+	if a >= hangulSBase && a < hangulSBase+hangulSCount && (a-hangulSBase)%hangulTCount == 0 &&
+		b > hangulTBase && b < hangulTBase+hangulTCount {
+		return a + (b - hangulTBase), true
+	}
+
+	c, ok := compositions[[2]rune{a, b}]
+	return c, ok
+}
@@ -0,0 +1,106 @@
+package unidata
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// parseCodepoints parses a list of hex codepoints such as "0044 0307".
+func parseCodepoints(t *testing.T, s string) string {
+	t.Helper()
+	var b strings.Builder
+	for _, f := range strings.Fields(s) {
+		n, err := strconv.ParseUint(f, 16, 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.WriteRune(rune(n))
+	}
+	return b.String()
+}
+
+func TestNormalize(t *testing.T) {
+	fp, err := os.Open("testdata/NormalizationTest.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fp.Close()
+
+	var (
+		part  string
+		part1 = make(map[rune]bool)
+		scan  = bufio.NewScanner(fp)
+		n     int
+	)
+	for scan.Scan() {
+		n++
+		line := scan.Text()
+		if i := strings.IndexByte(line, '#'); i > -1 {
+			line = line[:i]
+		}
+		if line == "" {
+			continue
+		}
+		if line[0] == '@' {
+			part = strings.TrimSpace(line)
+			continue
+		}
+
+		f := strings.Split(line, ";")
+		if len(f) < 5 {
+			t.Fatalf("line %d: wrong number of fields", n)
+		}
+		var c [6]string
+		for i := range f[:5] {
+			c[i+1] = parseCodepoints(t, f[i])
+		}
+		if part == "@Part1" {
+			part1[[]rune(c[1])[0]] = true
+		}
+
+		tests := []struct {
+			form Form
+			want string
+			in   []string
+		}{
+			{NFC, c[2], []string{c[1], c[2], c[3]}},
+			{NFC, c[4], []string{c[4], c[5]}},
+			{NFD, c[3], []string{c[1], c[2], c[3]}},
+			{NFD, c[5], []string{c[4], c[5]}},
+			{NFKC, c[4], []string{c[1], c[2], c[3], c[4], c[5]}},
+			{NFKD, c[5], []string{c[1], c[2], c[3], c[4], c[5]}},
+		}
+		for _, tt := range tests {
+			for _, in := range tt.in {
+				if have := Normalize(in, tt.form); have != tt.want {
+					t.Errorf("line %d: %s(%+q)\nhave: %+q\nwant: %+q", n, tt.form, in, have, tt.want)
+				}
+			}
+			if !IsNormalized(tt.want, tt.form) {
+				t.Errorf("line %d: IsNormalized(%+q, %s) is false", n, tt.want, tt.form)
+			}
+		}
+	}
+	if err := scan.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Everything not in part 1 should be unchanged; the Hangul syllables that
+	// aren't in the test file are skipped.
+	for r := rune(0); r <= 0x10ffff; r++ {
+		if part1[r] || (r >= 0xd800 && r <= 0xdfff) || (r >= 0xac00 && r <= 0xd7a3) {
+			continue
+		}
+		if _, ok := Find(r); !ok {
+			continue
+		}
+		for _, form := range []Form{NFC, NFD, NFKC, NFKD} {
+			if have := Normalize(string(r), form); have != string(r) {
+				t.Errorf("%s(U+%04X)\nhave: %+q\nwant: %+q", form, r, have, string(r))
+			}
+		}
+	}
+}
@@ -0,0 +1,271 @@
+package unidata
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"unicode"
+)
+
+// NumericType is the Numeric_Type property.
+type NumericType uint8
+
+// Numeric types.
+const (
+	NumericNone    NumericType = iota
+	NumericDecimal             // Decimal digits in a positional system: 0-9, ٠-٩, etc.
+	NumericDigit               // Digits in a special context, such as superscripts or circled digits.
+	NumericNumeric             // Everything else: fractions, Roman numerals, Han numerals, etc.
+)
+
+func (t NumericType) String() string {
+	switch t {
+	case NumericNone:
+		return "None"
+	case NumericDecimal:
+		return "Decimal"
+	case NumericDigit:
+		return "Digit"
+	case NumericNumeric:
+		return "Numeric"
+	}
+	return fmt.Sprintf("NumericType(%d)", uint8(t))
+}
+
+// Numeric is the numeric type and value of a codepoint; the value is a
+// rational number, such as 1/2 for ½.
+type Numeric struct {
+	Type        NumericType
+	Numerator   int64
+	Denominator int64
+}
+
+// Rat gets the value as a rational number; this returns nil if the type is
+// NumericNone.
+func (n Numeric) Rat() *big.Rat {
+	if n.Type == NumericNone {
+		return nil
+	}
+	return big.NewRat(n.Numerator, n.Denominator)
+}
+
+// String formats the value as "5" or "1/2"; this is blank if the type is
+// NumericNone.
+func (n Numeric) String() string {
+	if n.Type == NumericNone {
+		return ""
+	}
+	if n.Denominator == 1 {
+		return strconv.FormatInt(n.Numerator, 10)
+	}
+	return strconv.FormatInt(n.Numerator, 10) + "/" + strconv.FormatInt(n.Denominator, 10)
+}
+
+// Numeric gets the Numeric_Type and Numeric_Value properties.
+func (c Codepoint) Numeric() Numeric { return Numerics[c.Codepoint] }
+
+// ParseNumber parses a number written with the digits of any script, such as
+// "١٢٣" (Arabic-Indic) or "１２３" (fullwidth), or with other numeric
+// characters such as "Ⅻ" or "½".
+//
+// Accepted are:
+//
+//   - Decimal digits, optionally with a sign, thousands separators (",", "٬"),
+//     and a decimal separator (".", "٫"); all digits must be from the same
+//     set of digits, so "1٢" is an error. Thousands separators must separate
+//     groups of three digits, so "1,5" is an error rather than 1.5.
+//   - Roman numerals such as "ⅯⅯⅩⅩⅠ"; these must be valid numerals, so "ⅠⅠⅩ"
+//     is an error.
+//   - Decimal digits followed by a single fraction, as in "3½".
+//   - A single other numeric character, such as "⑳" or "五".
+func ParseNumber(s string) (*big.Rat, error) {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return nil, errors.New("empty string")
+	}
+
+	var neg bool
+	switch runes[0] {
+	case '-', '−': // Hyphen-minus, minus sign
+		neg, runes = true, runes[1:]
+	case '+':
+		runes = runes[1:]
+	}
+	if len(runes) == 0 {
+		return nil, errors.New("no digits")
+	}
+
+	var (
+		n   *big.Rat
+		err error
+	)
+	switch {
+	case len(runes) == 1 && Numerics[runes[0]].Type != NumericNone:
+		n = Numerics[runes[0]].Rat()
+	case isRoman(runes[0]):
+		n, err = parseRoman(runes)
+	default:
+		n, err = parseDecimal(runes)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if neg {
+		n.Neg(n)
+	}
+	return n, nil
+}
+
+// Roman numerals have the Nl category, and are in the Number Forms block.
+func isRoman(r rune) bool {
+	return r >= 0x2160 && r <= 0x2188 && unicode.Is(unicode.Nl, r)
+}
+
+// Symbols of Roman numerals and their values, including the subtractive forms
+// such as "IV"; these are written as the values of the symbols, so that
+// numerals such as "Ⅻ" and "ⅩⅠⅠ" are the same.
+var romanSymbols = []struct {
+	value   int64
+	symbols []int64
+}{
+	{100000, []int64{100000}}, {90000, []int64{10000, 100000}},
+	{50000, []int64{50000}}, {40000, []int64{10000, 50000}},
+	{10000, []int64{10000}}, {9000, []int64{1000, 10000}},
+	{5000, []int64{5000}}, {4000, []int64{1000, 5000}},
+	{1000, []int64{1000}}, {900, []int64{100, 1000}},
+	{500, []int64{500}}, {400, []int64{100, 500}},
+	{100, []int64{100}}, {90, []int64{10, 100}},
+	{50, []int64{50}}, {40, []int64{10, 50}},
+	{10, []int64{10}}, {9, []int64{1, 10}},
+	{5, []int64{5}}, {4, []int64{1, 5}},
+	{1, []int64{1}},
+}
+
+// romanValues gets the values of the symbols for n written as a Roman numeral.
+func romanValues(n int64) []int64 {
+	var v []int64
+	for _, s := range romanSymbols {
+		for ; n >= s.value; n -= s.value {
+			v = append(v, s.symbols...)
+		}
+	}
+	return v
+}
+
+// parseRoman parses a Roman numeral; this must be written in the standard
+// form, so "ⅠⅠⅩ" or "ⅤⅤ" are errors.
+func parseRoman(runes []rune) (*big.Rat, error) {
+	var values []int64
+	for _, r := range runes {
+		if !isRoman(r) {
+			return nil, fmt.Errorf("not a Roman numeral: %q", r)
+		}
+		values = append(values, romanValues(Numerics[r].Numerator)...)
+	}
+
+	var total, prev int64
+	for i := len(values) - 1; i >= 0; i-- {
+		if v := values[i]; v < prev {
+			total -= v
+		} else {
+			total += v
+			prev = v
+		}
+	}
+
+	want := romanValues(total)
+	if len(want) != len(values) {
+		return nil, fmt.Errorf("invalid Roman numeral: %q", string(runes))
+	}
+	for i := range want {
+		if want[i] != values[i] {
+			return nil, fmt.Errorf("invalid Roman numeral: %q", string(runes))
+		}
+	}
+	return big.NewRat(total, 1), nil
+}
+
+func parseDecimal(runes []rune) (*big.Rat, error) {
+	var (
+		intPart, frac []byte
+		sep           bool
+		groups        []int      // Position in intPart of thousands separators.
+		zero          = rune(-1) // Zero of the set of digits we're using.
+		n             = new(big.Rat)
+	)
+	for i, r := range runes {
+		switch r {
+		case '.', '٫': // Full stop, Arabic decimal separator
+			if sep {
+				return nil, fmt.Errorf("more than one decimal separator at position %d", i+1)
+			}
+			sep = true
+			continue
+		case ',', '٬': // Comma, Arabic thousands separator
+			if sep {
+				return nil, fmt.Errorf("thousands separator after the decimal separator at position %d", i+1)
+			}
+			groups = append(groups, len(intPart))
+			continue
+		}
+
+		num := Numerics[r]
+		switch num.Type {
+		case NumericDecimal, NumericDigit:
+			if num.Denominator != 1 || num.Numerator < 0 || num.Numerator > 9 {
+				return nil, fmt.Errorf("not a digit: %q", r)
+			}
+			// Digits are always encoded contiguously from 0 to 9, so the zero
+			// is the same for all digits from the same set.
+			if z := digitZero(r, num.Numerator); zero == -1 {
+				zero = z
+			} else if z != zero {
+				return nil, fmt.Errorf("mixed digits from different sets at position %d: %q", i+1, r)
+			}
+			if sep {
+				frac = append(frac, byte('0'+num.Numerator))
+			} else {
+				intPart = append(intPart, byte('0'+num.Numerator))
+			}
+		case NumericNumeric:
+			// Allow a single fraction at the end, as in "3½".
+			if i != len(runes)-1 || sep || num.Numerator >= num.Denominator {
+				return nil, fmt.Errorf("unexpected numeric character at position %d: %q", i+1, r)
+			}
+			n.Add(n, num.Rat())
+		default:
+			return nil, fmt.Errorf("not a number at position %d: %q", i+1, r)
+		}
+	}
+	if len(intPart) == 0 && len(frac) == 0 {
+		return nil, errors.New("no digits")
+	}
+	// Every group must have three digits, except the first which can have one
+	// to three: "1,234,567".
+	if len(groups) > 0 {
+		prev := 0
+		for i, g := range append(groups, len(intPart)) {
+			if size := g - prev; size < 1 || size > 3 || (i > 0 && size != 3) {
+				return nil, errors.New("thousands separator not between groups of three digits")
+			}
+			prev = g
+		}
+	}
+
+	d, ok := new(big.Rat).SetString(string(intPart) + "." + string(frac) + "0")
+	if !ok {
+		return nil, errors.New("invalid number") // Should never happen.
+	}
+	return n.Add(n, d), nil
+}
+
+// digitZero gets the zero of the set of digits r belongs to. The superscripts
+// ¹, ², and ³ are in Latin-1 rather than with the other superscript digits.
+func digitZero(r rune, v int64) rune {
+	switch r {
+	case '¹', '²', '³':
+		return '⁰'
+	}
+	return r - rune(v)
+}
@@ -0,0 +1,338 @@
+package unidata
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// rangeTable is a sorted list of ranges with their property value, for faster
+// lookups of properties with many values.
+type rangeTable struct {
+	ranges [][2]rune
+	values []string
+}
+
+func newRangeTable(m map[string][][2]rune) rangeTable {
+	type entry struct {
+		r [2]rune
+		v string
+	}
+	var e []entry
+	for v, ranges := range m {
+		for _, r := range ranges {
+			e = append(e, entry{r, v})
+		}
+	}
+	sort.Slice(e, func(i, j int) bool { return e[i].r[0] < e[j].r[0] })
+
+	t := rangeTable{ranges: make([][2]rune, len(e)), values: make([]string, len(e))}
+	for i := range e {
+		t.ranges[i], t.values[i] = e[i].r, e[i].v
+	}
+	return t
+}
+
+// lookup gets the value for r, or def if it's not in any of the ranges.
+func (t rangeTable) lookup(r rune, def string) string {
+	i := sort.Search(len(t.ranges), func(i int) bool { return t.ranges[i][1] >= r })
+	if i < len(t.ranges) && r >= t.ranges[i][0] {
+		return t.values[i]
+	}
+	return def
+}
+
+var graphemeTable = newRangeTable(GraphemeBreaks)
+
+// GraphemeBreak gets the Grapheme_Cluster_Break property, such as "Extend" or
+// "Regional_Indicator"; this is "Other" if it has none.
+func (c Codepoint) GraphemeBreak() string { return graphemeTable.lookup(c.Codepoint, "Other") }
+
+// Graphemes splits s in extended grapheme clusters, as defined in UAX #29.
+//
+// https://www.unicode.org/reports/tr29/#Grapheme_Cluster_Boundaries
+func Graphemes(s string) []string {
+	var (
+		clusters   []string
+		start      int
+		prev       string
+		ri         int  // Number of Regional_Indicator before the current character.
+		pict, pZWJ bool // In "ExtPict Extend*", or "ExtPict Extend* ZWJ"
+	)
+	for i, r := range s {
+		cur := graphemeTable.lookup(r, "Other")
+		ext := inRanges(r, Props[PropExtendedPictographic])
+		if i > 0 && graphemeBoundary(prev, cur, ri, pZWJ && ext) {
+			clusters = append(clusters, s[start:i])
+			start = i
+		}
+
+		if cur == "Regional_Indicator" {
+			ri++
+		} else {
+			ri = 0
+		}
+		switch {
+		case ext:
+			pict, pZWJ = true, false
+		case pict && cur == "Extend":
+			pZWJ = false
+		case pict && cur == "ZWJ":
+			pict, pZWJ = false, true
+		default:
+			pict, pZWJ = false, false
+		}
+		prev = cur
+	}
+	if start < len(s) {
+		clusters = append(clusters, s[start:])
+	}
+	return clusters
+}
+
+func graphemeBoundary(prev, cur string, ri int, emojiZWJ bool) bool {
+	switch {
+	case prev == "CR" && cur == "LF": // GB3
+		return false
+	case prev == "Control" || prev == "CR" || prev == "LF": // GB4
+		return true
+	case cur == "Control" || cur == "CR" || cur == "LF": // GB5
+		return true
+	case prev == "L" && (cur == "L" || cur == "V" || cur == "LV" || cur == "LVT"): // GB6
+		return false
+	case (prev == "LV" || prev == "V") && (cur == "V" || cur == "T"): // GB7
+		return false
+	case (prev == "LVT" || prev == "T") && cur == "T": // GB8
+		return false
+	case cur == "Extend" || cur == "ZWJ" || cur == "SpacingMark" || prev == "Prepend": // GB9, GB9a, GB9b
+		return false
+	case emojiZWJ: // GB11
+		return false
+	case prev == "Regional_Indicator" && cur == "Regional_Indicator": // GB12, GB13
+		return ri%2 == 0
+	}
+	return true // GB999
+}
+
+var (
+	wordTable     = newRangeTable(WordBreaks)
+	sentenceTable = newRangeTable(SentenceBreaks)
+)
+
+// WordBreak gets the Word_Break property, such as "ALetter" or "MidNum"; this
+// is "Other" if it has none.
+func (c Codepoint) WordBreak() string { return wordTable.lookup(c.Codepoint, "Other") }
+
+// SentenceBreak gets the Sentence_Break property, such as "Upper" or "ATerm";
+// this is "Other" if it has none.
+func (c Codepoint) SentenceBreak() string { return sentenceTable.lookup(c.Codepoint, "Other") }
+
+// splitAt splits s before every rune for which brk returns true; runes are the
+// runes in s.
+func splitAt(s string, runes []rune, brk func(i int) bool) []string {
+	var (
+		segments []string
+		start    int
+		pos      int
+	)
+	for i, r := range runes {
+		if i > 0 && brk(i) {
+			segments = append(segments, s[start:pos])
+			start = pos
+		}
+		pos += utf8.RuneLen(r)
+	}
+	if start < len(s) {
+		segments = append(segments, s[start:])
+	}
+	return segments
+}
+
+// skipIgnored records which characters are ignored by WB4 and SB5: these are
+// treated as if they're the preceding character, except after a newline or
+// paragraph separator.
+func skipIgnored(props []string, ignore, sep func(string) bool) []bool {
+	skip := make([]bool, len(props))
+	for i := 1; i < len(props); i++ {
+		skip[i] = ignore(props[i]) && !sep(props[i-1])
+	}
+	return skip
+}
+
+// Words splits s in words, as defined in UAX #29. Every character ends up in
+// a segment, including spaces and punctuation; for example "Hello, world" is
+// split in "Hello", ",", " ", and "world".
+//
+// https://www.unicode.org/reports/tr29/#Word_Boundaries
+func Words(s string) []string {
+	runes := []rune(s)
+	props := make([]string, len(runes))
+	for i, r := range runes {
+		props[i] = wordTable.lookup(r, "Other")
+	}
+	newline := func(p string) bool { return p == "Newline" || p == "CR" || p == "LF" }
+	skip := skipIgnored(props, func(p string) bool {
+		return p == "Extend" || p == "Format" || p == "ZWJ"
+	}, newline)
+
+	// Get the property of the character before or after i, ignoring the
+	// characters skipped by WB4; this is "" at the start or end of the text.
+	prev := func(i int) (int, string) {
+		for i--; i >= 0; i-- {
+			if !skip[i] {
+				return i, props[i]
+			}
+		}
+		return -1, ""
+	}
+	next := func(i int) string {
+		for i++; i < len(props); i++ {
+			if !skip[i] {
+				return props[i]
+			}
+		}
+		return ""
+	}
+	ahletter := func(p string) bool { return p == "ALetter" || p == "Hebrew_Letter" }
+	midnumletq := func(p string) bool { return p == "MidNumLet" || p == "Single_Quote" }
+
+	return splitAt(s, runes, func(i int) bool {
+		switch {
+		case props[i-1] == "CR" && props[i] == "LF": // WB3
+			return false
+		case newline(props[i-1]) || newline(props[i]): // WB3a, WB3b
+			return true
+		case props[i-1] == "ZWJ" && inRanges(runes[i], Props[PropExtendedPictographic]): // WB3c
+			return false
+		case props[i-1] == "WSegSpace" && props[i] == "WSegSpace": // WB3d
+			return false
+		case skip[i]: // WB4
+			return false
+		}
+
+		var (
+			j, a   = prev(i)
+			_, aa  = prev(j)
+			b, bb  = props[i], next(i)
+			numOrL = func(p string) bool { return ahletter(p) || p == "Numeric" || p == "Katakana" }
+		)
+		switch {
+		case ahletter(a) && ahletter(b): // WB5
+			return false
+		case ahletter(a) && (b == "MidLetter" || midnumletq(b)) && ahletter(bb): // WB6
+			return false
+		case ahletter(aa) && (a == "MidLetter" || midnumletq(a)) && ahletter(b): // WB7
+			return false
+		case a == "Hebrew_Letter" && b == "Single_Quote": // WB7a
+			return false
+		case a == "Hebrew_Letter" && b == "Double_Quote" && bb == "Hebrew_Letter": // WB7b
+			return false
+		case aa == "Hebrew_Letter" && a == "Double_Quote" && b == "Hebrew_Letter": // WB7c
+			return false
+		case (a == "Numeric" || ahletter(a)) && b == "Numeric": // WB8, WB9
+			return false
+		case a == "Numeric" && ahletter(b): // WB10
+			return false
+		case aa == "Numeric" && (a == "MidNum" || midnumletq(a)) && b == "Numeric": // WB11
+			return false
+		case a == "Numeric" && (b == "MidNum" || midnumletq(b)) && bb == "Numeric": // WB12
+			return false
+		case a == "Katakana" && b == "Katakana": // WB13
+			return false
+		case (numOrL(a) || a == "ExtendNumLet") && b == "ExtendNumLet": // WB13a
+			return false
+		case a == "ExtendNumLet" && numOrL(b): // WB13b
+			return false
+		case a == "Regional_Indicator" && b == "Regional_Indicator": // WB15, WB16
+			n := 0
+			for ; j >= 0 && props[j] == "Regional_Indicator"; j, _ = prev(j) {
+				n++
+			}
+			return n%2 == 0
+		}
+		return true // WB999
+	})
+}
+
+// Sentences splits s in sentences, as defined in UAX #29. Spaces after the
+// end of a sentence are included in the sentence.
+//
+// https://www.unicode.org/reports/tr29/#Sentence_Boundaries
+func Sentences(s string) []string {
+	runes := []rune(s)
+	props := make([]string, len(runes))
+	for i, r := range runes {
+		props[i] = sentenceTable.lookup(r, "Other")
+	}
+	parasep := func(p string) bool { return p == "Sep" || p == "CR" || p == "LF" }
+	skip := skipIgnored(props, func(p string) bool { return p == "Extend" || p == "Format" }, parasep)
+
+	prev := func(i int) int {
+		for i--; i >= 0 && skip[i]; i-- {
+		}
+		return i
+	}
+	prop := func(i int) string {
+		if i < 0 {
+			return ""
+		}
+		return props[i]
+	}
+	sterm := func(p string) bool { return p == "ATerm" || p == "STerm" }
+
+	return splitAt(s, runes, func(i int) bool {
+		switch {
+		case props[i-1] == "CR" && props[i] == "LF": // SB3
+			return false
+		case parasep(props[i-1]): // SB4
+			return true
+		case skip[i]: // SB5
+			return false
+		}
+
+		var (
+			j    = prev(i)
+			a, b = props[j], props[i]
+		)
+		switch {
+		case a == "ATerm" && b == "Numeric": // SB6
+			return false
+		case a == "ATerm" && b == "Upper" && (prop(prev(j)) == "Upper" || prop(prev(j)) == "Lower"): // SB7
+			return false
+		}
+
+		// Look for "SATerm Close* Sp*" before the current position.
+		var sp bool
+		for ; prop(j) == "Sp"; j = prev(j) {
+			sp = true
+		}
+		for ; prop(j) == "Close"; j = prev(j) {
+		}
+		if !sterm(prop(j)) {
+			return false // SB998
+		}
+
+		if props[j] == "ATerm" { // SB8
+			for k := i; k < len(props); k++ {
+				if skip[k] {
+					continue
+				}
+				p := props[k]
+				if p == "Lower" {
+					return false
+				}
+				if p == "OLetter" || p == "Upper" || parasep(p) || sterm(p) {
+					break
+				}
+			}
+		}
+		switch {
+		case b == "SContinue" || sterm(b): // SB8a
+			return false
+		case !sp && b == "Close": // SB9
+			return false
+		case b == "Sp" || parasep(b): // SB9, SB10
+			return false
+		}
+		return true // SB11
+	})
+}
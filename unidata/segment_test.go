@@ -0,0 +1,62 @@
+package unidata
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+)
+
+// testBreaks runs the tests from one of the *BreakTest.txt files: every line
+// is a list of hex codepoints separated by "÷" (break) or "×" (no break).
+func testBreaks(t *testing.T, file string, split func(string) []string) {
+	t.Helper()
+
+	fp, err := os.Open(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fp.Close()
+
+	var (
+		scan = bufio.NewScanner(fp)
+		n    int
+	)
+	for scan.Scan() {
+		n++
+		line := scan.Text()
+		if i := strings.IndexByte(line, '#'); i > -1 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		var want []string
+		for _, s := range strings.Split(strings.Trim(line, "÷ "), "÷") {
+			want = append(want, parseCodepoints(t, strings.ReplaceAll(s, "×", "")))
+		}
+		in := strings.Join(want, "")
+
+		have := split(in)
+		if strings.Join(have, "÷") != strings.Join(want, "÷") {
+			t.Errorf("%s line %d: %s\nhave: %+q\nwant: %+q", file, n, line, have, want)
+		}
+	}
+	if err := scan.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGraphemes(t *testing.T) {
+	testBreaks(t, "testdata/GraphemeBreakTest.txt", Graphemes)
+}
+
+func TestWords(t *testing.T) {
+	testBreaks(t, "testdata/WordBreakTest.txt", Words)
+}
+
+func TestSentences(t *testing.T) {
+	testBreaks(t, "testdata/SentenceBreakTest.txt", Sentences)
+}
@@ -95,6 +95,7 @@ var (
 		"Samaritan":                             {0x0800, 0x083F},
 		"Mandaic":                               {0x0840, 0x085F},
 		"Syriac Supplement":                     {0x0860, 0x086F},
+		"Arabic Extended-B":                     {0x0870, 0x089F},
 		"Arabic Extended-A":                     {0x08A0, 0x08FF},
 		"Devanagari":                            {0x0900, 0x097F},
 		"Bengali":                               {0x0980, 0x09FF},
@@ -258,7 +259,9 @@ var (
 		"Osage":                                   {0x104B0, 0x104FF},
 		"Elbasan":                                 {0x10500, 0x1052F},
 		"Caucasian Albanian":                      {0x10530, 0x1056F},
+		"Vithkuqi":                                {0x10570, 0x105BF},
 		"Linear A":                                {0x10600, 0x1077F},
+		"Latin Extended-F":                        {0x10780, 0x107BF},
 		"Cypriot Syllabary":                       {0x10800, 0x1083F},
 		"Imperial Aramaic":                        {0x10840, 0x1085F},
 		"Palmyrene":                               {0x10860, 0x1087F},
@@ -280,8 +283,11 @@ var (
 		"Old Hungarian":                           {0x10C80, 0x10CFF},
 		"Hanifi Rohingya":                         {0x10D00, 0x10D3F},
 		"Rumi Numeral Symbols":                    {0x10E60, 0x10E7F},
+		"Yezidi":                                  {0x10E80, 0x10EBF},
 		"Old Sogdian":                             {0x10F00, 0x10F2F},
 		"Sogdian":                                 {0x10F30, 0x10F6F},
+		"Old Uyghur":                              {0x10F70, 0x10FAF},
+		"Chorasmian":                              {0x10FB0, 0x10FDF},
 		"Elymaic":                                 {0x10FE0, 0x10FFF},
 		"Brahmi":                                  {0x11000, 0x1107F},
 		"Kaithi":                                  {0x11080, 0x110CF},
@@ -300,27 +306,32 @@ var (
 		"Modi":                                    {0x11600, 0x1165F},
 		"Mongolian Supplement":                    {0x11660, 0x1167F},
 		"Takri":                                   {0x11680, 0x116CF},
-		"Ahom":                                    {0x11700, 0x1173F},
+		"Ahom":                                    {0x11700, 0x1174F},
 		"Dogra":                                   {0x11800, 0x1184F},
 		"Warang Citi":                             {0x118A0, 0x118FF},
+		"Dives Akuru":                             {0x11900, 0x1195F},
 		"Nandinagari":                             {0x119A0, 0x119FF},
 		"Zanabazar Square":                        {0x11A00, 0x11A4F},
 		"Soyombo":                                 {0x11A50, 0x11AAF},
+		"Unified Canadian Aboriginal Syllabics Extended-A": {0x11AB0, 0x11ABF},
 		"Pau Cin Hau":                             {0x11AC0, 0x11AFF},
 		"Bhaiksuki":                               {0x11C00, 0x11C6F},
 		"Marchen":                                 {0x11C70, 0x11CBF},
 		"Masaram Gondi":                           {0x11D00, 0x11D5F},
 		"Gunjala Gondi":                           {0x11D60, 0x11DAF},
 		"Makasar":                                 {0x11EE0, 0x11EFF},
+		"Lisu Supplement":                         {0x11FB0, 0x11FBF},
 		"Tamil Supplement":                        {0x11FC0, 0x11FFF},
 		"Cuneiform":                               {0x12000, 0x123FF},
 		"Cuneiform Numbers and Punctuation":       {0x12400, 0x1247F},
 		"Early Dynastic Cuneiform":                {0x12480, 0x1254F},
+		"Cypro-Minoan":                            {0x12F90, 0x12FFF},
 		"Egyptian Hieroglyphs":                    {0x13000, 0x1342F},
 		"Egyptian Hieroglyph Format Controls":     {0x13430, 0x1343F},
 		"Anatolian Hieroglyphs":                   {0x14400, 0x1467F},
 		"Bamum Supplement":                        {0x16800, 0x16A3F},
 		"Mro":                                     {0x16A40, 0x16A6F},
+		"Tangsa":                                  {0x16A70, 0x16ACF},
 		"Bassa Vah":                               {0x16AD0, 0x16AFF},
 		"Pahawh Hmong":                            {0x16B00, 0x16B8F},
 		"Medefaidrin":                             {0x16E40, 0x16E9F},
@@ -328,12 +339,16 @@ var (
 		"Ideographic Symbols and Punctuation":     {0x16FE0, 0x16FFF},
 		"Tangut":                                  {0x17000, 0x187FF},
 		"Tangut Components":                       {0x18800, 0x18AFF},
+		"Khitan Small Script":                     {0x18B00, 0x18CFF},
+		"Tangut Supplement":                       {0x18D00, 0x18D7F},
+		"Kana Extended-B":                         {0x1AFF0, 0x1AFFF},
 		"Kana Supplement":                         {0x1B000, 0x1B0FF},
 		"Kana Extended-A":                         {0x1B100, 0x1B12F},
 		"Small Kana Extension":                    {0x1B130, 0x1B16F},
 		"Nushu":                                   {0x1B170, 0x1B2FF},
 		"Duployan":                                {0x1BC00, 0x1BC9F},
 		"Shorthand Format Controls":               {0x1BCA0, 0x1BCAF},
+		"Znamenny Musical Notation":               {0x1CF00, 0x1CFCF},
 		"Byzantine Musical Symbols":               {0x1D000, 0x1D0FF},
 		"Musical Symbols":                         {0x1D100, 0x1D1FF},
 		"Ancient Greek Musical Notation":          {0x1D200, 0x1D24F},
@@ -342,9 +357,12 @@ var (
 		"Counting Rod Numerals":                   {0x1D360, 0x1D37F},
 		"Mathematical Alphanumeric Symbols":       {0x1D400, 0x1D7FF},
 		"Sutton SignWriting":                      {0x1D800, 0x1DAAF},
+		"Latin Extended-G":                        {0x1DF00, 0x1DFFF},
 		"Glagolitic Supplement":                   {0x1E000, 0x1E02F},
 		"Nyiakeng Puachue Hmong":                  {0x1E100, 0x1E14F},
+		"Toto":                                    {0x1E290, 0x1E2BF},
 		"Wancho":                                  {0x1E2C0, 0x1E2FF},
+		"Ethiopic Extended-B":                     {0x1E7E0, 0x1E7FF},
 		"Mende Kikakui":                           {0x1E800, 0x1E8DF},
 		"Adlam":                                   {0x1E900, 0x1E95F},
 		"Indic Siyaq Numbers":                     {0x1EC70, 0x1ECBF},
@@ -365,16 +383,18 @@ var (
 		"Supplemental Symbols and Pictographs":    {0x1F900, 0x1F9FF},
 		"Chess Symbols":                           {0x1FA00, 0x1FA6F},
 		"Symbols and Pictographs Extended-A":      {0x1FA70, 0x1FAFF},
+		"Symbols for Legacy Computing":            {0x1FB00, 0x1FBFF},
 		"CJK Unified Ideographs Extension B":      {0x20000, 0x2A6DF},
 		"CJK Unified Ideographs Extension C":      {0x2A700, 0x2B73F},
 		"CJK Unified Ideographs Extension D":      {0x2B740, 0x2B81F},
 		"CJK Unified Ideographs Extension E":      {0x2B820, 0x2CEAF},
 		"CJK Unified Ideographs Extension F":      {0x2CEB0, 0x2EBEF},
 		"CJK Compatibility Ideographs Supplement": {0x2F800, 0x2FA1F},
-		"Tags":                             {0xE0000, 0xE007F},
-		"Variation Selectors Supplement":   {0xE0100, 0xE01EF},
-		"Supplementary Private Use Area-A": {0xF0000, 0xFFFFF},
-		"Supplementary Private Use Area-B": {0x100000, 0x10FFFF},
+		"CJK Unified Ideographs Extension G":      {0x30000, 0x3134F},
+		"Tags":                                    {0xE0000, 0xE007F},
+		"Variation Selectors Supplement":          {0xE0100, 0xE01EF},
+		"Supplementary Private Use Area-A":        {0xF0000, 0xFFFFF},
+		"Supplementary Private Use Area-B":        {0x100000, 0x10FFFF},
 	}
 
 	Blockmap = make(map[string]string)
@@ -582,19 +602,21 @@ var (
 
 var (
 	ranges = [][]rune{
-		{0x3400, 0x4DB5},
-		{0x4E00, 0x9FEF},
+		{0x3400, 0x4DBF},
+		{0x4E00, 0x9FFF},
 		{0xAC00, 0xD7A3},
 		{0xD800, 0xDB7F},
 		{0xDB80, 0xDBFF},
 		{0xDC00, 0xDFFF},
 		{0xE000, 0xF8FF},
-		{0x17000, 0x187F1},
-		{0x20000, 0x2A6D6},
-		{0x2A700, 0x2B734},
+		{0x17000, 0x187F7},
+		{0x18D00, 0x18D08},
+		{0x20000, 0x2A6DF},
+		{0x2A700, 0x2B738},
 		{0x2B740, 0x2B81D},
 		{0x2B820, 0x2CEA1},
 		{0x2CEB0, 0x2EBE0},
+		{0x30000, 0x3134A},
 		{0xF0000, 0xFFFFD},
 		{0x100000, 0x10FFFD},
 	}
@@ -608,12 +630,203 @@ var (
 		"<Low Surrogate>",
 		"<Private Use>",
 		"<Tangut Ideograph>",
+		"<Tangut Ideograph Supplement>",
 		"<CJK Ideograph Extension B>",
 		"<CJK Ideograph Extension C>",
 		"<CJK Ideograph Extension D>",
 		"<CJK Ideograph Extension E>",
 		"<CJK Ideograph Extension F>",
+		"<CJK Ideograph Extension G>",
 		"<Plane 15 Private Use>",
 		"<Plane 16 Private Use>",
 	}
 )
+
+// Binary properties from PropList.txt, DerivedCoreProperties.txt, and
+// emoji-data.txt; the "Other_" contributory properties are only used to derive
+// other properties and aren't included.
+//
+// http://www.unicode.org/reports/tr44/#Property_Index
+const (
+	PropASCIIHexDigit              = Property(1 << iota) // AHex
+	PropAlphabetic                                       // Alpha
+	PropBidiControl                                      // Bidi_C
+	PropCaseIgnorable                                    // CI
+	PropCased                                            // Cased
+	PropChangesWhenCasefolded                            // CWCF
+	PropChangesWhenCasemapped                            // CWCM
+	PropChangesWhenLowercased                            // CWL
+	PropChangesWhenTitlecased                            // CWT
+	PropChangesWhenUppercased                            // CWU
+	PropDash                                             // Dash
+	PropDefaultIgnorableCodePoint                        // DI
+	PropDeprecated                                       // Dep
+	PropDiacritic                                        // Dia
+	PropExtender                                         // Ext
+	PropGraphemeBase                                     // Gr_Base
+	PropGraphemeExtend                                   // Gr_Ext
+	PropGraphemeLink                                     // Gr_Link (deprecated)
+	PropHexDigit                                         // Hex
+	PropHyphen                                           // Hyphen (deprecated)
+	PropIDContinue                                       // IDC
+	PropIDStart                                          // IDS
+	PropIDSBinaryOperator                                // IDSB
+	PropIDSTrinaryOperator                               // IDST
+	PropIdeographic                                      // Ideo
+	PropJoinControl                                      // Join_C
+	PropLogicalOrderException                            // LOE
+	PropLowercase                                        // Lower
+	PropMath                                             // Math
+	PropNoncharacterCodePoint                            // NChar
+	PropPatternSyntax                                    // Pat_Syn
+	PropPatternWhiteSpace                                // Pat_WS
+	PropPrependedConcatenationMark                       // PCM
+	PropQuotationMark                                    // QMark
+	PropRadical                                          // Radical
+	PropRegionalIndicator                                // RI
+	PropSentenceTerminal                                 // STerm
+	PropSoftDotted                                       // SD
+	PropTerminalPunctuation                              // Term
+	PropUnifiedIdeograph                                 // UIdeo
+	PropUppercase                                        // Upper
+	PropVariationSelector                                // VS
+	PropWhiteSpace                                       // WSpace
+	PropXIDContinue                                      // XIDC
+	PropXIDStart                                         // XIDS
+	PropEmoji                                            // Emoji
+	PropEmojiPresentation                                // EPres
+	PropEmojiModifier                                    // EMod
+	PropEmojiModifierBase                                // EBase
+	PropEmojiComponent                                   // EComp
+	PropExtendedPictographic                             // ExtPict
+)
+
+// EmojiProps are all the properties from emoji-data.txt.
+const EmojiProps = PropEmoji | PropEmojiPresentation | PropEmojiModifier |
+	PropEmojiModifierBase | PropEmojiComponent | PropExtendedPictographic
+
+var (
+	Propnames = map[Property]string{
+		PropASCIIHexDigit:              "ASCII_Hex_Digit",
+		PropAlphabetic:                 "Alphabetic",
+		PropBidiControl:                "Bidi_Control",
+		PropCaseIgnorable:              "Case_Ignorable",
+		PropCased:                      "Cased",
+		PropChangesWhenCasefolded:      "Changes_When_Casefolded",
+		PropChangesWhenCasemapped:      "Changes_When_Casemapped",
+		PropChangesWhenLowercased:      "Changes_When_Lowercased",
+		PropChangesWhenTitlecased:      "Changes_When_Titlecased",
+		PropChangesWhenUppercased:      "Changes_When_Uppercased",
+		PropDash:                       "Dash",
+		PropDefaultIgnorableCodePoint:  "Default_Ignorable_Code_Point",
+		PropDeprecated:                 "Deprecated",
+		PropDiacritic:                  "Diacritic",
+		PropExtender:                   "Extender",
+		PropGraphemeBase:               "Grapheme_Base",
+		PropGraphemeExtend:             "Grapheme_Extend",
+		PropGraphemeLink:               "Grapheme_Link",
+		PropHexDigit:                   "Hex_Digit",
+		PropHyphen:                     "Hyphen",
+		PropIDContinue:                 "ID_Continue",
+		PropIDStart:                    "ID_Start",
+		PropIDSBinaryOperator:          "IDS_Binary_Operator",
+		PropIDSTrinaryOperator:         "IDS_Trinary_Operator",
+		PropIdeographic:                "Ideographic",
+		PropJoinControl:                "Join_Control",
+		PropLogicalOrderException:      "Logical_Order_Exception",
+		PropLowercase:                  "Lowercase",
+		PropMath:                       "Math",
+		PropNoncharacterCodePoint:      "Noncharacter_Code_Point",
+		PropPatternSyntax:              "Pattern_Syntax",
+		PropPatternWhiteSpace:          "Pattern_White_Space",
+		PropPrependedConcatenationMark: "Prepended_Concatenation_Mark",
+		PropQuotationMark:              "Quotation_Mark",
+		PropRadical:                    "Radical",
+		PropRegionalIndicator:          "Regional_Indicator",
+		PropSentenceTerminal:           "Sentence_Terminal",
+		PropSoftDotted:                 "Soft_Dotted",
+		PropTerminalPunctuation:        "Terminal_Punctuation",
+		PropUnifiedIdeograph:           "Unified_Ideograph",
+		PropUppercase:                  "Uppercase",
+		PropVariationSelector:          "Variation_Selector",
+		PropWhiteSpace:                 "White_Space",
+		PropXIDContinue:                "XID_Continue",
+		PropXIDStart:                   "XID_Start",
+		PropEmoji:                      "Emoji",
+		PropEmojiPresentation:          "Emoji_Presentation",
+		PropEmojiModifier:              "Emoji_Modifier",
+		PropEmojiModifierBase:          "Emoji_Modifier_Base",
+		PropEmojiComponent:             "Emoji_Component",
+		PropExtendedPictographic:       "Extended_Pictographic",
+	}
+
+	// Short aliases from PropertyAliases.txt; the full names are added in
+	// init().
+	Propmap = map[string]Property{
+		"ahex":   PropASCIIHexDigit,
+		"alpha":  PropAlphabetic,
+		"bidic":  PropBidiControl,
+		"ci":     PropCaseIgnorable,
+		"cwcf":   PropChangesWhenCasefolded,
+		"cwcm":   PropChangesWhenCasemapped,
+		"cwl":    PropChangesWhenLowercased,
+		"cwt":    PropChangesWhenTitlecased,
+		"cwu":    PropChangesWhenUppercased,
+		"di":     PropDefaultIgnorableCodePoint,
+		"dep":    PropDeprecated,
+		"dia":    PropDiacritic,
+		"ext":    PropExtender,
+		"grbase": PropGraphemeBase,
+		"grext":  PropGraphemeExtend,
+		"grlink": PropGraphemeLink,
+		"hex":    PropHexDigit,
+		"idc":    PropIDContinue,
+		"ids":    PropIDStart,
+		"idsb":   PropIDSBinaryOperator,
+		"idst":   PropIDSTrinaryOperator,
+		"ideo":   PropIdeographic,
+		"joinc":  PropJoinControl,
+		"loe":    PropLogicalOrderException,
+		"lower":  PropLowercase,
+		"nchar":  PropNoncharacterCodePoint,
+		"patsyn": PropPatternSyntax,
+		"patws":  PropPatternWhiteSpace,
+		"pcm":    PropPrependedConcatenationMark,
+		"qmark":  PropQuotationMark,
+		"ri":     PropRegionalIndicator,
+		"sterm":  PropSentenceTerminal,
+		"sd":     PropSoftDotted,
+		"term":   PropTerminalPunctuation,
+		"uideo":  PropUnifiedIdeograph,
+		"upper":  PropUppercase,
+		"vs":     PropVariationSelector,
+		"wspace": PropWhiteSpace,
+		"space":  PropWhiteSpace,
+		"xidc":   PropXIDContinue,
+		"xids":   PropXIDStart,
+
+		// emoji-data.txt
+		"epres":   PropEmojiPresentation,
+		"emod":    PropEmojiModifier,
+		"ebase":   PropEmojiModifierBase,
+		"ecomp":   PropEmojiComponent,
+		"extpict": PropExtendedPictographic,
+	}
+)
+
+func init() {
+	for k, v := range Propnames {
+		Propmap[CanonicalCategory(v)] = k
+	}
+}
+
+// Scriptmap maps the canonical form of script names and ISO 15924 codes to the
+// script name.
+var Scriptmap = make(map[string]string)
+
+func init() {
+	for code, name := range ScriptCodes {
+		Scriptmap[CanonicalCategory(code)] = name
+		Scriptmap[CanonicalCategory(name)] = name
+	}
+}
@@ -6,6 +6,7 @@ package unidata
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode"
@@ -28,8 +29,69 @@ type Codepoint struct {
 	Cat       uint8
 	Name      string
 	Digraph   string
-	HTML      string
-	KeySym    string // TODO: []string?
+
+	// Deprecated: use HTMLEntities; this is the first entry.
+	HTML string
+
+	// Deprecated: use KeySyms; this is the first entry.
+	KeySym string
+
+	// HTML entity names and X11 keysym names, without the & and ;. The first
+	// one is the preferred name: "nbsp" rather than "NonBreakingSpace".
+	HTMLEntities []string
+	KeySyms      []string
+}
+
+// Property is a set of binary properties.
+type Property uint64
+
+// CaseMapping is the full case mapping of a codepoint; a mapping is nil if the
+// codepoint maps to itself.
+type CaseMapping struct {
+	Upper, Lower, Title, Fold []rune
+}
+
+// SpecialCase is a conditional case mapping from SpecialCasing.txt or a Turkic
+// case folding from CaseFolding.txt; mappings that don't apply are nil.
+type SpecialCase struct {
+	Codepoint                 rune
+	Lower, Title, Upper, Fold []rune
+	Lang                      string // Language ID; blank for all languages.
+	Context                   string // Casing context, e.g. Final_Sigma or Not_Before_Dot.
+}
+
+// NameAlias is a formal name alias from NameAliases.txt.
+type NameAlias struct {
+	Name string
+	Type AliasType
+}
+
+// AliasType is the type of a formal name alias.
+type AliasType uint8
+
+// Alias types.
+const (
+	AliasCorrection   AliasType = iota // Corrections for serious problems in the name.
+	AliasControl                       // ISO 6429 names for control characters.
+	AliasAlternate                     // Widely used alternate names.
+	AliasFigment                       // Names that were documented but never used.
+	AliasAbbreviation                  // Commonly used abbreviations.
+)
+
+func (t AliasType) String() string {
+	switch t {
+	case AliasCorrection:
+		return "correction"
+	case AliasControl:
+		return "control"
+	case AliasAlternate:
+		return "alternate"
+	case AliasFigment:
+		return "figment"
+	case AliasAbbreviation:
+		return "abbreviation"
+	}
+	return fmt.Sprintf("AliasType(%d)", uint8(t))
 }
 
 // Emoji is an emoji sequence.
@@ -40,6 +102,12 @@ type Emoji struct {
 	CLDR            []string
 	SkinTones       bool
 	Genders         int
+	Version         string // Emoji version it was added in, e.g. "13.1" for E13.1.
+
+	// Sequence for emoji with two people, to give every person a different
+	// skin tone; the tones are added after the first and last codepoint. For
+	// example 👭 (women holding hands) is 👩🤝👩.
+	MultiTone []rune
 }
 
 func (e Emoji) GroupName() string {
@@ -53,7 +121,7 @@ func (e Emoji) SubgroupName() string {
 // Find a codepoint.
 func Find(cp rune) (Codepoint, bool) {
 	info, ok := Codepoints[cp]
-	if ok {
+	if ok && !info.IsRangeEnd() {
 		return info, true
 	}
 
@@ -61,7 +129,7 @@ func Find(cp rune) (Codepoint, bool) {
 	// range:
 	//
 	//   3400;<CJK Ideograph Extension A, First>;Lo;0;L;;;;;N;;;;;
-	//   4DB5;<CJK Ideograph Extension A, Last>;Lo;0;L;;;;;N;;;;;
+	//   4DBF;<CJK Ideograph Extension A, Last>;Lo;0;L;;;;;N;;;;;
 	for i, r := range ranges {
 		if cp >= r[0] && cp <= r[1] {
 			info, ok := Codepoints[r[0]]
@@ -70,7 +138,7 @@ func Find(cp rune) (Codepoint, bool) {
 			}
 
 			info.Codepoint = cp
-			info.Name = rangeNames[i]
+			info.Name = derivedName(cp, rangeNames[i])
 			return info, true
 		}
 	}
@@ -78,6 +146,33 @@ func Find(cp rune) (Codepoint, bool) {
 	return Codepoint{Codepoint: cp, Name: UnknownCodepoint}, false
 }
 
+// FindName finds a codepoint by its name, any of its formal aliases, or its
+// Unicode 1.0 name; the name is matched case-insensitively.
+func FindName(name string) (Codepoint, bool) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	for cp, aliases := range NameAliases {
+		for _, a := range aliases {
+			if a.Name == name {
+				return Find(cp)
+			}
+		}
+	}
+	for _, info := range Codepoints {
+		if info.Name == name {
+			return info, true
+		}
+	}
+	if cp, ok := parseDerivedName(name); ok {
+		return Find(cp)
+	}
+	for cp, n := range Unicode1Names {
+		if n == name {
+			return Find(cp)
+		}
+	}
+	return Codepoint{}, false
+}
+
 // ToRune converts a human input string to a rune.
 //
 // The input can be as U+41, U+0041, U41, 0x41, 0o101, 0b1000001
@@ -158,6 +253,144 @@ func (c Codepoint) Block() string {
 	return ""
 }
 
+// Properties gets all binary properties for this codepoint.
+func (c Codepoint) Properties() Property {
+	var p Property
+	for prop, r := range Props {
+		if inRanges(c.Codepoint, r) {
+			p |= prop
+		}
+	}
+	return p
+}
+
+// HasProperty reports if this codepoint has all the properties in p.
+func (c Codepoint) HasProperty(p Property) bool {
+	return c.Properties()&p == p
+}
+
+// Script gets the Script property, such as "Latin" or "Common"; this is
+// "Unknown" for unassigned codepoints.
+func (c Codepoint) Script() string {
+	for name, r := range Scripts {
+		if inRanges(c.Codepoint, r) {
+			return name
+		}
+	}
+	return "Unknown"
+}
+
+// ScriptExtensions gets the Script_Extensions property: all scripts this
+// codepoint is commonly used with. For most codepoints this is the same as
+// Script().
+func (c Codepoint) ScriptExtensions() []string {
+	var s []string
+	for name, r := range ScriptExtensions {
+		if inRanges(c.Codepoint, r) {
+			s = append(s, name)
+		}
+	}
+	if len(s) == 0 {
+		return []string{c.Script()}
+	}
+	sort.Strings(s)
+	return s
+}
+
+// Age gets the Unicode version this codepoint was added in, such as "13.0";
+// this is blank for unassigned codepoints.
+func (c Codepoint) Age() string {
+	for v, r := range Ages {
+		if inRanges(c.Codepoint, r) {
+			return v
+		}
+	}
+	return ""
+}
+
+// CompareVersion compares two Unicode or emoji versions such as "9.0" and
+// "13.1", returning -1 if a is lower than b, 1 if a is higher than b, and 0 if
+// they're identical.
+func CompareVersion(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
+
+// ScriptCode gets the ISO 15924 code for a script name, such as "Latn" for
+// "Latin".
+func ScriptCode(name string) string {
+	for code, n := range ScriptCodes {
+		if n == name {
+			return code
+		}
+	}
+	return "Zzzz"
+}
+
+// Names gets the names of all properties in p.
+func (p Property) Names() []string {
+	var names []string
+	for i := 0; i < 64; i++ {
+		if n, ok := Propnames[p&(1<<i)]; ok {
+			names = append(names, n)
+		}
+	}
+	return names
+}
+
+func (p Property) String() string {
+	return strings.Join(p.Names(), ", ")
+}
+
+// Upper gets the full uppercase mapping, ignoring any conditional mappings.
+func (c Codepoint) Upper() string { return c.mapCase(Casemaps[c.Codepoint].Upper) }
+
+// Lower gets the full lowercase mapping, ignoring any conditional mappings.
+func (c Codepoint) Lower() string { return c.mapCase(Casemaps[c.Codepoint].Lower) }
+
+// Title gets the full titlecase mapping, ignoring any conditional mappings.
+func (c Codepoint) Title() string { return c.mapCase(Casemaps[c.Codepoint].Title) }
+
+// Fold gets the full case folding, ignoring any conditional mappings.
+func (c Codepoint) Fold() string { return c.mapCase(Casemaps[c.Codepoint].Fold) }
+
+func (c Codepoint) mapCase(m []rune) string {
+	if m == nil {
+		return string(c.Codepoint)
+	}
+	return string(m)
+}
+
+// Aliases gets the formal name aliases from NameAliases.txt; for example the
+// correction "LATIN CAPITAL LETTER GHA" for U+01A2 or the abbreviation "NBSP"
+// for U+00A0.
+func (c Codepoint) Aliases() []NameAlias { return NameAliases[c.Codepoint] }
+
+// Unicode1Name gets the name from Unicode 1.0 if it's different from the
+// current name, such as "APOSTROPHE-QUOTE" for U+0027.
+func (c Codepoint) Unicode1Name() string { return Unicode1Names[c.Codepoint] }
+
+// inRanges reports if cp is in the list of ranges, which must be sorted.
+func inRanges(cp rune, ranges [][2]rune) bool {
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i][1] >= cp })
+	return i < len(ranges) && cp >= ranges[i][0]
+}
+
 func (c Codepoint) UTF8() string {
 	buf := make([]byte, 4)
 	n := utf8.EncodeRune(buf, c.Codepoint)
@@ -194,8 +427,8 @@ func (c Codepoint) JSON() string {
 }
 
 func (c Codepoint) HTMLEntity() string {
-	if c.HTML != "" {
-		return "&" + c.HTML + ";"
+	if len(c.HTMLEntities) > 0 {
+		return "&" + c.HTMLEntities[0] + ";"
 	}
 	return c.XMLEntity()
 }